@@ -954,15 +954,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/metric": {
+    "/v1/inhibit_rule": {
       "post": {
-        "summary": "create metric",
-        "operationId": "CreateMetric",
+        "summary": "create inhibit rule",
+        "operationId": "CreateInhibitRule",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertCreateMetricResponse"
+              "$ref": "#/definitions/alertCreateInhibitRuleResponse"
             }
           }
         },
@@ -972,7 +972,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertCreateMetricRequest"
+              "$ref": "#/definitions/alertCreateInhibitRuleRequest"
             }
           }
         ],
@@ -981,13 +981,13 @@ var Files = map[string]string{
         ]
       },
       "patch": {
-        "summary": "modify metric",
-        "operationId": "ModifyMetric",
+        "summary": "modify inhibit rule",
+        "operationId": "ModifyInhibitRule",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertModifyMetricResponse"
+              "$ref": "#/definitions/alertModifyInhibitRuleResponse"
             }
           }
         },
@@ -997,7 +997,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertModifyMetricRequest"
+              "$ref": "#/definitions/alertModifyInhibitRuleRequest"
             }
           }
         ],
@@ -1006,15 +1006,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/metrics": {
+    "/v1/inhibit_rules": {
       "get": {
-        "summary": "describe metrics",
-        "operationId": "DescribeMetrics",
+        "summary": "describe inhibit rules",
+        "operationId": "DescribeInhibitRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDescribeMetricsResponse"
+              "$ref": "#/definitions/alertDescribeInhibitRulesResponse"
             }
           }
         },
@@ -1053,7 +1053,7 @@ var Files = map[string]string{
             "format": "int64"
           },
           {
-            "name": "metric_id",
+            "name": "inhibit_rule_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1063,7 +1063,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "metric_name",
+            "name": "inhibit_rule_name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1073,7 +1073,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "status",
+            "name": "source_rs_type_name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1083,7 +1083,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "rs_type_id",
+            "name": "target_rs_type_name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1098,13 +1098,13 @@ var Files = map[string]string{
         ]
       },
       "delete": {
-        "summary": "delete metrics",
-        "operationId": "DeleteMetrics",
+        "summary": "delete inhibit rules",
+        "operationId": "DeleteInhibitRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDeleteMetricsResponse"
+              "$ref": "#/definitions/alertDeleteInhibitRulesResponse"
             }
           }
         },
@@ -1114,7 +1114,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertDeleteMetricsRequest"
+              "$ref": "#/definitions/alertDeleteInhibitRulesRequest"
             }
           }
         ],
@@ -1123,15 +1123,67 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/policies": {
+    "/v1/metric": {
+      "post": {
+        "summary": "create metric",
+        "operationId": "CreateMetric",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateMetricResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateMetricRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify metric",
+        "operationId": "ModifyMetric",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifyMetricResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifyMetricRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/metrics": {
       "get": {
-        "summary": "describe policies",
-        "operationId": "DescribePolicies",
+        "summary": "describe metrics",
+        "operationId": "DescribeMetrics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDescribePoliciesResponse"
+              "$ref": "#/definitions/alertDescribeMetricsResponse"
             }
           }
         },
@@ -1170,17 +1222,7 @@ var Files = map[string]string{
             "format": "int64"
           },
           {
-            "name": "policy_id",
-            "in": "query",
-            "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multimulti"
-          },
-          {
-            "name": "policy_name",
+            "name": "metric_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1190,7 +1232,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "policy_description",
+            "name": "metric_name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1200,7 +1242,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "creator",
+            "name": "status",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1225,13 +1267,13 @@ var Files = map[string]string{
         ]
       },
       "delete": {
-        "summary": "delete policies",
-        "operationId": "DeletePolicies",
+        "summary": "delete metrics",
+        "operationId": "DeleteMetrics",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDeletePoliciesResponse"
+              "$ref": "#/definitions/alertDeleteMetricsResponse"
             }
           }
         },
@@ -1241,7 +1283,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertDeletePoliciesRequest"
+              "$ref": "#/definitions/alertDeleteMetricsRequest"
             }
           }
         ],
@@ -1250,51 +1292,81 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/policy": {
-      "post": {
-        "summary": "create policy",
-        "operationId": "CreatePolicy",
+    "/v1/outboxes": {
+      "get": {
+        "summary": "describe outboxes",
+        "operationId": "DescribeOutboxes",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertCreatePolicyResponse"
+              "$ref": "#/definitions/alertDescribeOutboxesResponse"
             }
           }
         },
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/alertCreatePolicyRequest"
-            }
-          }
-        ],
-        "tags": [
-          "AlertManager"
-        ]
-      },
-      "patch": {
-        "summary": "modify policy",
-        "operationId": "ModifyPolicy",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/alertModifyPolicyResponse"
-            }
-          }
-        },
-        "parameters": [
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/alertModifyPolicyRequest"
-            }
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "outbox_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "alert_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
           }
         ],
         "tags": [
@@ -1302,15 +1374,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/resource_filter": {
+    "/v1/outboxes/discard": {
       "post": {
-        "summary": "create resource filter",
-        "operationId": "CreateResourceFilter",
+        "summary": "discard outboxes",
+        "operationId": "DiscardOutboxes",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertCreateResourceFilterResponse"
+              "$ref": "#/definitions/alertDiscardOutboxesResponse"
             }
           }
         },
@@ -1320,22 +1392,24 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertCreateResourceFilterRequest"
+              "$ref": "#/definitions/alertDiscardOutboxesRequest"
             }
           }
         ],
         "tags": [
           "AlertManager"
         ]
-      },
-      "patch": {
-        "summary": "modify resource filter",
-        "operationId": "ModifyResourceFilter",
+      }
+    },
+    "/v1/outboxes/retry": {
+      "post": {
+        "summary": "retry outboxes",
+        "operationId": "RetryOutboxes",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertModifyResourceFilterResponse"
+              "$ref": "#/definitions/alertRetryOutboxesResponse"
             }
           }
         },
@@ -1345,7 +1419,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertModifyResourceFilterRequest"
+              "$ref": "#/definitions/alertRetryOutboxesRequest"
             }
           }
         ],
@@ -1354,15 +1428,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/resource_filters": {
+    "/v1/policies": {
       "get": {
-        "summary": "describe resource filters",
-        "operationId": "DescribeResourceFilters",
+        "summary": "describe policies",
+        "operationId": "DescribePolicies",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDescribeResourceFiltersResponse"
+              "$ref": "#/definitions/alertDescribePoliciesResponse"
             }
           }
         },
@@ -1401,7 +1475,7 @@ var Files = map[string]string{
             "format": "int64"
           },
           {
-            "name": "rs_filter_id",
+            "name": "policy_id",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1411,7 +1485,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "rs_filter_name",
+            "name": "policy_name",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1421,7 +1495,17 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "status",
+            "name": "policy_description",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "creator",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1446,13 +1530,13 @@ var Files = map[string]string{
         ]
       },
       "delete": {
-        "summary": "delete resource filters",
-        "operationId": "DeleteResourceFilters",
+        "summary": "delete policies",
+        "operationId": "DeletePolicies",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDeleteResourceFiltersResponse"
+              "$ref": "#/definitions/alertDeletePoliciesResponse"
             }
           }
         },
@@ -1462,7 +1546,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertDeleteResourceFiltersRequest"
+              "$ref": "#/definitions/alertDeletePoliciesRequest"
             }
           }
         ],
@@ -1471,15 +1555,15 @@ var Files = map[string]string{
         ]
       }
     },
-    "/v1/resource_type": {
+    "/v1/policy": {
       "post": {
-        "summary": "create resource type",
-        "operationId": "CreateResourceType",
+        "summary": "create policy",
+        "operationId": "CreatePolicy",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertCreateResourceTypeResponse"
+              "$ref": "#/definitions/alertCreatePolicyResponse"
             }
           }
         },
@@ -1489,7 +1573,228 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertCreateResourceTypeRequest"
+              "$ref": "#/definitions/alertCreatePolicyRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify policy",
+        "operationId": "ModifyPolicy",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifyPolicyResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifyPolicyRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/resource_filter": {
+      "post": {
+        "summary": "create resource filter",
+        "operationId": "CreateResourceFilter",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateResourceFilterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateResourceFilterRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify resource filter",
+        "operationId": "ModifyResourceFilter",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifyResourceFilterResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifyResourceFilterRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/resource_filters": {
+      "get": {
+        "summary": "describe resource filters",
+        "operationId": "DescribeResourceFilters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDescribeResourceFiltersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "rs_filter_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "rs_filter_name",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "status",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "rs_type_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete resource filters",
+        "operationId": "DeleteResourceFilters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDeleteResourceFiltersResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertDeleteResourceFiltersRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/resource_type": {
+      "post": {
+        "summary": "create resource type",
+        "operationId": "CreateResourceType",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateResourceTypeResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateResourceTypeRequest"
             }
           }
         ],
@@ -1771,64 +2076,406 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "metrics_type",
+            "name": "metrics_type",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "condition_type",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "thresholds",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "unit",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "consecutive_count",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "inhibit",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "boolean",
+              "format": "boolean"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "policy_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "metric_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete rules",
+        "operationId": "DeleteRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDeleteRulesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertDeleteRulesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/silence": {
+      "post": {
+        "summary": "create silence",
+        "operationId": "CreateSilence",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateSilenceResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateSilenceRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify silence",
+        "operationId": "ModifySilence",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifySilenceResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifySilenceRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/silences": {
+      "get": {
+        "summary": "describe silences",
+        "operationId": "DescribeSilences",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDescribeSilencesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "sort_key",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "reverse",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          },
+          {
+            "name": "offset",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "limit",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int64"
+          },
+          {
+            "name": "silence_id",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "creator",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multimulti"
+          },
+          {
+            "name": "active",
+            "in": "query",
+            "required": false,
+            "type": "boolean",
+            "format": "boolean"
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "delete": {
+        "summary": "delete silences",
+        "operationId": "DeleteSilences",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDeleteSilencesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertDeleteSilencesRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/template": {
+      "post": {
+        "summary": "create template",
+        "operationId": "CreateTemplate",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertCreateTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertCreateTemplateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      },
+      "patch": {
+        "summary": "modify template",
+        "operationId": "ModifyTemplate",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertModifyTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertModifyTemplateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/template/preview": {
+      "post": {
+        "summary": "preview template",
+        "operationId": "PreviewTemplate",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertPreviewTemplateResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertPreviewTemplateRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManager"
+        ]
+      }
+    },
+    "/v1/templates": {
+      "get": {
+        "summary": "describe templates",
+        "operationId": "DescribeTemplates",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertDescribeTemplatesResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "search_word",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multimulti"
+            "type": "string"
           },
           {
-            "name": "condition_type",
+            "name": "sort_key",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multimulti"
+            "type": "string"
           },
           {
-            "name": "thresholds",
+            "name": "reverse",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multimulti"
+            "type": "boolean",
+            "format": "boolean"
           },
           {
-            "name": "unit",
+            "name": "offset",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multimulti"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "consecutive_count",
+            "name": "limit",
             "in": "query",
             "required": false,
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "collectionFormat": "multimulti"
+            "type": "integer",
+            "format": "int64"
           },
           {
-            "name": "inhibit",
+            "name": "template_id",
             "in": "query",
             "required": false,
             "type": "array",
             "items": {
-              "type": "boolean",
-              "format": "boolean"
+              "type": "string"
             },
             "collectionFormat": "multimulti"
           },
@@ -1843,7 +2490,7 @@ var Files = map[string]string{
             "collectionFormat": "multimulti"
           },
           {
-            "name": "metric_id",
+            "name": "language",
             "in": "query",
             "required": false,
             "type": "array",
@@ -1858,13 +2505,13 @@ var Files = map[string]string{
         ]
       },
       "delete": {
-        "summary": "delete rules",
-        "operationId": "DeleteRules",
+        "summary": "delete templates",
+        "operationId": "DeleteTemplates",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/alertDeleteRulesResponse"
+              "$ref": "#/definitions/alertDeleteTemplatesResponse"
             }
           }
         },
@@ -1874,7 +2521,7 @@ var Files = map[string]string{
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/alertDeleteRulesRequest"
+              "$ref": "#/definitions/alertDeleteTemplatesRequest"
             }
           }
         ],
@@ -1883,6 +2530,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/alert_acknowledge": {
+      "post": {
+        "summary": "acknowledge firing resource of alert rule to stop repeat notifications",
+        "operationId": "AcknowledgeAlert",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertAcknowledgeAlertResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertAcknowledgeAlertRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManagerCustom"
+        ]
+      }
+    },
     "/v1/alert_details": {
       "get": {
         "summary": "describe alert details",
@@ -2022,6 +2696,33 @@ var Files = map[string]string{
         ]
       }
     },
+    "/v1/alert_snooze": {
+      "post": {
+        "summary": "snooze or unsnooze notifications of one resource of alert rule",
+        "operationId": "SnoozeAlert",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertSnoozeAlertResponse"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertSnoozeAlertRequest"
+            }
+          }
+        ],
+        "tags": [
+          "AlertManagerCustom"
+        ]
+      }
+    },
     "/v1/alert_status": {
       "get": {
         "summary": "describe alert status",
@@ -2465,6 +3166,9 @@ var Files = map[string]string{
         },
         "nf_address_list_id": {
           "type": "string"
+        },
+        "escalation_config": {
+          "type": "string"
         }
       },
       "title": "9.Action\n********************************************************************************************************"
@@ -2551,6 +3255,9 @@ var Files = map[string]string{
         },
         "nf_address_list_id": {
           "type": "string"
+        },
+        "escalation_config": {
+          "type": "string"
         }
       }
     },
@@ -2660,6 +3367,38 @@ var Files = map[string]string{
         }
       }
     },
+    "alertCreateInhibitRuleRequest": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_name": {
+          "type": "string"
+        },
+        "source_rs_type_name": {
+          "type": "string"
+        },
+        "source_severity": {
+          "type": "string"
+        },
+        "target_rs_type_name": {
+          "type": "string"
+        },
+        "equal_label": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "alertCreateInhibitRuleResponse": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertCreateMetricRequest": {
       "type": "object",
       "properties": {
@@ -2711,6 +3450,15 @@ var Files = map[string]string{
         },
         "language": {
           "type": "string"
+        },
+        "available_schedule": {
+          "type": "string"
+        },
+        "group_config": {
+          "type": "string"
+        },
+        "route_config": {
+          "type": "string"
         }
       }
     },
@@ -2808,6 +3556,23 @@ var Files = map[string]string{
         },
         "metric_id": {
           "type": "string"
+        },
+        "predict_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodata_state": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_clear_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
         }
       }
     },
@@ -2819,6 +3584,64 @@ var Files = map[string]string{
         }
       }
     },
+    "alertCreateSilenceRequest": {
+      "type": "object",
+      "properties": {
+        "creator": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "matchers": {
+          "type": "string"
+        },
+        "start_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "end_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "alertCreateSilenceResponse": {
+      "type": "object",
+      "properties": {
+        "silence_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertCreateTemplateRequest": {
+      "type": "object",
+      "properties": {
+        "policy_id": {
+          "type": "string"
+        },
+        "language": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "content": {
+          "type": "string"
+        }
+      }
+    },
+    "alertCreateTemplateResponse": {
+      "type": "object",
+      "properties": {
+        "template_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertDeleteActionsRequest": {
       "type": "object",
       "properties": {
@@ -2929,6 +3752,28 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDeleteInhibitRulesRequest": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteInhibitRulesResponse": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "alertDeleteMetricsRequest": {
       "type": "object",
       "properties": {
@@ -2987,7 +3832,51 @@ var Files = map[string]string{
     "alertDeleteResourceFiltersResponse": {
       "type": "object",
       "properties": {
-        "rs_filter_id": {
+        "rs_filter_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteResourceTypesRequest": {
+      "type": "object",
+      "properties": {
+        "rs_type_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteResourceTypesResponse": {
+      "type": "object",
+      "properties": {
+        "rs_type_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteRulesRequest": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "alertDeleteRulesResponse": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
           "type": "array",
           "items": {
             "type": "string"
@@ -2995,10 +3884,10 @@ var Files = map[string]string{
         }
       }
     },
-    "alertDeleteResourceTypesRequest": {
+    "alertDeleteSilencesRequest": {
       "type": "object",
       "properties": {
-        "rs_type_id": {
+        "silence_id": {
           "type": "array",
           "items": {
             "type": "string"
@@ -3006,10 +3895,10 @@ var Files = map[string]string{
         }
       }
     },
-    "alertDeleteResourceTypesResponse": {
+    "alertDeleteSilencesResponse": {
       "type": "object",
       "properties": {
-        "rs_type_id": {
+        "silence_id": {
           "type": "array",
           "items": {
             "type": "string"
@@ -3017,10 +3906,10 @@ var Files = map[string]string{
         }
       }
     },
-    "alertDeleteRulesRequest": {
+    "alertDeleteTemplatesRequest": {
       "type": "object",
       "properties": {
-        "rule_id": {
+        "template_id": {
           "type": "array",
           "items": {
             "type": "string"
@@ -3028,10 +3917,10 @@ var Files = map[string]string{
         }
       }
     },
-    "alertDeleteRulesResponse": {
+    "alertDeleteTemplatesResponse": {
       "type": "object",
       "properties": {
-        "rule_id": {
+        "template_id": {
           "type": "array",
           "items": {
             "type": "string"
@@ -3114,6 +4003,21 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDescribeInhibitRulesResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "inhibit_rule_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertInhibitRule"
+          }
+        }
+      }
+    },
     "alertDescribeMetricsResponse": {
       "type": "object",
       "properties": {
@@ -3129,6 +4033,21 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDescribeOutboxesResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "outbox_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertOutbox"
+          }
+        }
+      }
+    },
     "alertDescribePoliciesResponse": {
       "type": "object",
       "properties": {
@@ -3189,6 +4108,59 @@ var Files = map[string]string{
         }
       }
     },
+    "alertDescribeSilencesResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "silence_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertSilence"
+          }
+        }
+      }
+    },
+    "alertDescribeTemplatesResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "template_set": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertTemplate"
+          }
+        }
+      }
+    },
+    "alertDiscardOutboxesRequest": {
+      "type": "object",
+      "properties": {
+        "outbox_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "description": "Discard stops delivery of pending or dead outboxes."
+    },
+    "alertDiscardOutboxesResponse": {
+      "type": "object",
+      "properties": {
+        "outbox_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "alertExecutor": {
       "type": "object",
       "properties": {
@@ -3250,6 +4222,42 @@ var Files = map[string]string{
       },
       "title": "7.History\n********************************************************************************************************"
     },
+    "alertInhibitRule": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "string"
+        },
+        "inhibit_rule_name": {
+          "type": "string"
+        },
+        "source_rs_type_name": {
+          "type": "string"
+        },
+        "source_severity": {
+          "type": "string"
+        },
+        "target_rs_type_name": {
+          "type": "string"
+        },
+        "equal_label": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "10.InhibitRule\n********************************************************************************************************"
+    },
     "alertMetric": {
       "type": "object",
       "properties": {
@@ -3299,6 +4307,13 @@ var Files = map[string]string{
         },
         "nf_address_list_id": {
           "type": "string"
+        },
+        "escalation_config": {
+          "type": "string"
+        },
+        "clear_escalation_config": {
+          "type": "boolean",
+          "format": "boolean"
         }
       }
     },
@@ -3423,6 +4438,41 @@ var Files = map[string]string{
         }
       }
     },
+    "alertModifyInhibitRuleRequest": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "string"
+        },
+        "inhibit_rule_name": {
+          "type": "string"
+        },
+        "source_rs_type_name": {
+          "type": "string"
+        },
+        "source_severity": {
+          "type": "string"
+        },
+        "target_rs_type_name": {
+          "type": "string"
+        },
+        "equal_label": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      }
+    },
+    "alertModifyInhibitRuleResponse": {
+      "type": "object",
+      "properties": {
+        "inhibit_rule_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertModifyMetricRequest": {
       "type": "object",
       "properties": {
@@ -3480,6 +4530,27 @@ var Files = map[string]string{
         },
         "language": {
           "type": "string"
+        },
+        "available_schedule": {
+          "type": "string"
+        },
+        "group_config": {
+          "type": "string"
+        },
+        "route_config": {
+          "type": "string"
+        },
+        "clear_available_schedule": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "clear_group_config": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "clear_route_config": {
+          "type": "boolean",
+          "format": "boolean"
         }
       }
     },
@@ -3506,90 +4577,223 @@ var Files = map[string]string{
         "status": {
           "type": "string"
         },
-        "rs_type_id": {
+        "rs_type_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyResourceFilterResponse": {
+      "type": "object",
+      "properties": {
+        "rs_filter_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyResourceTypeRequest": {
+      "type": "object",
+      "properties": {
+        "rs_type_id": {
+          "type": "string"
+        },
+        "rs_type_name": {
+          "type": "string"
+        },
+        "rs_type_param": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyResourceTypeResponse": {
+      "type": "object",
+      "properties": {
+        "rs_type_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyRuleRequest": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
+          "type": "string"
+        },
+        "rule_name": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "monitor_periods": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "metrics_type": {
+          "type": "string"
+        },
+        "condition_type": {
+          "type": "string"
+        },
+        "thresholds": {
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "consecutive_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "inhibit": {
+          "type": "boolean",
+          "format": "boolean"
+        },
+        "predict_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodata_state": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_clear_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifyRuleResponse": {
+      "type": "object",
+      "properties": {
+        "rule_id": {
+          "type": "string"
+        }
+      }
+    },
+    "alertModifySilenceRequest": {
+      "type": "object",
+      "properties": {
+        "silence_id": {
+          "type": "string"
+        },
+        "creator": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "matchers": {
           "type": "string"
+        },
+        "start_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "end_time": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "alertModifyResourceFilterResponse": {
+    "alertModifySilenceResponse": {
       "type": "object",
       "properties": {
-        "rs_filter_id": {
+        "silence_id": {
           "type": "string"
         }
       }
     },
-    "alertModifyResourceTypeRequest": {
+    "alertModifyTemplateRequest": {
       "type": "object",
       "properties": {
-        "rs_type_id": {
+        "template_id": {
           "type": "string"
         },
-        "rs_type_name": {
+        "format": {
           "type": "string"
         },
-        "rs_type_param": {
+        "title": {
+          "type": "string"
+        },
+        "content": {
           "type": "string"
         }
       }
     },
-    "alertModifyResourceTypeResponse": {
+    "alertModifyTemplateResponse": {
       "type": "object",
       "properties": {
-        "rs_type_id": {
+        "template_id": {
           "type": "string"
         }
       }
     },
-    "alertModifyRuleRequest": {
+    "alertOutbox": {
       "type": "object",
       "properties": {
-        "rule_id": {
+        "outbox_id": {
           "type": "string"
         },
-        "rule_name": {
+        "idempotency_key": {
           "type": "string"
         },
-        "disabled": {
-          "type": "boolean",
-          "format": "boolean"
+        "alert_id": {
+          "type": "string"
         },
-        "monitor_periods": {
-          "type": "integer",
-          "format": "int64"
+        "rule_id": {
+          "type": "string"
         },
-        "severity": {
+        "resource_name": {
           "type": "string"
         },
-        "metrics_type": {
+        "notifier": {
           "type": "string"
         },
-        "condition_type": {
+        "addresses": {
           "type": "string"
         },
-        "thresholds": {
+        "title": {
           "type": "string"
         },
-        "unit": {
+        "content": {
           "type": "string"
         },
-        "consecutive_count": {
+        "status": {
+          "type": "string"
+        },
+        "attempts": {
           "type": "integer",
           "format": "int64"
         },
-        "inhibit": {
-          "type": "boolean",
-          "format": "boolean"
-        }
-      }
-    },
-    "alertModifyRuleResponse": {
-      "type": "object",
-      "properties": {
-        "rule_id": {
+        "last_error": {
           "type": "string"
+        },
+        "notification_id": {
+          "type": "string"
+        },
+        "next_attempt_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
         }
-      }
+      },
+      "title": "13.Outbox\n********************************************************************************************************"
     },
     "alertPolicy": {
       "type": "object",
@@ -3628,10 +4832,64 @@ var Files = map[string]string{
         },
         "language": {
           "type": "string"
+        },
+        "available_schedule": {
+          "type": "string",
+          "title": "json of timezone, windows and exclude dates, takes the place of available start and end time"
+        },
+        "group_config": {
+          "type": "string",
+          "title": "json of group by, group wait and group interval, empty sends one notification for each resource"
+        },
+        "route_config": {
+          "type": "string",
+          "title": "json of routing tree, routes matching severity, rule name, resource name pattern or labels to address lists and repeat configs"
         }
       },
       "title": "4.Policy\n********************************************************************************************************"
     },
+    "alertPreviewTemplateRequest": {
+      "type": "object",
+      "properties": {
+        "template_id": {
+          "type": "string"
+        },
+        "policy_id": {
+          "type": "string"
+        },
+        "language": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "content": {
+          "type": "string"
+        },
+        "notification_param": {
+          "type": "string"
+        },
+        "resume": {
+          "type": "boolean",
+          "format": "boolean"
+        }
+      },
+      "description": "Preview renders title and content if given, or else template of template_id, or else template of policy in language.\nnotification_param is json of notification param, empty to render a sample."
+    },
+    "alertPreviewTemplateResponse": {
+      "type": "object",
+      "properties": {
+        "title": {
+          "type": "string"
+        },
+        "content": {
+          "type": "string"
+        }
+      }
+    },
     "alertResourceFilter": {
       "type": "object",
       "properties": {
@@ -3684,6 +4942,29 @@ var Files = map[string]string{
       },
       "title": "1.ResourceType\n********************************************************************************************************"
     },
+    "alertRetryOutboxesRequest": {
+      "type": "object",
+      "properties": {
+        "outbox_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "description": "Retry delivers pending, dead or discarded outboxes again from the first attempt."
+    },
+    "alertRetryOutboxesResponse": {
+      "type": "object",
+      "properties": {
+        "outbox_id": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "alertRule": {
       "type": "object",
       "properties": {
@@ -3737,10 +5018,118 @@ var Files = map[string]string{
         },
         "metric_id": {
           "type": "string"
+        },
+        "predict_horizon": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodata_state": {
+          "type": "string"
+        },
+        "recovery_thresholds": {
+          "type": "string"
+        },
+        "consecutive_clear_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "levels": {
+          "type": "string"
         }
       },
       "title": "5.Rule\n********************************************************************************************************"
     },
+    "alertSilence": {
+      "type": "object",
+      "properties": {
+        "silence_id": {
+          "type": "string"
+        },
+        "creator": {
+          "type": "string"
+        },
+        "comment": {
+          "type": "string"
+        },
+        "matchers": {
+          "type": "string"
+        },
+        "start_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "end_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "11.Silence\n********************************************************************************************************"
+    },
+    "alertTemplate": {
+      "type": "object",
+      "properties": {
+        "template_id": {
+          "type": "string"
+        },
+        "policy_id": {
+          "type": "string"
+        },
+        "language": {
+          "type": "string"
+        },
+        "format": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "content": {
+          "type": "string"
+        },
+        "create_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "update_time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      },
+      "title": "12.Template\n********************************************************************************************************"
+    },
+    "alertAcknowledgeAlertRequest": {
+      "type": "object",
+      "properties": {
+        "alert_id": {
+          "type": "string"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "resource_name": {
+          "type": "string"
+        },
+        "acknowledger": {
+          "type": "string"
+        }
+      }
+    },
+    "alertAcknowledgeAlertResponse": {
+      "type": "object",
+      "properties": {
+        "alert_id": {
+          "type": "string"
+        }
+      }
+    },
     "alertAlertDetail": {
       "type": "object",
       "properties": {
@@ -3818,7 +5207,26 @@ var Files = map[string]string{
           "type": "string"
         },
         "nf_address_list_id": {
+          "type": "string",
+          "title": "nf_address_list_id and escalation_config are of the first action of policy, actions lists all of them"
+        },
+        "available_schedule": {
+          "type": "string"
+        },
+        "group_config": {
+          "type": "string"
+        },
+        "escalation_config": {
+          "type": "string"
+        },
+        "route_config": {
           "type": "string"
+        },
+        "actions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertAction"
+          }
         }
       }
     },
@@ -4004,7 +5412,7 @@ var Files = map[string]string{
           "format": "date-time"
         }
       },
-      "title": "1.History\r\n********************************************************************************************************"
+      "title": "1.History\n********************************************************************************************************"
     },
     "alertResourceStatus": {
       "type": "object",
@@ -4032,6 +5440,45 @@ var Files = map[string]string{
         },
         "aggregated_alerts": {
           "type": "string"
+        },
+        "acknowledger": {
+          "type": "string"
+        },
+        "acknowledge_time": {
+          "type": "string"
+        },
+        "snooze_until": {
+          "type": "string"
+        }
+      }
+    },
+    "alertSnoozeAlertRequest": {
+      "type": "object",
+      "properties": {
+        "alert_id": {
+          "type": "string"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "resource_name": {
+          "type": "string"
+        },
+        "snooze_minutes": {
+          "type": "integer",
+          "format": "int64",
+          "title": "snooze minutes from now, 0 clears snooze"
+        },
+        "operator": {
+          "type": "string"
+        }
+      }
+    },
+    "alertSnoozeAlertResponse": {
+      "type": "object",
+      "properties": {
+        "alert_id": {
+          "type": "string"
         }
       }
     }
@@ -61,37 +61,3 @@ func SendMetricRequest(metricParam string) string {
 	}
 	return ""
 }
-
-func SendEmailRequest(notificationParam string, resume string, language string) string {
-	cfg := config.GetInstance()
-	url := fmt.Sprintf("http://127.0.0.1:%s/api/v1/email", cfg.App.AdapterPort)
-	request, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		logger.Error(nil, "SendEmailRequest NewRequest error:", err)
-		return ""
-	}
-
-	params := request.URL.Query()
-	params.Add("notification_param", notificationParam)
-	params.Add("resume", resume)
-	params.Add("language", language)
-	request.URL.RawQuery = params.Encode()
-
-	logger.Debug(nil, "SendEmailRequest %s", request.URL.RawQuery)
-
-	response, err := client.Do(request)
-	if err != nil {
-		logger.Error(nil, "SendEmailRequest get error:", err)
-	} else {
-		defer response.Body.Close()
-
-		contents, err := ioutil.ReadAll(response.Body)
-
-		if err != nil {
-			logger.Error(nil, "SendEmailRequest read error:", err.Error())
-		}
-
-		return string(contents)
-	}
-	return ""
-}
@@ -3,9 +3,11 @@ package notification
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
-	"time"
 
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 
@@ -14,29 +16,33 @@ import (
 	"kubesphere.io/alert/pkg/logger"
 )
 
-var nfClient *grpc.ClientConn
+var (
+	nfClient     *grpc.ClientConn
+	nfClientLock sync.Mutex
+)
 
+//getNotificationConn dials notification service once and shares the connection by all workers,
+//dial does not block, calls wait for the connection to be ready instead.
 func getNotificationConn(svcAddress string) (*grpc.ClientConn, error) {
+	nfClientLock.Lock()
+	defer nfClientLock.Unlock()
+
 	if nfClient != nil {
 		return nfClient, nil
 	}
 
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
-
 	keepAlive := keepalive.ClientParameters{
-		30 * time.Second,
-		10 * time.Second,
-		true,
+		Time:                30 * time.Second,
+		Timeout:             10 * time.Second,
+		PermitWithoutStream: true,
 	}
 
-	var err error
-
-	nfClient, err = grpc.DialContext(ctx, svcAddress, grpc.WithInsecure(), grpc.WithKeepaliveParams(keepAlive))
-
+	conn, err := grpc.Dial(svcAddress, grpc.WithInsecure(), grpc.WithKeepaliveParams(keepAlive))
 	if err != nil {
 		return nil, err
 	}
 
+	nfClient = conn
 	return nfClient, nil
 }
 
@@ -58,13 +64,12 @@ func SendNotification(method string, receiver string, title string, content stri
 		return false, ""
 	}
 
-	// sleep a few millsecond for grpc dial etcd
-	time.Sleep(time.Millisecond * 500)
 	clientX := pb.NewNotificationClient(conn)
 
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-	resp, err := clientX.CreateNotification(ctx, &pb.CreateNotificationRequest{ContentType: &wrappers.StringValue{Value: method}, Title: &wrappers.StringValue{Value: title}, Content: &wrappers.StringValue{Value: content}, ExpiredDays: &wrappers.UInt32Value{Value: 0}, Owner: &wrappers.StringValue{Value: "KubeSphere"}, AddressInfo: &wrappers.StringValue{Value: receiver}})
+	resp, err := clientX.CreateNotification(ctx, &pb.CreateNotificationRequest{ContentType: &wrappers.StringValue{Value: method}, Title: &wrappers.StringValue{Value: title}, Content: &wrappers.StringValue{Value: content}, ExpiredDays: &wrappers.UInt32Value{Value: 0}, Owner: &wrappers.StringValue{Value: "KubeSphere"}, AddressInfo: &wrappers.StringValue{Value: receiver}}, grpc.WaitForReady(true))
 	if err != nil {
 		logger.Error(nil, "SendNotification CreateNotification failed %v", err)
 		return false, ""
@@ -81,13 +86,12 @@ func GetNotificationStatus(notificationIds []string) map[string][]string {
 		return nil
 	}
 
-	// sleep a few millsecond for grpc dial etcd
-	time.Sleep(time.Millisecond * 500)
 	clientX := pb.NewNotificationClient(conn)
 
-	ctx, _ := context.WithTimeout(context.Background(), 10*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-	resp, err := clientX.DescribeTasks(ctx, &pb.DescribeTasksRequest{NotificationId: notificationIds})
+	resp, err := clientX.DescribeTasks(ctx, &pb.DescribeTasksRequest{NotificationId: notificationIds}, grpc.WaitForReady(true))
 	if err != nil {
 		logger.Error(nil, "GetNotificationStatus DescribeTasks failed %v", err)
 		return nil
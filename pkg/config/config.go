@@ -52,6 +52,8 @@ type Config struct {
 
 		AdapterPort string `default:"8080"`
 	}
+
+	Executor ExecutorConfig
 }
 
 var instance *Config
@@ -69,6 +71,32 @@ type GrpcConfig struct {
 	ShowErrorCause bool `default:"false"` // show grpc error cause to frontend
 }
 
+type ExecutorConfig struct {
+	FlapWindow    uint32 `default:"30"` // minutes of sliding window to count state transitions of a resource
+	FlapThreshold uint32 `default:"6"`  // transitions in window to start flapping, stop when below half of it, 0 to disable
+
+	StormWindow          uint32 `default:"60"`  // seconds of window to count notifications of all executors
+	StormThreshold       uint32 `default:"200"` // notifications in window to start summary mode, stop when below half of it, 0 to disable
+	StormSummaryInterval uint32 `default:"300"` // seconds between summary notifications of an address list in summary mode
+
+	WebhookTimeout uint32 `default:"10"` // seconds to wait for response of webhook, slack, dingtalk and wechat notifiers
+
+	OutboxRetryInterval    uint32 `default:"30"`   // seconds before the first retry of a failed notification, doubled for each retry
+	OutboxMaxRetryInterval uint32 `default:"3600"` // seconds of max interval between retries
+	OutboxMaxAttempts      uint32 `default:"10"`   // attempts before a notification is dead
+	OutboxRetentionDays    uint32 `default:"7"`    // days to keep sent and discarded notifications
+
+	DispatchWorkers   uint32 `default:"4"`   // workers of an executor delivering notifications concurrently
+	DispatchQueueSize uint32 `default:"100"` // outboxes claimed by an executor waiting for workers, no more are claimed when it is full
+
+	SmtpHost     string `default:""`   // smtp server of smtp notifier, empty to disable
+	SmtpPort     string `default:"25"` // smtp port of smtp notifier
+	SmtpUsername string `default:""`   // plain auth username of smtp notifier, empty to send without auth
+	SmtpPassword string `default:""`   // plain auth password of smtp notifier
+	SmtpFrom     string `default:""`   // sender address of smtp notifier
+	SmtpTimeout  uint32 `default:"30"` // seconds to wait for connecting and each exchange with smtp server
+}
+
 type LogConfig struct {
 	Level string `default:"debug"` // debug, info, warn, error, fatal
 }
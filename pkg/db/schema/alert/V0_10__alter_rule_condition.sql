@@ -0,0 +1 @@
+ALTER TABLE rule MODIFY condition_type varchar(255) NOT NULL COMMENT 'comparator >, <, >=, <=, ==, != or expression, eg. value > 80 && value < 95';
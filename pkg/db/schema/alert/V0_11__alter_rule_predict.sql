@@ -0,0 +1,2 @@
+ALTER TABLE rule MODIFY metrics_type varchar(20) COMMENT 'instant/last/avg/min/max/count/sum/p95/rate/delta/deriv/predict_linear';
+ALTER TABLE rule ADD COLUMN predict_horizon int DEFAULT 0 NOT NULL COMMENT 'unit：minute';
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN nodata_state varchar(20) DEFAULT 'keep_last' NOT NULL COMMENT 'nodata, keep_last, ok';
@@ -0,0 +1,2 @@
+ALTER TABLE rule ADD COLUMN recovery_thresholds varchar(50) DEFAULT '' NOT NULL COMMENT 'threshold to clear alert, empty means same as thresholds';
+ALTER TABLE rule ADD COLUMN consecutive_clear_count int DEFAULT 1 NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE rule ADD COLUMN levels varchar(255) DEFAULT '' NOT NULL COMMENT 'more severe levels, eg. [{"thresholds":"95","severity":"critical"}]';
@@ -0,0 +1,15 @@
+CREATE TABLE inhibit_rule
+(
+	inhibit_rule_id varchar(50) NOT NULL,
+	inhibit_rule_name varchar(50) NOT NULL,
+	source_rs_type_name varchar(50) NOT NULL COMMENT 'resource type of firing alert, eg. node',
+	source_severity varchar(50) DEFAULT '' NOT NULL COMMENT 'minimal severity of firing alert, empty means any',
+	target_rs_type_name varchar(50) NOT NULL COMMENT 'resource type of muted alert, eg. pod',
+	equal_label varchar(50) NOT NULL COMMENT 'key in rs_filter_param of muted alert naming the source resource, eg. node_id',
+	disabled bool DEFAULT false NOT NULL,
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (inhibit_rule_id)
+);
+
+CREATE INDEX index_inhibit_rule_target_rs_type_name ON inhibit_rule(target_rs_type_name(50));
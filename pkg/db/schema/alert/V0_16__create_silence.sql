@@ -0,0 +1,14 @@
+CREATE TABLE silence
+(
+	silence_id varchar(50) NOT NULL,
+	creator varchar(50) NOT NULL,
+	comment varchar(255) DEFAULT '' NOT NULL,
+	matchers text NOT NULL COMMENT 'eg. {"alert_name":"alert-1","rs_filter_param":{"node_id":"node1"}}',
+	start_time datetime(3) COMMENT 'datetime(3)',
+	end_time datetime(3) COMMENT 'datetime(3)',
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (silence_id)
+);
+
+CREATE INDEX index_silence_end_time ON silence(end_time);
@@ -0,0 +1 @@
+ALTER TABLE policy ADD COLUMN available_schedule text COMMENT 'eg. {"timezone":"Asia/Shanghai","windows":[{"weekdays":["mon","fri"],"start_time":"09:00:00","end_time":"18:00:00"}],"exclude_dates":["2019-10-01"]}';
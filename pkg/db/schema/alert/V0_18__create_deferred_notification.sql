@@ -0,0 +1,17 @@
+CREATE TABLE deferred_notification
+(
+	deferred_id varchar(50) NOT NULL,
+	alert_id varchar(50) NOT NULL,
+	rule_id varchar(50) NOT NULL,
+	resource_name varchar(255) NOT NULL,
+	status varchar(50) NOT NULL COMMENT 'firing or resumed',
+	cumulated_count int DEFAULT 0 NOT NULL,
+	first_alert_time varchar(50) DEFAULT '' NOT NULL,
+	last_alert_time varchar(50) DEFAULT '' NOT NULL,
+	last_value varchar(255) DEFAULT '' NOT NULL,
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (deferred_id)
+);
+
+CREATE UNIQUE INDEX index_deferred_notification_resource ON deferred_notification(alert_id, rule_id, resource_name);
@@ -0,0 +1 @@
+ALTER TABLE policy ADD COLUMN group_config text COMMENT 'eg. {"group_by":["rule"],"group_wait":30,"group_interval":300}';
@@ -0,0 +1 @@
+ALTER TABLE action ADD COLUMN escalation_config text COMMENT 'eg. {"severities":["critical"],"steps":[{"delay":30,"nf_address_list_id":"nfl-1"}]}';
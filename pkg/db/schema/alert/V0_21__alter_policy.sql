@@ -0,0 +1 @@
+ALTER TABLE policy ADD COLUMN route_config text COMMENT 'eg. {"routes":[{"severities":["critical"],"nf_address_list_id":"nfl-1"}]}';
@@ -0,0 +1 @@
+ALTER TABLE action MODIFY nf_address_list_id varchar(1000) NOT NULL COMMENT 'address list id, webhook url or email addresses separated by comma according to trigger_action';
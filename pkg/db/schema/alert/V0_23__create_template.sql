@@ -0,0 +1,14 @@
+CREATE TABLE template
+(
+	template_id varchar(50) NOT NULL,
+	policy_id varchar(50) DEFAULT '' NOT NULL COMMENT 'empty for templates of all policies',
+	language varchar(8) DEFAULT '' NOT NULL,
+	format varchar(10) DEFAULT 'text' NOT NULL COMMENT 'text or html',
+	title text NOT NULL COMMENT 'go text/template, eg. [{{.AlertName}}] {{.RuleName}} on {{.ResourceName}}',
+	content text NOT NULL COMMENT 'go text/template or html/template according to format',
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (template_id)
+);
+
+CREATE UNIQUE INDEX index_template_policy_language ON template(policy_id, language);
@@ -0,0 +1,24 @@
+CREATE TABLE outbox
+(
+	outbox_id varchar(50) NOT NULL,
+	idempotency_key varchar(50) NOT NULL COMMENT 'sha1 of alert, notification key and receivers',
+	alert_id varchar(50) DEFAULT '' NOT NULL,
+	rule_id varchar(50) DEFAULT '' NOT NULL,
+	resource_name varchar(255) DEFAULT '' NOT NULL,
+	notifier varchar(50) NOT NULL COMMENT 'trigger action of action',
+	addresses text NOT NULL COMMENT 'json list of address list ids, urls or email addresses',
+	title text NOT NULL,
+	content mediumtext NOT NULL,
+	html bool DEFAULT false NOT NULL,
+	status varchar(20) NOT NULL COMMENT 'pending, sending, sent, dead, discarded',
+	attempts int unsigned DEFAULT 0 NOT NULL,
+	last_error text,
+	notification_id varchar(255) DEFAULT '' NOT NULL,
+	next_attempt_time datetime(3) COMMENT 'datetime(3)',
+	create_time datetime(3) COMMENT 'datetime(3)',
+	update_time datetime(3) COMMENT 'datetime(3)',
+	PRIMARY KEY (outbox_id)
+);
+
+CREATE UNIQUE INDEX index_outbox_idempotency_key ON outbox(idempotency_key);
+CREATE INDEX index_outbox_status_next_attempt_time ON outbox(status, next_attempt_time);
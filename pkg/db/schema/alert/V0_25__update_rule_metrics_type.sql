@@ -0,0 +1,4 @@
+-- Metrics type was ignored before, every rule was evaluated by the last value.
+-- Rules keeping a supported metrics type, eg. avg, are aggregated by it from now on,
+-- the others, eg. legacy descriptions like 实时, are rewritten to last so that they keep being evaluated as before.
+UPDATE rule SET metrics_type="last" WHERE metrics_type IS NULL OR metrics_type NOT IN ("", "instant", "last", "avg", "min", "max", "count", "sum", "p95", "rate", "delta", "deriv", "predict_linear");
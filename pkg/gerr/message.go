@@ -48,6 +48,16 @@ var (
 		en:   "illegal Time format [%s]",
 		zhCN: "非法的时间格式[%s]",
 	}
+	ErrorIllegalTimeRange = ErrorMessage{
+		Name: "illegal_time_range",
+		en:   "illegal time range [%s, %s]",
+		zhCN: "非法的时间范围[%s, %s]",
+	}
+	ErrorIllegalConditionFormat = ErrorMessage{
+		Name: "illegal_condition_format",
+		en:   "illegal condition format [%s]",
+		zhCN: "非法的条件表达式[%s]",
+	}
 	ErrorDescribeResourcesFailed = ErrorMessage{
 		Name: "describe_resources_failed",
 		en:   "describe resources failed",
@@ -83,4 +93,9 @@ var (
 		en:   "delete resource [%s] failed",
 		zhCN: "删除资源[%s]失败",
 	}
+	ErrorResourceNotFound = ErrorMessage{
+		Name: "resource_not_found",
+		en:   "resource [%s] not found",
+		zhCN: "资源[%s]不存在",
+	}
 )
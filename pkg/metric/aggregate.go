@@ -0,0 +1,214 @@
+package metric
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+//Aggregation functions selected by rule metrics type.
+const (
+	AggregateInstant = "instant"
+	AggregateLast    = "last"
+	AggregateAvg     = "avg"
+	AggregateMax     = "max"
+	AggregateMin     = "min"
+	AggregateSum     = "sum"
+	AggregateP95     = "p95"
+	AggregateCount   = "count"
+	AggregateRate    = "rate"
+	AggregateDelta   = "delta"
+	AggregateDeriv   = "deriv"
+
+	AggregatePredictLinear = "predict_linear"
+)
+
+//IsAggregateSupported reports whether metricsType could be used by rule, empty means last value.
+//predict_linear needs horizon of rule, so it is evaluated by PredictLinear instead of Aggregate.
+func IsAggregateSupported(metricsType string) bool {
+	switch metricsType {
+	case "", AggregateInstant, AggregateLast, AggregateAvg, AggregateMax, AggregateMin, AggregateSum, AggregateP95, AggregateCount,
+		AggregateRate, AggregateDelta, AggregateDeriv, AggregatePredictLinear:
+		return true
+	}
+	return false
+}
+
+type sample struct {
+	t float64
+	v float64
+}
+
+//parseSamples converts samples to float64, unparsable samples like "NaN" from an empty range are skipped.
+func parseSamples(tvs []TV) []sample {
+	samples := make([]sample, 0, len(tvs))
+	for _, tv := range tvs {
+		v, err := strconv.ParseFloat(tv.V, 64)
+		if err != nil || math.IsNaN(v) {
+			continue
+		}
+		samples = append(samples, sample{float64(tv.T), v})
+	}
+	return samples
+}
+
+//ParseValues converts sample values to float64, unparsable samples are skipped.
+func ParseValues(tvs []TV) []float64 {
+	samples := parseSamples(tvs)
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = s.v
+	}
+	return values
+}
+
+//Aggregate reduces the time series into one value according to metricsType.
+func Aggregate(metricsType string, tvs []TV) (float64, error) {
+	//Count valid samples only, like the other aggregations, an empty range counts 0
+	if metricsType == AggregateCount {
+		return float64(len(ParseValues(tvs))), nil
+	}
+
+	switch metricsType {
+	case AggregateRate, AggregateDelta, AggregateDeriv:
+		samples := parseSamples(tvs)
+		if len(samples) < 2 {
+			return 0, fmt.Errorf("%s needs at least 2 valid values in %v", metricsType, tvs)
+		}
+		switch metricsType {
+		case AggregateRate:
+			return rate(samples)
+		case AggregateDelta:
+			return samples[len(samples)-1].v - samples[0].v, nil
+		case AggregateDeriv:
+			return deriv(samples)
+		}
+	}
+
+	values := ParseValues(tvs)
+	if len(values) == 0 {
+		return 0, fmt.Errorf("no valid value in %v", tvs)
+	}
+
+	switch metricsType {
+	case "", AggregateInstant, AggregateLast:
+		return values[len(values)-1], nil
+	case AggregateAvg:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), nil
+	case AggregateMax:
+		max := values[0]
+		for _, v := range values {
+			max = math.Max(max, v)
+		}
+		return max, nil
+	case AggregateMin:
+		min := values[0]
+		for _, v := range values {
+			min = math.Min(min, v)
+		}
+		return min, nil
+	case AggregateSum:
+		sum := 0.0
+		for _, v := range values {
+			sum += v
+		}
+		return sum, nil
+	case AggregateP95:
+		return percentile(values, 0.95), nil
+	}
+
+	return 0, fmt.Errorf("unsupported metrics type [%s]", metricsType)
+}
+
+//percentile uses nearest rank method.
+func percentile(values []float64, p float64) float64 {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+//rate calculates per-second increase of a counter, a decrease of value is treated as counter reset.
+func rate(samples []sample) (float64, error) {
+	increase := 0.0
+	for i := 1; i < len(samples); i++ {
+		if samples[i].v >= samples[i-1].v {
+			increase += samples[i].v - samples[i-1].v
+		} else {
+			increase += samples[i].v
+		}
+	}
+
+	duration := samples[len(samples)-1].t - samples[0].t
+	if duration <= 0 {
+		return 0, fmt.Errorf("rate needs samples in different time")
+	}
+	return increase / duration, nil
+}
+
+//deriv calculates per-second derivative by simple linear regression, so unevenly spaced samples are weighted correctly.
+func deriv(samples []sample) (float64, error) {
+	slope, _, err := linearRegression(samples)
+	return slope, err
+}
+
+//linearRegression returns slope and intercept of least squares fit, intercept is the value at time of the first sample,
+//so that unix time never takes part in the sums and precision is kept.
+func linearRegression(samples []sample) (float64, float64, error) {
+	n := float64(len(samples))
+	t0 := samples[0].t
+	sumT, sumV, sumTT, sumTV := 0.0, 0.0, 0.0, 0.0
+	for _, s := range samples {
+		t := s.t - t0
+		sumT += t
+		sumV += s.v
+		sumTT += t * t
+		sumTV += t * s.v
+	}
+
+	d := n*sumTT - sumT*sumT
+	if d == 0 {
+		return 0, 0, fmt.Errorf("regression needs samples in different time")
+	}
+	slope := (n*sumTV - sumT*sumV) / d
+	intercept := (sumV - slope*sumT) / n
+	return slope, intercept, nil
+}
+
+//PredictLinear predicts value after horizon seconds from the last sample by linear regression,
+//and returns the unix time when the fitted line reaches target, 0 if it never does from the last sample on.
+func PredictLinear(tvs []TV, horizon float64, target float64) (float64, int64, error) {
+	samples := parseSamples(tvs)
+	if len(samples) < 2 {
+		return 0, 0, fmt.Errorf("regression needs at least 2 valid values in %v", tvs)
+	}
+	slope, intercept, err := linearRegression(samples)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	//Time is offset from the first sample like the fit
+	t0 := samples[0].t
+	last := float64(tvs[len(tvs)-1].T) - t0
+	v := slope*(last+horizon) + intercept
+
+	crossTime := int64(0)
+	if slope != 0 && !math.IsInf(target, 0) && !math.IsNaN(target) {
+		cross := (target - intercept) / slope
+		if cross >= last {
+			crossTime = int64(t0 + cross)
+		}
+	}
+
+	return v, crossTime, nil
+}
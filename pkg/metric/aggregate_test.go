@@ -0,0 +1,96 @@
+package metric
+
+import (
+	"math"
+	"testing"
+)
+
+func newTVs(values ...string) []TV {
+	tvs := []TV{}
+	for i, v := range values {
+		tvs = append(tvs, TV{T: int64(i * 60), V: v})
+	}
+	return tvs
+}
+
+func TestAggregate(t *testing.T) {
+	tvs := newTVs("4", "1", "NaN", "7", "3", "5")
+	testCase := map[string]float64{
+		"":             5,
+		AggregateLast:  5,
+		AggregateAvg:   4,
+		AggregateMax:   7,
+		AggregateMin:   1,
+		AggregateSum:   20,
+		AggregateP95:   7,
+		AggregateCount: 5,
+	}
+	for metricsType, expect := range testCase {
+		v, err := Aggregate(metricsType, tvs)
+		if err != nil {
+			t.Fatalf("Aggregate [%s] failed: %+v", metricsType, err)
+		}
+		if v != expect {
+			t.Fatalf("Aggregate [%s] expect [%v] but get [%v]", metricsType, expect, v)
+		}
+	}
+
+	if v, err := Aggregate(AggregateCount, newTVs("NaN", "NaN")); err != nil || v != 0 {
+		t.Fatalf("Aggregate count of NaN samples expect 0 but get [%v] [%v]", v, err)
+	}
+	if _, err := Aggregate(AggregateAvg, newTVs("NaN")); err == nil {
+		t.Fatalf("Aggregate without valid value should fail")
+	}
+	if _, err := Aggregate("median", tvs); err == nil {
+		t.Fatalf("Aggregate unsupported metrics type should fail")
+	}
+}
+
+func TestAggregateChange(t *testing.T) {
+	//Counter reset between 100 and 20, samples are unevenly spaced
+	tvs := []TV{{0, "10"}, {30, "40"}, {60, "100"}, {150, "20"}, {180, "50"}}
+	testCase := map[string]float64{
+		AggregateRate:  (30 + 60 + 20 + 30) / 180.0,
+		AggregateDelta: 40,
+	}
+	for metricsType, expect := range testCase {
+		v, err := Aggregate(metricsType, tvs)
+		if err != nil {
+			t.Fatalf("Aggregate [%s] failed: %+v", metricsType, err)
+		}
+		if v != expect {
+			t.Fatalf("Aggregate [%s] expect [%v] but get [%v]", metricsType, expect, v)
+		}
+	}
+
+	v, err := Aggregate(AggregateDeriv, []TV{{1000, "1"}, {1010, "2"}, {1040, "5"}})
+	if err != nil || v < 0.0999 || v > 0.1001 {
+		t.Fatalf("Aggregate deriv expect [0.1] but get [%v], %+v", v, err)
+	}
+
+	if _, err := Aggregate(AggregateRate, newTVs("1")); err == nil {
+		t.Fatalf("Aggregate rate with one value should fail")
+	}
+}
+
+func TestPredictLinear(t *testing.T) {
+	//Grow 1 per minute from 10
+	for _, t0 := range []int64{0, 1569549600} {
+		tvs := []TV{{t0, "10"}, {t0 + 60, "11"}, {t0 + 120, "12"}, {t0 + 300, "15"}}
+		v, crossTime, err := PredictLinear(tvs, 3600, 100)
+		if err != nil || v < 74.999 || v > 75.001 || crossTime != t0+5400 {
+			t.Fatalf("PredictLinear from [%d] expect [75] crossing 100 at [%d] but get [%v %d], %+v", t0, t0+5400, v, crossTime, err)
+		}
+
+		//Target passed already or never reached
+		for _, target := range []float64{12, math.Inf(1), math.NaN()} {
+			if _, crossTime, _ := PredictLinear(tvs, 3600, target); crossTime != 0 {
+				t.Fatalf("PredictLinear from [%d] expect never crossing [%v] but get [%d]", t0, target, crossTime)
+			}
+		}
+	}
+
+	if _, _, err := PredictLinear([]TV{{0, "10"}, {60, "NaN"}}, 3600, 100); err == nil {
+		t.Fatalf("PredictLinear with one valid value should fail")
+	}
+}
@@ -1,6 +1,11 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
 	"time"
 
 	"kubesphere.io/alert/pkg/pb"
@@ -9,16 +14,56 @@ import (
 )
 
 type Action struct {
-	ActionId        string    `gorm:"column:action_id" json:"action_id"`
-	ActionName      string    `gorm:"column:action_name" json:"action_name"`
-	TriggerStatus   string    `gorm:"column:trigger_status" json:"trigger_status"`
-	TriggerAction   string    `gorm:"column:trigger_action" json:"trigger_action"`
-	CreateTime      time.Time `gorm:"column:create_time" json:"create_time"`
-	UpdateTime      time.Time `gorm:"column:update_time" json:"update_time"`
-	PolicyId        string    `gorm:"column:policy_id" json:"policy_id"`
-	NfAddressListId string    `gorm:"column:nf_address_list_id" json:"nf_address_list_id"`
+	ActionId         string    `gorm:"column:action_id" json:"action_id"`
+	ActionName       string    `gorm:"column:action_name" json:"action_name"`
+	TriggerStatus    string    `gorm:"column:trigger_status" json:"trigger_status"`
+	TriggerAction    string    `gorm:"column:trigger_action" json:"trigger_action"`
+	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
+	PolicyId         string    `gorm:"column:policy_id" json:"policy_id"`
+	NfAddressListId  string    `gorm:"column:nf_address_list_id" json:"nf_address_list_id"`
+	EscalationConfig string    `gorm:"column:escalation_config" json:"escalation_config"`
 }
 
+//Transitions of resource which trigger an action, listed in TriggerStatus separated by comma,
+//eg. "triggered,resumed". Empty TriggerStatus triggers on triggered, repeated and resumed.
+//Escalated is the first notification after resource moves to a higher severity, it never refers to escalation steps
+//of EscalationConfig, which notify their own address lists whatever TriggerStatus is.
+const (
+	TriggerStatusTriggered = "triggered"
+	TriggerStatusRepeated  = "repeated"
+	TriggerStatusResumed   = "resumed"
+	TriggerStatusEscalated = "escalated"
+)
+
+//Notifiers selected by TriggerAction, empty TriggerAction sends by notification service.
+//NfAddressListId of action is the address list id of notification service, url of incoming webhook for webhook,
+//slack, dingtalk and wechat, or email addresses separated by comma for smtp.
+const (
+	TriggerActionNotification = "notification"
+	TriggerActionWebhook      = "webhook"
+	TriggerActionSmtp         = "smtp"
+	TriggerActionSlack        = "slack"
+	TriggerActionDingTalk     = "dingtalk"
+	TriggerActionWeChat       = "wechat"
+)
+
+//EscalationConfig notifies more address lists in order while a resource keeps firing without acknowledgement,
+//eg. {"severities":["critical"],"steps":[{"delay":30,"nf_address_list_id":"nfl-1"},{"delay":60,"nf_address_list_id":"nfl-2"}]}.
+//Empty severities escalates resources of any severity.
+type EscalationConfig struct {
+	Severities []string         `json:"severities"`
+	Steps      []EscalationStep `json:"steps"`
+}
+
+//EscalationStep notifies the address list once resource has been firing for delay minutes.
+type EscalationStep struct {
+	Delay           uint32 `json:"delay"`
+	NfAddressListId string `json:"nf_address_list_id"`
+}
+
+const MaxEscalationSteps = 10
+
 //table name
 const (
 	TableAction = "action"
@@ -39,22 +84,25 @@ const (
 	AcColUpdateTime      = "update_time"
 	AcColPolicyId        = "policy_id"
 	AcColNfAddressListId = "nf_address_list_id"
+
+	AcColEscalationConfig = "escalation_config"
 )
 
 func NewActionId() string {
 	return idutil.GetUuid(ActionIdPrefix)
 }
 
-func NewAction(actionName string, triggerStatus string, triggerAction string, policyId string, nfAddressListId string) *Action {
+func NewAction(actionName string, triggerStatus string, triggerAction string, policyId string, nfAddressListId string, escalationConfig string) *Action {
 	action := &Action{
-		ActionId:        NewActionId(),
-		ActionName:      actionName,
-		TriggerStatus:   triggerStatus,
-		TriggerAction:   triggerAction,
-		CreateTime:      time.Now(),
-		UpdateTime:      time.Now(),
-		PolicyId:        policyId,
-		NfAddressListId: nfAddressListId,
+		ActionId:         NewActionId(),
+		ActionName:       actionName,
+		TriggerStatus:    triggerStatus,
+		TriggerAction:    triggerAction,
+		CreateTime:       time.Now(),
+		UpdateTime:       time.Now(),
+		PolicyId:         policyId,
+		NfAddressListId:  nfAddressListId,
+		EscalationConfig: escalationConfig,
 	}
 	return action
 }
@@ -69,6 +117,7 @@ func ActionToPb(action *Action) *pb.Action {
 	pbAction.UpdateTime = pbutil.ToProtoTimestamp(action.UpdateTime)
 	pbAction.PolicyId = action.PolicyId
 	pbAction.NfAddressListId = action.NfAddressListId
+	pbAction.EscalationConfig = action.EscalationConfig
 	return &pbAction
 }
 
@@ -80,3 +129,94 @@ func ParseAcSet2PbSet(inAcs []*Action) []*pb.Action {
 	}
 	return pbAcs
 }
+
+//ParseEscalationConfig parses escalation config of action, steps should be ordered by increasing delay.
+func ParseEscalationConfig(escalationConfig string) (*EscalationConfig, error) {
+	config := &EscalationConfig{}
+	err := json.Unmarshal([]byte(escalationConfig), config)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Steps) == 0 || len(config.Steps) > MaxEscalationSteps {
+		return nil, fmt.Errorf("escalation steps count should be between 1 and %d", MaxEscalationSteps)
+	}
+
+	for i, step := range config.Steps {
+		if step.NfAddressListId == "" {
+			return nil, fmt.Errorf("escalation step %d has no nf_address_list_id", i+1)
+		}
+		if step.Delay == 0 || (i > 0 && step.Delay <= config.Steps[i-1].Delay) {
+			return nil, fmt.Errorf("escalation step %d delay [%d] should be positive and longer than the previous step", i+1, step.Delay)
+		}
+	}
+
+	for _, severity := range config.Severities {
+		if severity == "" {
+			return nil, fmt.Errorf("empty severity of escalation")
+		}
+	}
+
+	return config, nil
+}
+
+//IsEscalated reports whether resource of severity is escalated.
+func (c *EscalationConfig) IsEscalated(severity string) bool {
+	if len(c.Severities) == 0 {
+		return true
+	}
+	for _, s := range c.Severities {
+		if s == severity {
+			return true
+		}
+	}
+	return false
+}
+
+//ParseTriggerStatus returns transitions triggering action.
+func ParseTriggerStatus(triggerStatus string) ([]string, error) {
+	if triggerStatus == "" {
+		return []string{TriggerStatusTriggered, TriggerStatusRepeated, TriggerStatusResumed}, nil
+	}
+
+	transitions := []string{}
+	for _, transition := range strings.Split(triggerStatus, ",") {
+		transition = strings.TrimSpace(transition)
+		switch transition {
+		case TriggerStatusTriggered, TriggerStatusRepeated, TriggerStatusResumed, TriggerStatusEscalated:
+			transitions = append(transitions, transition)
+		default:
+			return nil, fmt.Errorf("unsupported trigger status [%s]", transition)
+		}
+	}
+	return transitions, nil
+}
+
+//IsTriggerActionSupported reports whether notifier of TriggerAction exists.
+func IsTriggerActionSupported(triggerAction string) bool {
+	switch triggerAction {
+	case "", TriggerActionNotification, TriggerActionWebhook, TriggerActionSmtp, TriggerActionSlack, TriggerActionDingTalk, TriggerActionWeChat:
+		return true
+	}
+	return false
+}
+
+//CheckReceiverAddress checks address notified through notifier of TriggerAction.
+func CheckReceiverAddress(triggerAction string, address string) error {
+	switch triggerAction {
+	case TriggerActionWebhook, TriggerActionSlack, TriggerActionDingTalk, TriggerActionWeChat:
+		u, err := url.Parse(address)
+		if err != nil {
+			return err
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook url [%s]", address)
+		}
+	case TriggerActionSmtp:
+		_, err := mail.ParseAddressList(address)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseEscalationConfig(t *testing.T) {
+	config, err := ParseEscalationConfig(`{"severities":["critical"],"steps":[{"delay":30,"nf_address_list_id":"nfl-1"},{"delay":60,"nf_address_list_id":"nfl-2"}]}`)
+	if err != nil {
+		t.Fatalf("ParseEscalationConfig failed: %+v", err)
+	}
+	if len(config.Steps) != 2 || config.Steps[1].NfAddressListId != "nfl-2" {
+		t.Fatalf("ParseEscalationConfig get wrong steps %+v", config.Steps)
+	}
+	if !config.IsEscalated("critical") || config.IsEscalated("minor") {
+		t.Fatalf("IsEscalated should only escalate critical")
+	}
+
+	for _, illegal := range []string{
+		`{"steps":[]}`,
+		`{"steps":[{"delay":30}]}`,
+		`{"steps":[{"delay":0,"nf_address_list_id":"nfl-1"}]}`,
+		`{"steps":[{"delay":30,"nf_address_list_id":"nfl-1"},{"delay":30,"nf_address_list_id":"nfl-2"}]}`,
+		`{"severities":[""],"steps":[{"delay":30,"nf_address_list_id":"nfl-1"}]}`,
+	} {
+		if _, err := ParseEscalationConfig(illegal); err == nil {
+			t.Fatalf("ParseEscalationConfig [%s] should fail", illegal)
+		}
+	}
+}
+
+func TestParseTriggerStatus(t *testing.T) {
+	transitions, err := ParseTriggerStatus("")
+	if err != nil || len(transitions) != 3 {
+		t.Fatalf("ParseTriggerStatus empty should trigger on triggered, repeated and resumed, get %v %+v", transitions, err)
+	}
+
+	transitions, err = ParseTriggerStatus("resumed, escalated")
+	if err != nil || len(transitions) != 2 || transitions[1] != TriggerStatusEscalated {
+		t.Fatalf("ParseTriggerStatus get %v %+v", transitions, err)
+	}
+
+	if _, err := ParseTriggerStatus("triggered,cleared"); err == nil {
+		t.Fatalf("ParseTriggerStatus unsupported transition should fail")
+	}
+}
+
+func TestCheckReceiverAddress(t *testing.T) {
+	testCase := map[string]bool{
+		TriggerActionNotification + " nfl-1":                              true,
+		TriggerActionWebhook + " https://example.com/hook?token=1":        true,
+		TriggerActionDingTalk + " nfl-1":                                  false,
+		TriggerActionSlack + " ftp://example.com/hook":                    false,
+		TriggerActionSmtp + " ops@example.com, Admin <admin@example.com>": true,
+		TriggerActionSmtp + " ops":                                        false,
+	}
+	for c, expect := range testCase {
+		kv := strings.SplitN(c, " ", 2)
+		err := CheckReceiverAddress(kv[0], kv[1])
+		if (err == nil) != expect {
+			t.Fatalf("CheckReceiverAddress [%s] [%s] expect [%v] but get %+v", kv[0], kv[1], expect, err)
+		}
+	}
+}
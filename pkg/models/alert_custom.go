@@ -7,6 +7,21 @@ import (
 	"kubesphere.io/alert/pkg/util/pbutil"
 )
 
+//AcknowledgeInfo is carried by acknowledging operation, identifies the firing resource and who acknowledged it.
+type AcknowledgeInfo struct {
+	RuleId       string `json:"rule_id"`
+	ResourceName string `json:"resource_name"`
+	Acknowledger string `json:"acknowledger"`
+}
+
+//SnoozeInfo is carried by snoozing operation, zero SnoozeMinutes clears the snooze.
+type SnoozeInfo struct {
+	RuleId        string `json:"rule_id"`
+	ResourceName  string `json:"resource_name"`
+	SnoozeMinutes uint32 `json:"snooze_minutes"`
+	Operator      string `json:"operator"`
+}
+
 type AlertDetail struct {
 	AlertId             string    `gorm:"column:alert_id" json:"alert_id"`
 	AlertName           string    `gorm:"column:alert_name" json:"alert_name"`
@@ -25,12 +40,17 @@ type AlertDetail struct {
 	Creator             string    `gorm:"column:creator" json:"creator"`
 	AvailableStartTime  string    `gorm:"column:available_start_time" json:"available_start_time"`
 	AvailableEndTime    string    `gorm:"column:available_end_time" json:"available_end_time"`
+	AvailableSchedule   string    `gorm:"column:available_schedule" json:"available_schedule"`
+	GroupConfig         string    `gorm:"column:group_config" json:"group_config"`
+	RouteConfig         string    `gorm:"column:route_config" json:"route_config"`
 	Language            string    `gorm:"column:language" json:"language"`
 	Metrics             []string  `gorm:"column:metrics" json:"metrics"`
 	RulesCount          uint32    `json:"rules_count"`
 	PositivesCount      uint32    `json:"positives_count"`
 	MostRecentAlertTime string    `json:"most_recent_alert_time"`
 	NfAddressListId     string    `gorm:"column:nf_address_list_id" json:"nf_address_list_id"`
+	EscalationConfig    string    `gorm:"column:escalation_config" json:"escalation_config"`
+	Actions             []*Action `gorm:"-" json:"actions"`
 }
 
 func AlertDetailToPb(alertDetail *AlertDetail) *pb.AlertDetail {
@@ -52,12 +72,17 @@ func AlertDetailToPb(alertDetail *AlertDetail) *pb.AlertDetail {
 	pbAlertDetail.Creator = alertDetail.Creator
 	pbAlertDetail.AvailableStartTime = alertDetail.AvailableStartTime
 	pbAlertDetail.AvailableEndTime = alertDetail.AvailableEndTime
+	pbAlertDetail.AvailableSchedule = alertDetail.AvailableSchedule
+	pbAlertDetail.GroupConfig = alertDetail.GroupConfig
+	pbAlertDetail.RouteConfig = alertDetail.RouteConfig
 	pbAlertDetail.Language = alertDetail.Language
 	pbAlertDetail.Metrics = alertDetail.Metrics
 	pbAlertDetail.RulesCount = alertDetail.RulesCount
 	pbAlertDetail.PositivesCount = alertDetail.PositivesCount
 	pbAlertDetail.MostRecentAlertTime = alertDetail.MostRecentAlertTime
 	pbAlertDetail.NfAddressListId = alertDetail.NfAddressListId
+	pbAlertDetail.EscalationConfig = alertDetail.EscalationConfig
+	pbAlertDetail.Actions = ParseAcSet2PbSet(alertDetail.Actions)
 	return &pbAlertDetail
 }
 
@@ -78,6 +103,9 @@ type ResourceStatus struct {
 	NextResendInterval uint32 `json:"next_resend_interval"`
 	NextSendableTime   string `json:"next_sendable_time"`
 	AggregatedAlerts   string `json:"aggregated_alerts"`
+	Acknowledger       string `json:"acknowledger"`
+	AcknowledgeTime    string `json:"acknowledge_time"`
+	SnoozeUntil        string `json:"snooze_until"`
 }
 
 type AlertStatus struct {
@@ -122,6 +150,9 @@ func AlertStatusToPb(alertStatus AlertStatus) *pb.AlertStatus {
 		pbResource.NextResendInterval = resource.NextResendInterval
 		pbResource.NextSendableTime = resource.NextSendableTime
 		pbResource.AggregatedAlerts = resource.AggregatedAlerts
+		pbResource.Acknowledger = resource.Acknowledger
+		pbResource.AcknowledgeTime = resource.AcknowledgeTime
+		pbResource.SnoozeUntil = resource.SnoozeUntil
 
 		pbAlertStatus.Resources = append(pbAlertStatus.Resources, &pbResource)
 	}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/util/idutil"
+)
+
+//DeferredNotification keeps notification of a resource suppressed outside the available window of policy,
+//it is persisted so that a migrated alert still delivers the digest when the window opens.
+type DeferredNotification struct {
+	DeferredId     string    `gorm:"column:deferred_id" json:"deferred_id"`
+	AlertId        string    `gorm:"column:alert_id" json:"alert_id"`
+	RuleId         string    `gorm:"column:rule_id" json:"rule_id"`
+	ResourceName   string    `gorm:"column:resource_name" json:"resource_name"`
+	Status         string    `gorm:"column:status" json:"status"`
+	CumulatedCount uint32    `gorm:"column:cumulated_count" json:"cumulated_count"`
+	FirstAlertTime string    `gorm:"column:first_alert_time" json:"first_alert_time"`
+	LastAlertTime  string    `gorm:"column:last_alert_time" json:"last_alert_time"`
+	LastValue      string    `gorm:"column:last_value" json:"last_value"`
+	CreateTime     time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime     time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableDeferredNotification = "deferred_notification"
+)
+
+const (
+	DeferredNotificationIdPrefix = "dn-"
+)
+
+//Status of deferred notification, resumed resource is folded into the digest instead of a resume notification.
+const (
+	DeferredStatusFiring  = "firing"
+	DeferredStatusResumed = "resumed"
+)
+
+//field name
+//Dn is short for deferred notification.
+const (
+	DnColId             = "deferred_id"
+	DnColAlertId        = "alert_id"
+	DnColRuleId         = "rule_id"
+	DnColResourceName   = "resource_name"
+	DnColStatus         = "status"
+	DnColCumulatedCount = "cumulated_count"
+	DnColFirstAlertTime = "first_alert_time"
+	DnColLastAlertTime  = "last_alert_time"
+	DnColLastValue      = "last_value"
+	DnColCreateTime     = "create_time"
+	DnColUpdateTime     = "update_time"
+)
+
+func NewDeferredNotificationId() string {
+	return idutil.GetUuid(DeferredNotificationIdPrefix)
+}
+
+func NewDeferredNotification(alertId string, ruleId string, resourceName string, status string, cumulatedCount uint32, firstAlertTime string, lastAlertTime string, lastValue string) *DeferredNotification {
+	deferredNotification := &DeferredNotification{
+		DeferredId:     NewDeferredNotificationId(),
+		AlertId:        alertId,
+		RuleId:         ruleId,
+		ResourceName:   resourceName,
+		Status:         status,
+		CumulatedCount: cumulatedCount,
+		FirstAlertTime: firstAlertTime,
+		LastAlertTime:  lastAlertTime,
+		LastValue:      lastValue,
+		CreateTime:     time.Now(),
+		UpdateTime:     time.Now(),
+	}
+	return deferredNotification
+}
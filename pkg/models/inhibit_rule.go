@@ -0,0 +1,88 @@
+package models
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//InhibitRule mutes alerts of target resource type when an alert of source resource type is firing,
+//target resource is related to source resource by the equal label in its resource filter param, eg. node_id.
+type InhibitRule struct {
+	InhibitRuleId    string    `gorm:"column:inhibit_rule_id" json:"inhibit_rule_id"`
+	InhibitRuleName  string    `gorm:"column:inhibit_rule_name" json:"inhibit_rule_name"`
+	SourceRsTypeName string    `gorm:"column:source_rs_type_name" json:"source_rs_type_name"`
+	SourceSeverity   string    `gorm:"column:source_severity" json:"source_severity"`
+	TargetRsTypeName string    `gorm:"column:target_rs_type_name" json:"target_rs_type_name"`
+	EqualLabel       string    `gorm:"column:equal_label" json:"equal_label"`
+	Disabled         bool      `gorm:"column:disabled" json:"disabled"`
+	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableInhibitRule = "inhibit_rule"
+)
+
+const (
+	InhibitRuleIdPrefix = "ir-"
+)
+
+//field name
+//Ir is short for inhibit rule.
+const (
+	IrColId               = "inhibit_rule_id"
+	IrColName             = "inhibit_rule_name"
+	IrColSourceRsTypeName = "source_rs_type_name"
+	IrColSourceSeverity   = "source_severity"
+	IrColTargetRsTypeName = "target_rs_type_name"
+	IrColEqualLabel       = "equal_label"
+	IrColDisabled         = "disabled"
+	IrColCreateTime       = "create_time"
+	IrColUpdateTime       = "update_time"
+)
+
+func NewInhibitRuleId() string {
+	return idutil.GetUuid(InhibitRuleIdPrefix)
+}
+
+func NewInhibitRule(inhibitRuleName string, sourceRsTypeName string, sourceSeverity string, targetRsTypeName string, equalLabel string, disabled bool) *InhibitRule {
+	inhibitRule := &InhibitRule{
+		InhibitRuleId:    NewInhibitRuleId(),
+		InhibitRuleName:  inhibitRuleName,
+		SourceRsTypeName: sourceRsTypeName,
+		SourceSeverity:   sourceSeverity,
+		TargetRsTypeName: targetRsTypeName,
+		EqualLabel:       equalLabel,
+		Disabled:         disabled,
+		CreateTime:       time.Now(),
+		UpdateTime:       time.Now(),
+	}
+	return inhibitRule
+}
+
+func InhibitRuleToPb(inhibitRule *InhibitRule) *pb.InhibitRule {
+	pbInhibitRule := pb.InhibitRule{}
+	pbInhibitRule.InhibitRuleId = inhibitRule.InhibitRuleId
+	pbInhibitRule.InhibitRuleName = inhibitRule.InhibitRuleName
+	pbInhibitRule.SourceRsTypeName = inhibitRule.SourceRsTypeName
+	pbInhibitRule.SourceSeverity = inhibitRule.SourceSeverity
+	pbInhibitRule.TargetRsTypeName = inhibitRule.TargetRsTypeName
+	pbInhibitRule.EqualLabel = inhibitRule.EqualLabel
+	pbInhibitRule.Disabled = inhibitRule.Disabled
+	pbInhibitRule.CreateTime = pbutil.ToProtoTimestamp(inhibitRule.CreateTime)
+	pbInhibitRule.UpdateTime = pbutil.ToProtoTimestamp(inhibitRule.UpdateTime)
+	return &pbInhibitRule
+}
+
+func ParseIrSet2PbSet(inIrs []*InhibitRule) []*pb.InhibitRule {
+	var pbIrs []*pb.InhibitRule
+	for _, inIr := range inIrs {
+		pbIr := InhibitRuleToPb(inIr)
+		pbIrs = append(pbIrs, pbIr)
+	}
+	return pbIrs
+}
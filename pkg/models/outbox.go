@@ -0,0 +1,155 @@
+package models
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//Outbox is a notification to deliver through a notifier to addresses, it is retried until sent or dead.
+//Notification of the same idempotency key is queued only once, eg. when executor restarts before resource status is saved.
+type Outbox struct {
+	OutboxId        string    `gorm:"column:outbox_id" json:"outbox_id"`
+	IdempotencyKey  string    `gorm:"column:idempotency_key" json:"idempotency_key"`
+	AlertId         string    `gorm:"column:alert_id" json:"alert_id"`
+	RuleId          string    `gorm:"column:rule_id" json:"rule_id"`
+	ResourceName    string    `gorm:"column:resource_name" json:"resource_name"`
+	Notifier        string    `gorm:"column:notifier" json:"notifier"`
+	Addresses       string    `gorm:"column:addresses" json:"addresses"`
+	Title           string    `gorm:"column:title" json:"title"`
+	Content         string    `gorm:"column:content" json:"content"`
+	Html            bool      `gorm:"column:html" json:"html"`
+	Status          string    `gorm:"column:status" json:"status"`
+	Attempts        uint32    `gorm:"column:attempts" json:"attempts"`
+	LastError       string    `gorm:"column:last_error" json:"last_error"`
+	NotificationId  string    `gorm:"column:notification_id" json:"notification_id"`
+	NextAttemptTime time.Time `gorm:"column:next_attempt_time" json:"next_attempt_time"`
+	CreateTime      time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime      time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableOutbox = "outbox"
+)
+
+const (
+	OutboxIdPrefix = "ob-"
+)
+
+//Status of outbox, sending outbox whose next attempt time passes is claimed again as its dispatcher is gone.
+const (
+	OutboxStatusPending   = "pending"
+	OutboxStatusSending   = "sending"
+	OutboxStatusSent      = "sent"
+	OutboxStatusDead      = "dead"
+	OutboxStatusDiscarded = "discarded"
+)
+
+//field name
+//Ob is short for outbox.
+const (
+	ObColId              = "outbox_id"
+	ObColIdempotencyKey  = "idempotency_key"
+	ObColAlertId         = "alert_id"
+	ObColRuleId          = "rule_id"
+	ObColResourceName    = "resource_name"
+	ObColNotifier        = "notifier"
+	ObColAddresses       = "addresses"
+	ObColTitle           = "title"
+	ObColContent         = "content"
+	ObColHtml            = "html"
+	ObColStatus          = "status"
+	ObColAttempts        = "attempts"
+	ObColLastError       = "last_error"
+	ObColNotificationId  = "notification_id"
+	ObColNextAttemptTime = "next_attempt_time"
+	ObColCreateTime      = "create_time"
+	ObColUpdateTime      = "update_time"
+)
+
+func NewOutboxId() string {
+	return idutil.GetUuid(OutboxIdPrefix)
+}
+
+//NewOutboxIdempotencyKey hashes key of the notification in alert with its receivers, so that each notifier queues it once.
+func NewOutboxIdempotencyKey(alertId string, key string, notifier string, addresses []string) string {
+	addressesBytes, _ := json.Marshal(addresses)
+	sum := sha1.Sum([]byte(alertId + "\n" + key + "\n" + notifier + "\n" + string(addressesBytes)))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewOutbox(idempotencyKey string, alertId string, ruleId string, resourceName string, notifier string, addresses []string, title string, content string, html bool) *Outbox {
+	addressesBytes, _ := json.Marshal(addresses)
+	outbox := &Outbox{
+		OutboxId:        NewOutboxId(),
+		IdempotencyKey:  idempotencyKey,
+		AlertId:         alertId,
+		RuleId:          ruleId,
+		ResourceName:    resourceName,
+		Notifier:        notifier,
+		Addresses:       string(addressesBytes),
+		Title:           title,
+		Content:         content,
+		Html:            html,
+		Status:          OutboxStatusPending,
+		NextAttemptTime: time.Now(),
+		CreateTime:      time.Now(),
+		UpdateTime:      time.Now(),
+	}
+	return outbox
+}
+
+//GetAddresses returns addresses of outbox, nil if they are broken.
+func (ob *Outbox) GetAddresses() []string {
+	var addresses []string
+	json.Unmarshal([]byte(ob.Addresses), &addresses)
+	return addresses
+}
+
+//OutboxRetryDelay doubles delay from interval for each failed attempt, up to maxInterval.
+func OutboxRetryDelay(attempts uint32, interval time.Duration, maxInterval time.Duration) time.Duration {
+	delay := interval
+	for i := uint32(1); i < attempts && delay < maxInterval; i++ {
+		delay = delay * 2
+	}
+	if delay > maxInterval {
+		delay = maxInterval
+	}
+	return delay
+}
+
+func OutboxToPb(outbox *Outbox) *pb.Outbox {
+	pbOutbox := pb.Outbox{}
+	pbOutbox.OutboxId = outbox.OutboxId
+	pbOutbox.IdempotencyKey = outbox.IdempotencyKey
+	pbOutbox.AlertId = outbox.AlertId
+	pbOutbox.RuleId = outbox.RuleId
+	pbOutbox.ResourceName = outbox.ResourceName
+	pbOutbox.Notifier = outbox.Notifier
+	pbOutbox.Addresses = outbox.Addresses
+	pbOutbox.Title = outbox.Title
+	pbOutbox.Content = outbox.Content
+	pbOutbox.Status = outbox.Status
+	pbOutbox.Attempts = outbox.Attempts
+	pbOutbox.LastError = outbox.LastError
+	pbOutbox.NotificationId = outbox.NotificationId
+	pbOutbox.NextAttemptTime = pbutil.ToProtoTimestamp(outbox.NextAttemptTime)
+	pbOutbox.CreateTime = pbutil.ToProtoTimestamp(outbox.CreateTime)
+	pbOutbox.UpdateTime = pbutil.ToProtoTimestamp(outbox.UpdateTime)
+	return &pbOutbox
+}
+
+func ParseObSet2PbSet(inObs []*Outbox) []*pb.Outbox {
+	var pbObs []*pb.Outbox
+	for _, inOb := range inObs {
+		pbOb := OutboxToPb(inOb)
+		pbObs = append(pbObs, pbOb)
+	}
+	return pbObs
+}
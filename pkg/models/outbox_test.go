@@ -0,0 +1,33 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOutboxRetryDelay(t *testing.T) {
+	testCase := map[uint32]time.Duration{
+		0:  30 * time.Second,
+		1:  30 * time.Second,
+		2:  60 * time.Second,
+		4:  240 * time.Second,
+		8:  time.Hour,
+		64: time.Hour,
+	}
+	for attempts, expect := range testCase {
+		delay := OutboxRetryDelay(attempts, 30*time.Second, time.Hour)
+		if delay != expect {
+			t.Fatalf("OutboxRetryDelay of %d attempts expect [%v] but get [%v]", attempts, expect, delay)
+		}
+	}
+}
+
+func TestNewOutboxIdempotencyKey(t *testing.T) {
+	key := NewOutboxIdempotencyKey("al-1", "rl-1 node1 triggered 0", TriggerActionWebhook, []string{"http://a"})
+	if len(key) != 40 || key != NewOutboxIdempotencyKey("al-1", "rl-1 node1 triggered 0", TriggerActionWebhook, []string{"http://a"}) {
+		t.Fatalf("NewOutboxIdempotencyKey should be a stable sha1, get [%s]", key)
+	}
+	if key == NewOutboxIdempotencyKey("al-1", "rl-1 node1 triggered 0", TriggerActionWebhook, []string{"http://b"}) {
+		t.Fatalf("NewOutboxIdempotencyKey should differ by addresses")
+	}
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"kubesphere.io/alert/pkg/pb"
@@ -16,6 +18,9 @@ type Policy struct {
 	Creator            string    `gorm:"column:creator" json:"creator"`
 	AvailableStartTime string    `gorm:"column:available_start_time" json:"available_start_time"`
 	AvailableEndTime   string    `gorm:"column:available_end_time" json:"available_end_time"`
+	AvailableSchedule  string    `gorm:"column:available_schedule" json:"available_schedule"`
+	GroupConfig        string    `gorm:"column:group_config" json:"group_config"`
+	RouteConfig        string    `gorm:"column:route_config" json:"route_config"`
 	CreateTime         time.Time `gorm:"column:create_time" json:"create_time"`
 	UpdateTime         time.Time `gorm:"column:update_time" json:"update_time"`
 	RsTypeId           string    `gorm:"column:rs_type_id" json:"rs_type_id"`
@@ -41,6 +46,9 @@ const (
 	PlColCreator            = "creator"
 	PlColAvailableStartTime = "available_start_time"
 	PlColAvailableEndTime   = "available_end_time"
+	PlColAvailableSchedule  = "available_schedule"
+	PlColGroupConfig        = "group_config"
+	PlColRouteConfig        = "route_config"
 	PlColCreateTime         = "create_time"
 	PlColUpdateTime         = "update_time"
 	PlColTypeId             = "rs_type_id"
@@ -51,7 +59,7 @@ func NewPolicyId() string {
 	return idutil.GetUuid(PolicyIdPrefix)
 }
 
-func NewPolicy(policyName string, policyDescription string, policyConfig string, creator string, availableStartTime string, availableEndTime string, rsTypeId string, language string) *Policy {
+func NewPolicy(policyName string, policyDescription string, policyConfig string, creator string, availableStartTime string, availableEndTime string, availableSchedule string, groupConfig string, routeConfig string, rsTypeId string, language string) *Policy {
 	policy := &Policy{
 		PolicyId:           NewPolicyId(),
 		PolicyName:         policyName,
@@ -60,6 +68,9 @@ func NewPolicy(policyName string, policyDescription string, policyConfig string,
 		Creator:            creator,
 		AvailableStartTime: availableStartTime,
 		AvailableEndTime:   availableEndTime,
+		AvailableSchedule:  availableSchedule,
+		GroupConfig:        groupConfig,
+		RouteConfig:        routeConfig,
 		CreateTime:         time.Now(),
 		UpdateTime:         time.Now(),
 		RsTypeId:           rsTypeId,
@@ -77,6 +88,9 @@ func PolicyToPb(policy *Policy) *pb.Policy {
 	pbPolicy.Creator = policy.Creator
 	pbPolicy.AvailableStartTime = policy.AvailableStartTime
 	pbPolicy.AvailableEndTime = policy.AvailableEndTime
+	pbPolicy.AvailableSchedule = policy.AvailableSchedule
+	pbPolicy.GroupConfig = policy.GroupConfig
+	pbPolicy.RouteConfig = policy.RouteConfig
 	pbPolicy.CreateTime = pbutil.ToProtoTimestamp(policy.CreateTime)
 	pbPolicy.UpdateTime = pbutil.ToProtoTimestamp(policy.UpdateTime)
 	pbPolicy.RsTypeId = policy.RsTypeId
@@ -101,8 +115,204 @@ type PolicyByAlert struct {
 	Creator            string    `gorm:"column:creator" json:"creator"`
 	AvailableStartTime string    `gorm:"column:available_start_time" json:"available_start_time"`
 	AvailableEndTime   string    `gorm:"column:available_end_time" json:"available_end_time"`
+	AvailableSchedule  string    `gorm:"column:available_schedule" json:"available_schedule"`
+	GroupConfig        string    `gorm:"column:group_config" json:"group_config"`
+	RouteConfig        string    `gorm:"column:route_config" json:"route_config"`
 	CreateTime         time.Time `gorm:"column:create_time" json:"create_time"`
 	UpdateTime         time.Time `gorm:"column:update_time" json:"update_time"`
 	RsTypeId           string    `gorm:"column:rs_type_id" json:"rs_type_id"`
 	Language           string    `gorm:"column:language" json:"language"`
 }
+
+//PolicySchedule is the calendar in which notifications of policy are sent, it takes the place of available start time and end time.
+//Eg. {"timezone":"Asia/Shanghai","windows":[{"weekdays":["mon","fri"],"start_time":"09:00:00","end_time":"18:00:00"}],"exclude_dates":["2019-10-01"]}
+//Notifications outside the schedule are dropped, unless DeferDelivery queues them for a digest when the window opens.
+//Schedule is got by ParsePolicySchedule, which keeps the parsed location and windows for IsAvailable.
+type PolicySchedule struct {
+	Timezone      string           `json:"timezone"`
+	Windows       []ScheduleWindow `json:"windows"`
+	ExcludeDates  []string         `json:"exclude_dates"`
+	DeferDelivery bool             `json:"defer_delivery"`
+	location      *time.Location
+}
+
+//ScheduleWindow crosses midnight when end time is not after start time, eg. 22:00:00-06:00:00.
+//Weekdays are the days on which the window starts, empty means every day.
+type ScheduleWindow struct {
+	Weekdays     []string `json:"weekdays"`
+	StartTime    string   `json:"start_time"`
+	EndTime      string   `json:"end_time"`
+	startSeconds int
+	endSeconds   int
+}
+
+const (
+	ScheduleTimeFormat = "15:04:05"
+	ScheduleDateFormat = "2006-01-02"
+)
+
+var scheduleWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func parseScheduleSeconds(timeStr string) (int, error) {
+	t, err := time.Parse(ScheduleTimeFormat, timeStr)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*3600 + t.Minute()*60 + t.Second(), nil
+}
+
+//ParsePolicySchedule parses and checks schedule, empty timezone means local time of executor.
+func ParsePolicySchedule(schedule string) (*PolicySchedule, error) {
+	policySchedule := &PolicySchedule{}
+	err := json.Unmarshal([]byte(schedule), policySchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	policySchedule.location = time.Local
+	if policySchedule.Timezone != "" {
+		policySchedule.location, err = time.LoadLocation(policySchedule.Timezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(policySchedule.Windows) == 0 {
+		return nil, fmt.Errorf("no window specified")
+	}
+
+	for i := range policySchedule.Windows {
+		window := &policySchedule.Windows[i]
+		for _, weekday := range window.Weekdays {
+			if _, ok := scheduleWeekdays[weekday]; !ok {
+				return nil, fmt.Errorf("unsupported weekday [%s]", weekday)
+			}
+		}
+
+		startSeconds, err := parseScheduleSeconds(window.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		endSeconds, err := parseScheduleSeconds(window.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		if startSeconds == endSeconds {
+			return nil, fmt.Errorf("window [%s-%s] is empty", window.StartTime, window.EndTime)
+		}
+		window.startSeconds = startSeconds
+		window.endSeconds = endSeconds
+	}
+
+	for _, date := range policySchedule.ExcludeDates {
+		_, err = time.Parse(ScheduleDateFormat, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return policySchedule, nil
+}
+
+func (w *ScheduleWindow) startsOn(weekday time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+	for _, day := range w.Weekdays {
+		if scheduleWeekdays[day] == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+//IsAvailable reports whether t is in any window of schedule, excluded dates are compared with date of t in timezone of schedule.
+func (s *PolicySchedule) IsAvailable(t time.Time) bool {
+	t = t.In(s.location)
+
+	date := t.Format(ScheduleDateFormat)
+	for _, excludeDate := range s.ExcludeDates {
+		if excludeDate == date {
+			return false
+		}
+	}
+
+	seconds := t.Hour()*3600 + t.Minute()*60 + t.Second()
+	yesterday := (t.Weekday() + 6) % 7
+	for _, window := range s.Windows {
+		startSeconds, endSeconds := window.startSeconds, window.endSeconds
+		if startSeconds < endSeconds {
+			if seconds >= startSeconds && seconds < endSeconds && window.startsOn(t.Weekday()) {
+				return true
+			}
+		} else {
+			//Overnight window, time after midnight belongs to the window started yesterday
+			if seconds >= startSeconds && window.startsOn(t.Weekday()) {
+				return true
+			}
+			if seconds < endSeconds && window.startsOn(yesterday) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+//PolicyGroupConfig merges notifications of many resources into one, eg. {"group_by":["rule"],"group_wait":30,"group_interval":300}.
+//Group by rule sends one notification for each rule, other keys of resource filter param label the group, eg. ns_name.
+//Resource filter param belongs to the alert rather than to each resource, so these keys never split resources of one alert,
+//they only name the group in notification.
+//The first notification of a group waits group wait seconds for more resources, then at most one is sent every group interval seconds.
+type PolicyGroupConfig struct {
+	GroupBy       []string `json:"group_by"`
+	GroupWait     uint32   `json:"group_wait"`
+	GroupInterval uint32   `json:"group_interval"`
+}
+
+const (
+	GroupByRule      = "rule"
+	MaxGroupInterval = 24 * 3600
+)
+
+//ParsePolicyGroupConfig parses and checks group config, group interval is required so that a group is always flushed.
+func ParsePolicyGroupConfig(groupConfig string) (*PolicyGroupConfig, error) {
+	policyGroupConfig := &PolicyGroupConfig{}
+	err := json.Unmarshal([]byte(groupConfig), policyGroupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range policyGroupConfig.GroupBy {
+		if key == "" {
+			return nil, fmt.Errorf("empty group by key")
+		}
+	}
+
+	if policyGroupConfig.GroupInterval == 0 || policyGroupConfig.GroupInterval > MaxGroupInterval {
+		return nil, fmt.Errorf("group interval [%d] out of range (0, %d]", policyGroupConfig.GroupInterval, MaxGroupInterval)
+	}
+	if policyGroupConfig.GroupWait > policyGroupConfig.GroupInterval {
+		return nil, fmt.Errorf("group wait [%d] is longer than group interval [%d]", policyGroupConfig.GroupWait, policyGroupConfig.GroupInterval)
+	}
+
+	return policyGroupConfig, nil
+}
+
+//IsGroupByRule reports whether each rule has its own group.
+func (c *PolicyGroupConfig) IsGroupByRule() bool {
+	for _, key := range c.GroupBy {
+		if key == GroupByRule {
+			return true
+		}
+	}
+	return false
+}
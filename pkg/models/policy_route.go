@@ -0,0 +1,159 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+)
+
+const MaxRouteDepth = 5
+
+//PolicyRoute is a node of the routing tree of policy, the root usually has no matcher and holds the routes,
+//eg. {"routes":[{"severities":["critical"],"nf_address_list_id":"nfl-1","repeat_config":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":5,"max_send_count":10}},
+//{"resource_name":"node-db-*","nf_address_list_id":"nfl-2","continue":true}]}.
+//Empty matcher matches anything, ResourceName is a shell pattern and Labels match resource filter param of alert.
+//Routes are tried in order and the first matching one is used unless it continues to the next ones,
+//a route without address list or repeat config inherits it from its parent.
+type PolicyRoute struct {
+	Severities      []string          `json:"severities"`
+	RuleName        string            `json:"rule_name"`
+	ResourceName    string            `json:"resource_name"`
+	Labels          map[string]string `json:"labels"`
+	NfAddressListId string            `json:"nf_address_list_id"`
+	RepeatConfig    *RepeatConfig     `json:"repeat_config"`
+	Continue        bool              `json:"continue"`
+	Routes          []*PolicyRoute    `json:"routes"`
+}
+
+//RouteReceiver is where a notification is routed to, empty NfAddressListId means address list of the action
+//and nil RepeatConfig means repeat config of the severity in policy config.
+type RouteReceiver struct {
+	NfAddressListId string
+	RepeatConfig    *RepeatConfig
+}
+
+//ParsePolicyRoute parses routing tree of policy, repeat config of each route should be valid.
+func ParsePolicyRoute(routeConfig string) (*PolicyRoute, error) {
+	policyRoute := &PolicyRoute{}
+	err := json.Unmarshal([]byte(routeConfig), policyRoute)
+	if err != nil {
+		return nil, err
+	}
+
+	err = policyRoute.check(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return policyRoute, nil
+}
+
+func (r *PolicyRoute) check(depth int) error {
+	if depth > MaxRouteDepth {
+		return fmt.Errorf("routes are nested deeper than %d", MaxRouteDepth)
+	}
+
+	if r.ResourceName != "" {
+		if _, err := path.Match(r.ResourceName, ""); err != nil {
+			return fmt.Errorf("illegal resource name pattern [%s]: %v", r.ResourceName, err)
+		}
+	}
+
+	if r.RepeatConfig != nil {
+		if _, err := NewRepeatStrategy(*r.RepeatConfig); err != nil {
+			return fmt.Errorf("illegal repeat config of route: %v", err)
+		}
+	}
+
+	for _, route := range r.Routes {
+		if route == nil {
+			return fmt.Errorf("empty route")
+		}
+		err := route.check(depth + 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *PolicyRoute) match(severity string, ruleName string, resourceName string, labels map[string]string) bool {
+	if len(r.Severities) > 0 {
+		matched := false
+		for _, s := range r.Severities {
+			if s == severity {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if r.RuleName != "" && r.RuleName != ruleName {
+		return false
+	}
+	if r.ResourceName != "" {
+		if matched, _ := path.Match(r.ResourceName, resourceName); !matched {
+			return false
+		}
+	}
+	for k, v := range r.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+//Resolve returns receivers of notification by walking through the routing tree, nil if the root does not match.
+//Receivers with the same address list are merged into the first one.
+func (r *PolicyRoute) Resolve(severity string, ruleName string, resourceName string, labels map[string]string) []RouteReceiver {
+	receivers := []RouteReceiver{}
+	seen := make(map[string]bool)
+	for _, receiver := range r.resolve(severity, ruleName, resourceName, labels, RouteReceiver{}) {
+		if seen[receiver.NfAddressListId] {
+			continue
+		}
+		seen[receiver.NfAddressListId] = true
+		receivers = append(receivers, receiver)
+	}
+
+	if len(receivers) == 0 {
+		return nil
+	}
+	return receivers
+}
+
+func (r *PolicyRoute) resolve(severity string, ruleName string, resourceName string, labels map[string]string, parent RouteReceiver) []RouteReceiver {
+	if !r.match(severity, ruleName, resourceName, labels) {
+		return nil
+	}
+
+	receiver := parent
+	if r.NfAddressListId != "" {
+		receiver.NfAddressListId = r.NfAddressListId
+	}
+	if r.RepeatConfig != nil {
+		receiver.RepeatConfig = r.RepeatConfig
+	}
+
+	receivers := []RouteReceiver{}
+	for _, route := range r.Routes {
+		matched := route.resolve(severity, ruleName, resourceName, labels, receiver)
+		if len(matched) == 0 {
+			continue
+		}
+		receivers = append(receivers, matched...)
+		if !route.Continue {
+			break
+		}
+	}
+
+	//No child route matches, the route itself is the receiver
+	if len(receivers) == 0 {
+		receivers = append(receivers, receiver)
+	}
+	return receivers
+}
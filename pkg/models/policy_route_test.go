@@ -0,0 +1,56 @@
+package models
+
+import "testing"
+
+func TestPolicyRoute(t *testing.T) {
+	routeConfig := `{"routes":[
+		{"severities":["critical"],"nf_address_list_id":"nfl-oncall","repeat_config":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":5,"max_send_count":10},
+			"routes":[{"labels":{"namespace":"db"},"nf_address_list_id":"nfl-dba"}]},
+		{"resource_name":"node-db-*","nf_address_list_id":"nfl-dba","continue":true},
+		{"rule_name":"cpu","nf_address_list_id":"nfl-ops"}]}`
+	policyRoute, err := ParsePolicyRoute(routeConfig)
+	if err != nil {
+		t.Fatalf("ParsePolicyRoute failed: %+v", err)
+	}
+
+	type routeCase struct {
+		severity     string
+		ruleName     string
+		resourceName string
+		labels       map[string]string
+		expect       []string
+	}
+	testCase := []routeCase{
+		{"critical", "cpu", "node-1", nil, []string{"nfl-oncall"}},
+		{"critical", "cpu", "node-1", map[string]string{"namespace": "db"}, []string{"nfl-dba"}},
+		{"major", "cpu", "node-db-1", nil, []string{"nfl-dba", "nfl-ops"}},
+		{"major", "memory", "node-db-1", nil, []string{"nfl-dba"}},
+		{"minor", "memory", "node-1", nil, []string{""}},
+	}
+	for _, c := range testCase {
+		receivers := policyRoute.Resolve(c.severity, c.ruleName, c.resourceName, c.labels)
+		if len(receivers) != len(c.expect) {
+			t.Fatalf("Resolve %+v get receivers %+v", c, receivers)
+		}
+		for i, receiver := range receivers {
+			if receiver.NfAddressListId != c.expect[i] {
+				t.Fatalf("Resolve %+v get receivers %+v", c, receivers)
+			}
+		}
+	}
+
+	receivers := policyRoute.Resolve("critical", "cpu", "node-1", map[string]string{"namespace": "db"})
+	if receivers[0].RepeatConfig == nil || receivers[0].RepeatConfig.RepeatIntervalInitvalue != 5 {
+		t.Fatalf("Resolve should inherit repeat config from parent route")
+	}
+
+	for _, illegal := range []string{
+		`{"routes":[{"resource_name":"node-[","nf_address_list_id":"nfl-1"}]}`,
+		`{"routes":[{"nf_address_list_id":"nfl-1","repeat_config":{"repeat_type":"exp-minutes"}}]}`,
+		`{"routes":[null]}`,
+	} {
+		if _, err := ParsePolicyRoute(illegal); err == nil {
+			t.Fatalf("ParsePolicyRoute [%s] should fail", illegal)
+		}
+	}
+}
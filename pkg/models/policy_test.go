@@ -0,0 +1,74 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicySchedule(t *testing.T) {
+	schedule := `{"timezone":"UTC","windows":[{"weekdays":["mon","tue","wed","thu","fri"],"start_time":"09:00:00","end_time":"18:00:00"},{"weekdays":["fri"],"start_time":"22:00:00","end_time":"06:00:00"}],"exclude_dates":["2019-10-01"]}`
+	policySchedule, err := ParsePolicySchedule(schedule)
+	if err != nil {
+		t.Fatalf("ParsePolicySchedule failed: %+v", err)
+	}
+
+	testCase := map[string]bool{
+		"2019-09-30T10:00:00Z": true,  //Monday in office hours
+		"2019-09-30T08:59:59Z": false, //Monday before office hours
+		"2019-09-30T18:00:00Z": false, //End time is excluded
+		"2019-10-01T10:00:00Z": false, //Excluded date
+		"2019-10-04T23:00:00Z": true,  //Friday night
+		"2019-10-05T05:00:00Z": true,  //Saturday morning belongs to Friday night
+		"2019-10-05T10:00:00Z": false, //Saturday
+		"2019-10-06T05:00:00Z": false, //Sunday morning, no window started on Saturday
+	}
+	for timeStr, expect := range testCase {
+		now, _ := time.Parse(time.RFC3339, timeStr)
+		if policySchedule.IsAvailable(now) != expect {
+			t.Fatalf("IsAvailable [%s] expect [%v]", timeStr, expect)
+		}
+	}
+
+	shanghai, err := ParsePolicySchedule(`{"timezone":"Asia/Shanghai","windows":[{"start_time":"09:00:00","end_time":"18:00:00"}]}`)
+	if err != nil {
+		t.Fatalf("ParsePolicySchedule failed: %+v", err)
+	}
+	now, _ := time.Parse(time.RFC3339, "2019-09-30T02:00:00Z")
+	if !shanghai.IsAvailable(now) {
+		t.Fatalf("IsAvailable should be in timezone of schedule")
+	}
+
+	for _, illegal := range []string{
+		`{"windows":[]}`,
+		`{"timezone":"Mars/Base","windows":[{"start_time":"09:00:00","end_time":"18:00:00"}]}`,
+		`{"windows":[{"weekdays":["monday"],"start_time":"09:00:00","end_time":"18:00:00"}]}`,
+		`{"windows":[{"start_time":"09:00","end_time":"18:00:00"}]}`,
+		`{"windows":[{"start_time":"09:00:00","end_time":"09:00:00"}]}`,
+		`{"windows":[{"start_time":"09:00:00","end_time":"18:00:00"}],"exclude_dates":["10/01"]}`,
+	} {
+		if _, err := ParsePolicySchedule(illegal); err == nil {
+			t.Fatalf("ParsePolicySchedule [%s] should fail", illegal)
+		}
+	}
+}
+
+func TestPolicyGroupConfig(t *testing.T) {
+	groupConfig, err := ParsePolicyGroupConfig(`{"group_by":["rule","ns_name"],"group_wait":30,"group_interval":300}`)
+	if err != nil {
+		t.Fatalf("ParsePolicyGroupConfig failed: %+v", err)
+	}
+	if !groupConfig.IsGroupByRule() {
+		t.Fatalf("IsGroupByRule expect [true]")
+	}
+
+	for _, illegal := range []string{
+		`{"group_by":["rule"]}`,
+		`{"group_by":[""],"group_interval":300}`,
+		`{"group_wait":600,"group_interval":300}`,
+		`{"group_interval":86401}`,
+	} {
+		if _, err := ParsePolicyGroupConfig(illegal); err == nil {
+			t.Fatalf("ParsePolicyGroupConfig [%s] should fail", illegal)
+		}
+	}
+}
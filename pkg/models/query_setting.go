@@ -13,6 +13,10 @@ var SearchWordColumnTable = []string{
 	TableAlert,
 	TableHistory,
 	TableComment,
+	TableInhibitRule,
+	TableSilence,
+	TableTemplate,
+	TableOutbox,
 }
 
 // columns that can be search through sql 'like' operator
@@ -44,6 +48,18 @@ var SearchColumns = map[string][]string{
 	TableAction: {
 		AcColId, AcColName, AcColTriggerStatus, AcColTriggerAction, AcColPolicyId, AcColNfAddressListId,
 	},
+	TableInhibitRule: {
+		IrColId, IrColName, IrColSourceRsTypeName, IrColSourceSeverity, IrColTargetRsTypeName, IrColEqualLabel,
+	},
+	TableSilence: {
+		SlColId, SlColCreator,
+	},
+	TableTemplate: {
+		TpColId, TpColPolicyId, TpColLanguage,
+	},
+	TableOutbox: {
+		ObColId, ObColAlertId, ObColStatus,
+	},
 }
 
 // columns that can be search through sql '=' operator
@@ -75,4 +91,16 @@ var IndexedColumns = map[string][]string{
 	TableAction: {
 		AcColId, AcColName, AcColTriggerStatus, AcColTriggerAction, AcColPolicyId, AcColNfAddressListId,
 	},
+	TableInhibitRule: {
+		IrColId, IrColName, IrColSourceRsTypeName, IrColSourceSeverity, IrColTargetRsTypeName, IrColEqualLabel,
+	},
+	TableSilence: {
+		SlColId, SlColCreator,
+	},
+	TableTemplate: {
+		TpColId, TpColPolicyId, TpColLanguage,
+	},
+	TableOutbox: {
+		ObColId, ObColAlertId, ObColStatus,
+	},
 }
@@ -0,0 +1,228 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"kubesphere.io/alert/pkg/util/cronutil"
+)
+
+//Repeat types of policy config.
+const (
+	RepeatTypeNormal           = "normal"
+	RepeatTypeNotRepeat        = "not-repeat"
+	RepeatTypeFixedMinutes     = "fixed-minutes"
+	RepeatTypeExpMinutes       = "exp-minutes"
+	RepeatTypeCappedExpMinutes = "capped-exp-minutes"
+	RepeatTypeFibonacciMinutes = "fibonacci-minutes"
+	RepeatTypeSchedule         = "schedule"
+	RepeatTypeCron             = "cron"
+)
+
+//Interval without max repeat interval keeps growing as before, it only stops at 100 years to avoid overflow.
+const unboundedRepeatInterval = 100 * 365 * 24 * 60
+
+//RepeatConfig is the repeat config of one severity in policy config,
+//eg. {"critical":{"repeat_type":"capped-exp-minutes","repeat_interval_initvalue":1,"max_send_count":8,"max_repeat_interval":60}}.
+//Repeat schedule lists intervals after each notification for schedule and the last one is kept, eg. "5m,15m,1h,4h".
+//Repeat cron is a 5 fields cron expression in local time of executor for cron, eg. "0 9 * * 1-5".
+type RepeatConfig struct {
+	RepeatType              string `json:"repeat_type"`
+	RepeatIntervalInitvalue uint32 `json:"repeat_interval_initvalue"`
+	MaxSendCount            uint32 `json:"max_send_count"`
+	MaxRepeatInterval       uint32 `json:"max_repeat_interval"`
+	RepeatSchedule          string `json:"repeat_schedule"`
+	RepeatCron              string `json:"repeat_cron"`
+}
+
+//RepeatStrategy decides when notification of a firing resource could be sent again.
+type RepeatStrategy interface {
+	//Repeatable reports whether another notification could be sent after sentCount notifications.
+	Repeatable(sentCount uint32) bool
+	//Next returns the sendable time after the sentCount-th notification, last is the sendable time before it.
+	Next(sentCount uint32, last time.Time, now time.Time) time.Time
+}
+
+type normalRepeat struct{}
+
+func (r normalRepeat) Repeatable(sentCount uint32) bool {
+	return true
+}
+
+func (r normalRepeat) Next(sentCount uint32, last time.Time, now time.Time) time.Time {
+	return last
+}
+
+type notRepeat struct{}
+
+func (r notRepeat) Repeatable(sentCount uint32) bool {
+	return sentCount == 0
+}
+
+func (r notRepeat) Next(sentCount uint32, last time.Time, now time.Time) time.Time {
+	return last
+}
+
+//intervalRepeat repeats after the interval of sentCount from the last sendable time.
+type intervalRepeat struct {
+	maxSendCount uint32
+	interval     func(sentCount uint32) time.Duration
+}
+
+func (r intervalRepeat) Repeatable(sentCount uint32) bool {
+	return sentCount < r.maxSendCount
+}
+
+func (r intervalRepeat) Next(sentCount uint32, last time.Time, now time.Time) time.Time {
+	return last.Add(r.interval(sentCount))
+}
+
+//cronRepeat repeats at the next time of cron schedule after now.
+type cronRepeat struct {
+	maxSendCount uint32
+	schedule     *cronutil.Schedule
+}
+
+func (r cronRepeat) Repeatable(sentCount uint32) bool {
+	return sentCount < r.maxSendCount
+}
+
+func (r cronRepeat) Next(sentCount uint32, last time.Time, now time.Time) time.Time {
+	next := r.schedule.Next(now)
+	if next.IsZero() {
+		return now.AddDate(100, 0, 0)
+	}
+	return next
+}
+
+//growInterval returns initValue grown by grow for sentCount-1 times, stopping at maxValue.
+func growInterval(sentCount uint32, initValue uint32, maxValue uint32, grow func(prev uint64, cur uint64) uint64) time.Duration {
+	prev, cur := uint64(0), uint64(initValue)
+	for i := uint32(1); i < sentCount && cur < uint64(maxValue); i++ {
+		prev, cur = cur, grow(prev, cur)
+	}
+	if cur > uint64(maxValue) {
+		cur = uint64(maxValue)
+	}
+	return time.Duration(cur) * time.Minute
+}
+
+func parseRepeatSchedule(repeatSchedule string) ([]time.Duration, error) {
+	intervals := []time.Duration{}
+	for _, item := range strings.Split(repeatSchedule, ",") {
+		interval, err := time.ParseDuration(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		if interval < time.Minute {
+			return nil, fmt.Errorf("repeat interval [%s] is shorter than 1m", item)
+		}
+		intervals = append(intervals, interval)
+	}
+	return intervals, nil
+}
+
+//NewRepeatStrategy checks repeat config and creates its strategy.
+func NewRepeatStrategy(c RepeatConfig) (RepeatStrategy, error) {
+	switch c.RepeatType {
+	case RepeatTypeNormal:
+		return normalRepeat{}, nil
+	case RepeatTypeNotRepeat:
+		return notRepeat{}, nil
+	}
+
+	if c.MaxSendCount == 0 {
+		return nil, fmt.Errorf("max send count of [%s] should be positive", c.RepeatType)
+	}
+
+	switch c.RepeatType {
+	case RepeatTypeFixedMinutes, RepeatTypeExpMinutes, RepeatTypeCappedExpMinutes, RepeatTypeFibonacciMinutes:
+		if c.RepeatIntervalInitvalue == 0 {
+			return nil, fmt.Errorf("repeat interval initvalue of [%s] should be positive", c.RepeatType)
+		}
+		maxRepeatInterval := c.MaxRepeatInterval
+		if maxRepeatInterval == 0 {
+			if c.RepeatType == RepeatTypeCappedExpMinutes {
+				return nil, fmt.Errorf("max repeat interval of [%s] should be positive", c.RepeatType)
+			}
+			maxRepeatInterval = unboundedRepeatInterval
+		}
+		if maxRepeatInterval < c.RepeatIntervalInitvalue {
+			return nil, fmt.Errorf("max repeat interval [%d] is less than repeat interval initvalue [%d]", maxRepeatInterval, c.RepeatIntervalInitvalue)
+		}
+
+		var grow func(prev uint64, cur uint64) uint64
+		switch c.RepeatType {
+		case RepeatTypeFixedMinutes:
+			grow = func(prev uint64, cur uint64) uint64 { return cur }
+		case RepeatTypeExpMinutes, RepeatTypeCappedExpMinutes:
+			grow = func(prev uint64, cur uint64) uint64 { return cur * 2 }
+		case RepeatTypeFibonacciMinutes:
+			grow = func(prev uint64, cur uint64) uint64 {
+				if prev == 0 {
+					return cur
+				}
+				return prev + cur
+			}
+		}
+
+		initValue := c.RepeatIntervalInitvalue
+		return intervalRepeat{
+			maxSendCount: c.MaxSendCount,
+			interval: func(sentCount uint32) time.Duration {
+				return growInterval(sentCount, initValue, maxRepeatInterval, grow)
+			},
+		}, nil
+	case RepeatTypeSchedule:
+		intervals, err := parseRepeatSchedule(c.RepeatSchedule)
+		if err != nil {
+			return nil, err
+		}
+		return intervalRepeat{
+			maxSendCount: c.MaxSendCount,
+			interval: func(sentCount uint32) time.Duration {
+				if sentCount == 0 {
+					return intervals[0]
+				}
+				if int(sentCount) > len(intervals) {
+					return intervals[len(intervals)-1]
+				}
+				return intervals[sentCount-1]
+			},
+		}, nil
+	case RepeatTypeCron:
+		schedule, err := cronutil.Parse(c.RepeatCron)
+		if err != nil {
+			return nil, err
+		}
+		return cronRepeat{
+			maxSendCount: c.MaxSendCount,
+			schedule:     schedule,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported repeat type [%s]", c.RepeatType)
+}
+
+//ParsePolicyConfig parses repeat config of each severity in policy config, all of them should be valid.
+func ParsePolicyConfig(policyConfig string) (map[string]RepeatConfig, error) {
+	repeatConfigs := make(map[string]RepeatConfig)
+	err := json.Unmarshal([]byte(policyConfig), &repeatConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	for severity, repeatConfig := range repeatConfigs {
+		if severity == "" || len(severity) > 20 {
+			return nil, fmt.Errorf("illegal severity [%s] of policy config", severity)
+		}
+		_, err := NewRepeatStrategy(repeatConfig)
+		if err != nil {
+			return nil, fmt.Errorf("severity [%s]: %v", severity, err)
+		}
+	}
+
+	return repeatConfigs, nil
+}
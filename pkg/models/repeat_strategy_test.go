@@ -0,0 +1,58 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRepeatStrategy(t *testing.T) {
+	last := time.Date(2019, 9, 27, 10, 0, 0, 0, time.Local)
+	testCase := map[string][]time.Duration{
+		`{"repeat_type":"fixed-minutes","repeat_interval_initvalue":5,"max_send_count":5}`:                              {5, 5, 5, 5},
+		`{"repeat_type":"exp-minutes","repeat_interval_initvalue":2,"max_send_count":5}`:                                {2, 4, 8, 16},
+		`{"repeat_type":"exp-minutes","repeat_interval_initvalue":720,"max_send_count":5}`:                              {720, 1440, 2880, 5760},
+		`{"repeat_type":"capped-exp-minutes","repeat_interval_initvalue":2,"max_send_count":5,"max_repeat_interval":5}`: {2, 4, 5, 5},
+		`{"repeat_type":"fibonacci-minutes","repeat_interval_initvalue":1,"max_send_count":5}`:                          {1, 1, 2, 3, 5, 8},
+		`{"repeat_type":"schedule","repeat_schedule":"5m,15m,1h","max_send_count":5}`:                                   {5, 15, 60, 60},
+	}
+	for config, expects := range testCase {
+		repeatConfigs, err := ParsePolicyConfig(`{"critical":` + config + `}`)
+		if err != nil {
+			t.Fatalf("ParsePolicyConfig [%s] failed: %+v", config, err)
+		}
+		strategy, _ := NewRepeatStrategy(repeatConfigs["critical"])
+		for i, expect := range expects {
+			next := strategy.Next(uint32(i+1), last, last)
+			if next.Sub(last) != expect*time.Minute {
+				t.Fatalf("Next of [%s] after %d notifications expect [%v] but get [%v]", config, i+1, expect*time.Minute, next.Sub(last))
+			}
+		}
+		if !strategy.Repeatable(4) || strategy.Repeatable(5) {
+			t.Fatalf("Repeatable of [%s] should stop at max send count", config)
+		}
+	}
+
+	cron, _ := NewRepeatStrategy(RepeatConfig{RepeatType: RepeatTypeCron, RepeatCron: "0 9 * * *", MaxSendCount: 3})
+	if next := cron.Next(1, last, last); next != time.Date(2019, 9, 28, 9, 0, 0, 0, time.Local) {
+		t.Fatalf("Next of cron expect next 9 o'clock but get [%v]", next)
+	}
+
+	notRepeat, _ := NewRepeatStrategy(RepeatConfig{RepeatType: RepeatTypeNotRepeat})
+	if !notRepeat.Repeatable(0) || notRepeat.Repeatable(1) {
+		t.Fatalf("not-repeat should be sent only once")
+	}
+
+	for _, illegal := range []string{
+		`{"critical":{"repeat_type":"linear-minutes","repeat_interval_initvalue":1,"max_send_count":5}}`,
+		`{"critical":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":1}}`,
+		`{"critical":{"repeat_type":"fixed-minutes","max_send_count":5}}`,
+		`{"critical":{"repeat_type":"capped-exp-minutes","repeat_interval_initvalue":1,"max_send_count":5}}`,
+		`{"critical":{"repeat_type":"schedule","repeat_schedule":"5m,30s","max_send_count":5}}`,
+		`{"critical":{"repeat_type":"cron","repeat_cron":"0 9 * *","max_send_count":5}}`,
+		`{"":{"repeat_type":"normal"}}`,
+	} {
+		if _, err := ParsePolicyConfig(illegal); err == nil {
+			t.Fatalf("ParsePolicyConfig [%s] should fail", illegal)
+		}
+	}
+}
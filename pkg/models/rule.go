@@ -1,29 +1,38 @@
 package models
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"time"
 
 	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/exprutil"
 	"kubesphere.io/alert/pkg/util/idutil"
 	"kubesphere.io/alert/pkg/util/pbutil"
 )
 
 type Rule struct {
-	RuleId           string    `gorm:"column:rule_id" json:"rule_id"`
-	RuleName         string    `gorm:"column:rule_name" json:"rule_name"`
-	Disabled         bool      `gorm:"column:disabled" json:"disabled"`
-	MonitorPeriods   uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
-	Severity         string    `gorm:"column:severity" json:"severity"`
-	MetricsType      string    `gorm:"column:metrics_type" json:"metrics_type"`
-	ConditionType    string    `gorm:"column:condition_type" json:"condition_type"`
-	Thresholds       string    `gorm:"column:thresholds" json:"thresholds"`
-	Unit             string    `gorm:"column:unit" json:"unit"`
-	ConsecutiveCount uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
-	Inhibit          bool      `gorm:"column:inhibit" json:"inhibit"`
-	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
-	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
-	PolicyId         string    `gorm:"column:policy_id" json:"policy_id"`
-	MetricId         string    `gorm:"column:metric_id" json:"metric_id"`
+	RuleId                string    `gorm:"column:rule_id" json:"rule_id"`
+	RuleName              string    `gorm:"column:rule_name" json:"rule_name"`
+	Disabled              bool      `gorm:"column:disabled" json:"disabled"`
+	MonitorPeriods        uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
+	Severity              string    `gorm:"column:severity" json:"severity"`
+	MetricsType           string    `gorm:"column:metrics_type" json:"metrics_type"`
+	ConditionType         string    `gorm:"column:condition_type" json:"condition_type"`
+	Thresholds            string    `gorm:"column:thresholds" json:"thresholds"`
+	Unit                  string    `gorm:"column:unit" json:"unit"`
+	ConsecutiveCount      uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
+	Inhibit               bool      `gorm:"column:inhibit" json:"inhibit"`
+	CreateTime            time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime            time.Time `gorm:"column:update_time" json:"update_time"`
+	PolicyId              string    `gorm:"column:policy_id" json:"policy_id"`
+	MetricId              string    `gorm:"column:metric_id" json:"metric_id"`
+	PredictHorizon        uint32    `gorm:"column:predict_horizon" json:"predict_horizon"`
+	NodataState           string    `gorm:"column:nodata_state" json:"nodata_state"`
+	RecoveryThresholds    string    `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	ConsecutiveClearCount uint32    `gorm:"column:consecutive_clear_count" json:"consecutive_clear_count"`
+	Levels                string    `gorm:"column:levels" json:"levels"`
 }
 
 //table name
@@ -38,48 +47,179 @@ const (
 //field name
 //Rl is short for rule.
 const (
-	RlColId               = "rule_id"
-	RlColName             = "rule_name"
-	RlColDisabled         = "disabled"
-	RlColMonitorPeriods   = "monitor_periods"
-	RlColSeverity         = "severity"
-	RlColMetricsType      = "metrics_type"
-	RlColConditionType    = "condition_type"
-	RlColThresholds       = "thresholds"
-	RlColUnit             = "unit"
-	RlColConsecutiveCount = "consecutive_count"
-	RlColInhibit          = "inhibit"
-	RlColCreateTime       = "create_time"
-	RlColUpdateTime       = "update_time"
-	RlColPolicyId         = "policy_id"
-	RlColMetricId         = "metric_id"
+	RlColId                    = "rule_id"
+	RlColName                  = "rule_name"
+	RlColDisabled              = "disabled"
+	RlColMonitorPeriods        = "monitor_periods"
+	RlColSeverity              = "severity"
+	RlColMetricsType           = "metrics_type"
+	RlColConditionType         = "condition_type"
+	RlColThresholds            = "thresholds"
+	RlColUnit                  = "unit"
+	RlColConsecutiveCount      = "consecutive_count"
+	RlColInhibit               = "inhibit"
+	RlColCreateTime            = "create_time"
+	RlColUpdateTime            = "update_time"
+	RlColPolicyId              = "policy_id"
+	RlColMetricId              = "metric_id"
+	RlColPredictHorizon        = "predict_horizon"
+	RlColNodataState           = "nodata_state"
+	RlColRecoveryThresholds    = "recovery_thresholds"
+	RlColConsecutiveClearCount = "consecutive_clear_count"
+	RlColLevels                = "levels"
+)
+
+//Behaviors when a resource of rule has no data.
+//Empty nodata state is the same as keep last.
+const (
+	RuleNodataStateNodata   = "nodata"
+	RuleNodataStateKeepLast = "keep_last"
+	RuleNodataStateOk       = "ok"
+)
+
+//Variables available in rule condition expression.
+//Value is the scaled metric value, threshold is the parsed rule thresholds.
+const (
+	RuleConditionValue     = "value"
+	RuleConditionThreshold = "threshold"
 )
 
 func NewRuleId() string {
 	return idutil.GetUuid(RuleIdPrefix)
 }
 
-func NewRule(ruleName string, disabled bool, monitorPeriods uint32, severity string, metricsType string, conditionType string, thresholds string, unit string, consecutiveCount uint32, inhibit bool, policyId string, metricId string) *Rule {
+func NewRule(ruleName string, disabled bool, monitorPeriods uint32, severity string, metricsType string, conditionType string, thresholds string, unit string, consecutiveCount uint32, inhibit bool, policyId string, metricId string, predictHorizon uint32, nodataState string, recoveryThresholds string, consecutiveClearCount uint32, levels string) *Rule {
 	rule := &Rule{
-		RuleId:           NewRuleId(),
-		RuleName:         ruleName,
-		Disabled:         disabled,
-		MonitorPeriods:   monitorPeriods,
-		Severity:         severity,
-		MetricsType:      metricsType,
-		ConditionType:    conditionType,
-		Thresholds:       thresholds,
-		Unit:             unit,
-		ConsecutiveCount: consecutiveCount,
-		Inhibit:          inhibit,
-		CreateTime:       time.Now(),
-		UpdateTime:       time.Now(),
-		PolicyId:         policyId,
-		MetricId:         metricId,
+		RuleId:                NewRuleId(),
+		RuleName:              ruleName,
+		Disabled:              disabled,
+		MonitorPeriods:        monitorPeriods,
+		Severity:              severity,
+		MetricsType:           metricsType,
+		ConditionType:         conditionType,
+		Thresholds:            thresholds,
+		Unit:                  unit,
+		ConsecutiveCount:      consecutiveCount,
+		Inhibit:               inhibit,
+		CreateTime:            time.Now(),
+		UpdateTime:            time.Now(),
+		PolicyId:              policyId,
+		MetricId:              metricId,
+		PredictHorizon:        predictHorizon,
+		NodataState:           nodataState,
+		RecoveryThresholds:    recoveryThresholds,
+		ConsecutiveClearCount: consecutiveClearCount,
+		Levels:                levels,
 	}
 	return rule
 }
 
+//ParseRuleCondition compiles condition type of a rule.
+//Plain comparators like ">=" are kept for compatibility and compare value with threshold, legacy "=" means "==",
+//anything else is parsed as an expression, eg. "value > 80 && value < 95" or "outside(value, 20, 80)",
+//which should yield a truth value.
+func ParseRuleCondition(conditionType string) (*exprutil.Expr, error) {
+	switch conditionType {
+	case "=":
+		conditionType = RuleConditionValue + " == " + RuleConditionThreshold
+	case ">=", ">", "<=", "<", "==", "!=":
+		conditionType = RuleConditionValue + " " + conditionType + " " + RuleConditionThreshold
+	}
+
+	condition, err := exprutil.Parse(conditionType, RuleConditionValue, RuleConditionThreshold)
+	if err != nil {
+		return nil, err
+	}
+	if !condition.IsBoolean() {
+		return nil, fmt.Errorf("condition [%s] is not a comparison", conditionType)
+	}
+	return condition, nil
+}
+
+//RuleLevel escalates rule to severity when condition is met with thresholds.
+//Levels are ordered from less to more severe, after the level made of rule thresholds and severity.
+type RuleLevel struct {
+	Thresholds string `json:"thresholds"`
+	Severity   string `json:"severity"`
+}
+
+//ParseRuleLevels parses levels of a rule, eg. [{"thresholds":"95","severity":"critical"}].
+func ParseRuleLevels(levels string) ([]RuleLevel, error) {
+	ruleLevels := []RuleLevel{}
+	if levels == "" {
+		return ruleLevels, nil
+	}
+
+	err := json.Unmarshal([]byte(levels), &ruleLevels)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ruleLevel := range ruleLevels {
+		_, err := strconv.ParseFloat(ruleLevel.Thresholds, 64)
+		if err != nil {
+			return nil, err
+		}
+		if ruleLevel.Severity == "" || len(ruleLevel.Severity) > 20 {
+			return nil, fmt.Errorf("illegal severity [%s] of level", ruleLevel.Severity)
+		}
+	}
+
+	return ruleLevels, nil
+}
+
+//CheckRuleLevels checks that severities of rule and its levels are unique, and every level is more severe than the one before,
+//which means a value right at thresholds of the level meets the level before but not the other way round.
+func CheckRuleLevels(conditionType string, severity string, thresholds string, ruleLevels []RuleLevel) error {
+	if len(ruleLevels) == 0 {
+		return nil
+	}
+
+	condition, err := ParseRuleCondition(conditionType)
+	if err != nil {
+		return err
+	}
+	if !condition.References(RuleConditionThreshold) {
+		return fmt.Errorf("condition [%s] has no threshold to be raised by levels", conditionType)
+	}
+
+	allLevels := append([]RuleLevel{{Thresholds: thresholds, Severity: severity}}, ruleLevels...)
+	severities := make(map[string]bool)
+	for i, ruleLevel := range allLevels {
+		if severities[ruleLevel.Severity] {
+			return fmt.Errorf("duplicate severity [%s] of level", ruleLevel.Severity)
+		}
+		severities[ruleLevel.Severity] = true
+
+		if i == 0 {
+			continue
+		}
+
+		prev, err := strconv.ParseFloat(allLevels[i-1].Thresholds, 64)
+		if err != nil {
+			return err
+		}
+		cur, err := strconv.ParseFloat(ruleLevel.Thresholds, 64)
+		if err != nil {
+			return err
+		}
+
+		curMeetsPrev, err := condition.Eval(map[string]float64{RuleConditionValue: cur, RuleConditionThreshold: prev})
+		if err != nil {
+			return err
+		}
+		prevMeetsCur, err := condition.Eval(map[string]float64{RuleConditionValue: prev, RuleConditionThreshold: cur})
+		if err != nil {
+			return err
+		}
+		if !curMeetsPrev || prevMeetsCur {
+			return fmt.Errorf("thresholds [%s] of level [%s] is not more severe than [%s]", ruleLevel.Thresholds, ruleLevel.Severity, allLevels[i-1].Thresholds)
+		}
+	}
+
+	return nil
+}
+
 func RuleToPb(rule *Rule) *pb.Rule {
 	pbRule := pb.Rule{}
 	pbRule.RuleId = rule.RuleId
@@ -97,6 +237,11 @@ func RuleToPb(rule *Rule) *pb.Rule {
 	pbRule.UpdateTime = pbutil.ToProtoTimestamp(rule.UpdateTime)
 	pbRule.PolicyId = rule.PolicyId
 	pbRule.MetricId = rule.MetricId
+	pbRule.PredictHorizon = rule.PredictHorizon
+	pbRule.NodataState = rule.NodataState
+	pbRule.RecoveryThresholds = rule.RecoveryThresholds
+	pbRule.ConsecutiveClearCount = rule.ConsecutiveClearCount
+	pbRule.Levels = rule.Levels
 	return &pbRule
 }
 
@@ -110,20 +255,25 @@ func ParseRlSet2PbSet(inRls []*Rule) []*pb.Rule {
 }
 
 type RuleDetail struct {
-	RuleId           string    `gorm:"column:rule_id" json:"rule_id"`
-	RuleName         string    `gorm:"column:rule_name" json:"rule_name"`
-	Disabled         bool      `gorm:"column:disabled" json:"disabled"`
-	MonitorPeriods   uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
-	Severity         string    `gorm:"column:severity" json:"severity"`
-	MetricsType      string    `gorm:"column:metrics_type" json:"metrics_type"`
-	ConditionType    string    `gorm:"column:condition_type" json:"condition_type"`
-	Thresholds       string    `gorm:"column:thresholds" json:"thresholds"`
-	MetricParam      string    `gorm:"column:metric_param" json:"metric_param"`
-	Unit             string    `gorm:"column:unit" json:"unit"`
-	ConsecutiveCount uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
-	Inhibit          bool      `gorm:"column:inhibit" json:"inhibit"`
-	CreateTime       time.Time `gorm:"column:create_time" json:"create_time"`
-	UpdateTime       time.Time `gorm:"column:update_time" json:"update_time"`
-	PolicyId         string    `gorm:"column:policy_id" json:"policy_id"`
-	MetricId         string    `gorm:"column:metric_id" json:"metric_id"`
+	RuleId                string    `gorm:"column:rule_id" json:"rule_id"`
+	RuleName              string    `gorm:"column:rule_name" json:"rule_name"`
+	Disabled              bool      `gorm:"column:disabled" json:"disabled"`
+	MonitorPeriods        uint32    `gorm:"column:monitor_periods" json:"monitor_periods"`
+	Severity              string    `gorm:"column:severity" json:"severity"`
+	MetricsType           string    `gorm:"column:metrics_type" json:"metrics_type"`
+	ConditionType         string    `gorm:"column:condition_type" json:"condition_type"`
+	Thresholds            string    `gorm:"column:thresholds" json:"thresholds"`
+	MetricParam           string    `gorm:"column:metric_param" json:"metric_param"`
+	Unit                  string    `gorm:"column:unit" json:"unit"`
+	ConsecutiveCount      uint32    `gorm:"column:consecutive_count" json:"consecutive_count"`
+	Inhibit               bool      `gorm:"column:inhibit" json:"inhibit"`
+	CreateTime            time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime            time.Time `gorm:"column:update_time" json:"update_time"`
+	PolicyId              string    `gorm:"column:policy_id" json:"policy_id"`
+	MetricId              string    `gorm:"column:metric_id" json:"metric_id"`
+	PredictHorizon        uint32    `gorm:"column:predict_horizon" json:"predict_horizon"`
+	NodataState           string    `gorm:"column:nodata_state" json:"nodata_state"`
+	RecoveryThresholds    string    `gorm:"column:recovery_thresholds" json:"recovery_thresholds"`
+	ConsecutiveClearCount uint32    `gorm:"column:consecutive_clear_count" json:"consecutive_clear_count"`
+	Levels                string    `gorm:"column:levels" json:"levels"`
 }
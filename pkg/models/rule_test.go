@@ -0,0 +1,70 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestCheckRuleLevels(t *testing.T) {
+	testCase := []struct {
+		conditionType string
+		levels        string
+		valid         bool
+	}{
+		{">", `[{"thresholds":"90","severity":"major"},{"thresholds":"95","severity":"critical"}]`, true},
+		{">=", `[{"thresholds":"90","severity":"major"}]`, true},
+		{"<", `[{"thresholds":"10","severity":"major"},{"thresholds":"5","severity":"critical"}]`, true},
+		{"value > threshold && value < 1000", `[{"thresholds":"90","severity":"major"}]`, true},
+		{">", `[]`, true},
+		//Unordered thresholds
+		{">", `[{"thresholds":"95","severity":"major"},{"thresholds":"90","severity":"critical"}]`, false},
+		{"<", `[{"thresholds":"90","severity":"major"}]`, false},
+		//Same thresholds as the level before
+		{">=", `[{"thresholds":"80","severity":"major"}]`, false},
+		//Duplicate severities
+		{">", `[{"thresholds":"90","severity":"minor"}]`, false},
+		{">", `[{"thresholds":"90","severity":"major"},{"thresholds":"95","severity":"major"}]`, false},
+		//Levels make no sense to equality
+		{"==", `[{"thresholds":"90","severity":"major"}]`, false},
+		//Levels need threshold in condition
+		{"value > 80", `[{"thresholds":"90","severity":"major"}]`, false},
+	}
+
+	for _, tc := range testCase {
+		ruleLevels, err := ParseRuleLevels(tc.levels)
+		if err != nil {
+			t.Fatalf("ParseRuleLevels [%s] failed: %+v", tc.levels, err)
+		}
+		err = CheckRuleLevels(tc.conditionType, "minor", "80", ruleLevels)
+		if (err == nil) != tc.valid {
+			t.Fatalf("CheckRuleLevels [%s] [%s] expect valid [%v] but get [%v]", tc.conditionType, tc.levels, tc.valid, err)
+		}
+	}
+}
+
+func TestParseRuleCondition(t *testing.T) {
+	testCase := map[string]bool{
+		"=":                               true,
+		"==":                              true,
+		">=":                              true,
+		"value > threshold && value < 95": true,
+		"!(value > threshold)":            true,
+		"outside(value, 20, 80)":          true,
+		"value":                           false,
+		"value - threshold":               false,
+		"abs(value)":                      false,
+		"value = threshold":               false,
+	}
+	for conditionType, valid := range testCase {
+		_, err := ParseRuleCondition(conditionType)
+		if (err == nil) != valid {
+			t.Fatalf("ParseRuleCondition [%s] expect valid [%v] but get [%v]", conditionType, valid, err)
+		}
+	}
+
+	condition, _ := ParseRuleCondition("=")
+	for value, expect := range map[float64]bool{80: true, 81: false} {
+		if result, _ := condition.Eval(map[string]float64{RuleConditionValue: value, RuleConditionThreshold: 80}); result != expect {
+			t.Fatalf("Legacy condition [=] with value [%v] expect [%v] but get [%v]", value, expect, result)
+		}
+	}
+}
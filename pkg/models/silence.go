@@ -0,0 +1,133 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//Silence mutes notifications matched by matchers between start time and end time, eg. during planned maintenance.
+type Silence struct {
+	SilenceId  string    `gorm:"column:silence_id" json:"silence_id"`
+	Creator    string    `gorm:"column:creator" json:"creator"`
+	Comment    string    `gorm:"column:comment" json:"comment"`
+	Matchers   string    `gorm:"column:matchers" json:"matchers"`
+	StartTime  time.Time `gorm:"column:start_time" json:"start_time"`
+	EndTime    time.Time `gorm:"column:end_time" json:"end_time"`
+	CreateTime time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//SilenceMatchers selects notifications to mute, empty matcher matches anything.
+//RsFilterParam matches keys of resource filter param of alert, eg. {"node_id": "node1"}.
+type SilenceMatchers struct {
+	AlertName     string            `json:"alert_name"`
+	RuleName      string            `json:"rule_name"`
+	Severity      string            `json:"severity"`
+	ResourceName  string            `json:"resource_name"`
+	RsFilterParam map[string]string `json:"rs_filter_param"`
+}
+
+//table name
+const (
+	TableSilence = "silence"
+)
+
+const (
+	SilenceIdPrefix = "sl-"
+)
+
+//field name
+//Sl is short for silence.
+const (
+	SlColId         = "silence_id"
+	SlColCreator    = "creator"
+	SlColComment    = "comment"
+	SlColMatchers   = "matchers"
+	SlColStartTime  = "start_time"
+	SlColEndTime    = "end_time"
+	SlColCreateTime = "create_time"
+	SlColUpdateTime = "update_time"
+)
+
+func NewSilenceId() string {
+	return idutil.GetUuid(SilenceIdPrefix)
+}
+
+func NewSilence(creator string, comment string, matchers string, startTime time.Time, endTime time.Time) *Silence {
+	silence := &Silence{
+		SilenceId:  NewSilenceId(),
+		Creator:    creator,
+		Comment:    comment,
+		Matchers:   matchers,
+		StartTime:  startTime,
+		EndTime:    endTime,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}
+	return silence
+}
+
+func SilenceToPb(silence *Silence) *pb.Silence {
+	pbSilence := pb.Silence{}
+	pbSilence.SilenceId = silence.SilenceId
+	pbSilence.Creator = silence.Creator
+	pbSilence.Comment = silence.Comment
+	pbSilence.Matchers = silence.Matchers
+	pbSilence.StartTime = pbutil.ToProtoTimestamp(silence.StartTime)
+	pbSilence.EndTime = pbutil.ToProtoTimestamp(silence.EndTime)
+	pbSilence.CreateTime = pbutil.ToProtoTimestamp(silence.CreateTime)
+	pbSilence.UpdateTime = pbutil.ToProtoTimestamp(silence.UpdateTime)
+	return &pbSilence
+}
+
+func ParseSlSet2PbSet(inSls []*Silence) []*pb.Silence {
+	var pbSls []*pb.Silence
+	for _, inSl := range inSls {
+		pbSl := SilenceToPb(inSl)
+		pbSls = append(pbSls, pbSl)
+	}
+	return pbSls
+}
+
+//ParseSilenceMatchers parses matchers of a silence, at least one matcher is required so that a silence never mutes everything.
+func ParseSilenceMatchers(matchers string) (*SilenceMatchers, error) {
+	silenceMatchers := &SilenceMatchers{}
+	err := json.Unmarshal([]byte(matchers), silenceMatchers)
+	if err != nil {
+		return nil, err
+	}
+
+	if silenceMatchers.AlertName == "" && silenceMatchers.RuleName == "" && silenceMatchers.Severity == "" &&
+		silenceMatchers.ResourceName == "" && len(silenceMatchers.RsFilterParam) == 0 {
+		return nil, fmt.Errorf("no matcher specified")
+	}
+
+	return silenceMatchers, nil
+}
+
+//Match reports whether notification of resource is muted by matchers.
+func (m *SilenceMatchers) Match(alertName string, ruleName string, severity string, resourceName string, rsFilterParam map[string]string) bool {
+	if m.AlertName != "" && m.AlertName != alertName {
+		return false
+	}
+	if m.RuleName != "" && m.RuleName != ruleName {
+		return false
+	}
+	if m.Severity != "" && m.Severity != severity {
+		return false
+	}
+	if m.ResourceName != "" && m.ResourceName != resourceName {
+		return false
+	}
+	for k, v := range m.RsFilterParam {
+		if rsFilterParam[k] != v {
+			return false
+		}
+	}
+	return true
+}
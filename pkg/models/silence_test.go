@@ -0,0 +1,39 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestSilenceMatchers(t *testing.T) {
+	rsFilterParam := map[string]string{"node_id": "node1", "ns_name": "default"}
+	testCase := []struct {
+		matchers string
+		severity string
+		matched  bool
+	}{
+		{`{"alert_name":"alert-1"}`, "minor", true},
+		{`{"alert_name":"alert-2"}`, "minor", false},
+		{`{"rule_name":"cpu","severity":"critical"}`, "critical", true},
+		{`{"rule_name":"cpu","severity":"critical"}`, "minor", false},
+		{`{"resource_name":"node1"}`, "minor", true},
+		{`{"resource_name":"node2"}`, "minor", false},
+		{`{"rs_filter_param":{"node_id":"node1"}}`, "minor", true},
+		{`{"rs_filter_param":{"node_id":"node1","ns_name":"kube-system"}}`, "minor", false},
+		{`{"rs_filter_param":{"workspace":"system"}}`, "minor", false},
+	}
+	for _, c := range testCase {
+		matchers, err := ParseSilenceMatchers(c.matchers)
+		if err != nil {
+			t.Fatalf("ParseSilenceMatchers [%s] failed: %+v", c.matchers, err)
+		}
+		if matched := matchers.Match("alert-1", "cpu", c.severity, "node1", rsFilterParam); matched != c.matched {
+			t.Fatalf("Match [%s] of [%s] expect [%v] but get [%v]", c.matchers, c.severity, c.matched, matched)
+		}
+	}
+
+	for _, illegal := range []string{`{}`, `{"rs_filter_param":{}}`, `{"alert_name":`} {
+		if _, err := ParseSilenceMatchers(illegal); err == nil {
+			t.Fatalf("ParseSilenceMatchers [%s] should fail", illegal)
+		}
+	}
+}
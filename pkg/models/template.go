@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/idutil"
+	"kubesphere.io/alert/pkg/util/pbutil"
+)
+
+//Template renders title and content of notifications of policy in language,
+//template with empty policy id is used by policies without their own template.
+type Template struct {
+	TemplateId string    `gorm:"column:template_id" json:"template_id"`
+	PolicyId   string    `gorm:"column:policy_id" json:"policy_id"`
+	Language   string    `gorm:"column:language" json:"language"`
+	Format     string    `gorm:"column:format" json:"format"`
+	Title      string    `gorm:"column:title" json:"title"`
+	Content    string    `gorm:"column:content" json:"content"`
+	CreateTime time.Time `gorm:"column:create_time" json:"create_time"`
+	UpdateTime time.Time `gorm:"column:update_time" json:"update_time"`
+}
+
+//table name
+const (
+	TableTemplate = "template"
+)
+
+const (
+	TemplateIdPrefix = "tp-"
+)
+
+//field name
+//Tp is short for template.
+const (
+	TpColId         = "template_id"
+	TpColPolicyId   = "policy_id"
+	TpColLanguage   = "language"
+	TpColFormat     = "format"
+	TpColTitle      = "title"
+	TpColContent    = "content"
+	TpColCreateTime = "create_time"
+	TpColUpdateTime = "update_time"
+)
+
+func NewTemplateId() string {
+	return idutil.GetUuid(TemplateIdPrefix)
+}
+
+func NewTemplate(policyId string, language string, format string, title string, content string) *Template {
+	template := &Template{
+		TemplateId: NewTemplateId(),
+		PolicyId:   policyId,
+		Language:   language,
+		Format:     format,
+		Title:      title,
+		Content:    content,
+		CreateTime: time.Now(),
+		UpdateTime: time.Now(),
+	}
+	return template
+}
+
+func TemplateToPb(template *Template) *pb.Template {
+	pbTemplate := pb.Template{}
+	pbTemplate.TemplateId = template.TemplateId
+	pbTemplate.PolicyId = template.PolicyId
+	pbTemplate.Language = template.Language
+	pbTemplate.Format = template.Format
+	pbTemplate.Title = template.Title
+	pbTemplate.Content = template.Content
+	pbTemplate.CreateTime = pbutil.ToProtoTimestamp(template.CreateTime)
+	pbTemplate.UpdateTime = pbutil.ToProtoTimestamp(template.UpdateTime)
+	return &pbTemplate
+}
+
+func ParseTpSet2PbSet(inTps []*Template) []*pb.Template {
+	var pbTps []*pb.Template
+	for _, inTp := range inTps {
+		pbTp := TemplateToPb(inTp)
+		pbTps = append(pbTps, pbTp)
+	}
+	return pbTps
+}
@@ -3,15 +3,38 @@ package notification
 import ()
 
 type NotificationParam struct {
+	AlertName      string `json:"alert_name"`
 	ResourceName   string `json:"resource_name"`
 	RuleName       string `json:"rule_name"`
 	CumulatedCount uint32 `json:"cumulated_count"`
 	FirstTime      string `json:"first_time"`
 	LastTime       string `json:"last_time"`
 	LastValue      string `json:"last_value"`
+	ForecastTime   string `json:"forecast_time"`
+	Event          string `json:"event"`
+	//Digest lists notifications merged into one when event is digest, group or storm, event of each one is firing or resumed
+	Digest []NotificationParam `json:"digest"`
 }
 
+//Events of notification param.
+const (
+	EventFiring  = "firing"
+	EventResumed = "resumed"
+	EventDigest  = "digest"
+	EventGroup   = "group"
+	EventStorm   = "storm"
+
+	EventEscalation = "escalation"
+
+	EventFlappingStarted = "flapping_started"
+	EventFlappingStopped = "flapping_stopped"
+)
+
 type Email struct {
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	//Html is true if content is rendered by html template
+	Html bool `json:"-"`
+	//IdempotencyKey is the same in retries of a notification, so that receivers could drop duplicates
+	IdempotencyKey string `json:"-"`
 }
@@ -0,0 +1,43 @@
+package notification
+
+import (
+	"sync"
+)
+
+//Notifier sends email to addresses, and returns id of the notification if the notifier keeps track of it.
+type Notifier interface {
+	Notify(addresses []string, email *Email) (string, error)
+}
+
+//PerAddressNotifier delivers to each address separately and receivers may ignore idempotency key,
+//so each address of it is queued alone, and retry of a failed address never notifies the others again.
+type PerAddressNotifier interface {
+	Notifier
+	NotifyPerAddress() bool
+}
+
+//IsPerAddress reports whether addresses of notifier should be queued one by one.
+func IsPerAddress(notifier Notifier) bool {
+	n, ok := notifier.(PerAddressNotifier)
+	return ok && n.NotifyPerAddress()
+}
+
+var notifiers = make(map[string]Notifier)
+
+var notifiersLock sync.RWMutex
+
+//RegisterNotifier makes notifier available by name, name is the trigger action of action.
+func RegisterNotifier(name string, notifier Notifier) {
+	notifiersLock.Lock()
+	defer notifiersLock.Unlock()
+
+	notifiers[name] = notifier
+}
+
+//GetNotifier returns nil if no notifier is registered by name.
+func GetNotifier(name string) Notifier {
+	notifiersLock.RLock()
+	defer notifiersLock.RUnlock()
+
+	return notifiers[name]
+}
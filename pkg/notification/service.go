@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+
+	nf "kubesphere.io/alert/pkg/client/notification"
+)
+
+//ServiceNotifier sends to address lists by the notification service, addresses are address list ids.
+type ServiceNotifier struct{}
+
+func NewServiceNotifier() *ServiceNotifier {
+	return &ServiceNotifier{}
+}
+
+func (n *ServiceNotifier) Notify(addresses []string, email *Email) (string, error) {
+	nfAddressListIds, _ := json.Marshal(addresses)
+	success, notificationId := nf.SendNotification("other", string(nfAddressListIds), email.Title, email.Content)
+	if !success {
+		return "", fmt.Errorf("notification service failed to send to %v", addresses)
+	}
+	return notificationId, nil
+}
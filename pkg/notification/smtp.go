@@ -0,0 +1,155 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+//SmtpNotifier sends email by smtp server directly, addresses are email addresses separated by comma.
+type SmtpNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+func NewSmtpNotifier(host string, port string, username string, password string, from string, timeout time.Duration) *SmtpNotifier {
+	return &SmtpNotifier{
+		Host:     host,
+		Port:     port,
+		Username: username,
+		Password: password,
+		From:     from,
+		Timeout:  timeout,
+	}
+}
+
+func (n *SmtpNotifier) formatMessage(to []string, email *Email) []byte {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", n.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Title))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	if email.IdempotencyKey != "" {
+		fmt.Fprintf(&msg, "Message-ID: <%s@%s>\r\n", email.IdempotencyKey, n.Host)
+	}
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	if email.Html {
+		msg.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	} else {
+		msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	}
+	msg.WriteString("\r\n")
+	msg.WriteString(email.Content)
+	return msg.Bytes()
+}
+
+//sendMail works as smtp.SendMail, but gives up when connecting or any exchange with smtp server takes longer than timeout.
+func (n *SmtpNotifier) sendMail(auth smtp.Auth, to []string, msg []byte) error {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(n.Host, n.Port), n.Timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	extend := func() {
+		if n.Timeout > 0 {
+			conn.SetDeadline(time.Now().Add(n.Timeout))
+		}
+	}
+	extend()
+	c, err := smtp.NewClient(conn, n.Host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	extend()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		err = c.StartTLS(&tls.Config{ServerName: n.Host})
+		if err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server [%s] does not support AUTH", n.Host)
+		}
+		extend()
+		err = c.Auth(auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	extend()
+	err = c.Mail(n.From)
+	if err != nil {
+		return err
+	}
+	for _, addr := range to {
+		extend()
+		err = c.Rcpt(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	extend()
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	extend()
+	return c.Quit()
+}
+
+//Notify sends one email to all addresses.
+func (n *SmtpNotifier) Notify(addresses []string, email *Email) (string, error) {
+	if n.Host == "" {
+		return "", fmt.Errorf("smtp host is not configured")
+	}
+
+	to := []string{}
+	for _, address := range addresses {
+		list, err := mail.ParseAddressList(address)
+		if err != nil {
+			return "", err
+		}
+		for _, addr := range list {
+			to = append(to, addr.Address)
+		}
+	}
+	if len(to) == 0 {
+		return "", fmt.Errorf("no email address to send")
+	}
+
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	}
+
+	err := n.sendMail(auth, to, n.formatMessage(to, email))
+	if err != nil {
+		return "", err
+	}
+	return "", nil
+}
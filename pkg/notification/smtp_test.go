@@ -0,0 +1,116 @@
+package notification
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+//serveSmtp accepts one session of smtp client without auth, and sends recipients and data received to ch.
+func serveSmtp(t *testing.T, l net.Listener, ch chan []string) {
+	conn, err := l.Accept()
+	if err != nil {
+		t.Errorf("Accept failed: %+v", err)
+		close(ch)
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(s string) { conn.Write([]byte(s + "\r\n")) }
+
+	received := []string{}
+	reply("220 localhost")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			break
+		}
+		cmd := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			received = append(received, strings.TrimSpace(line[len("RCPT TO:"):]))
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 go ahead")
+			data := ""
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil || dataLine == ".\r\n" {
+					break
+				}
+				data += dataLine
+			}
+			received = append(received, data)
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			ch <- received
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+	ch <- received
+}
+
+func TestSmtpNotifier(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %+v", err)
+	}
+	defer l.Close()
+
+	ch := make(chan []string, 1)
+	go serveSmtp(t, l, ch)
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	n := NewSmtpNotifier(host, port, "", "", "alert@example.com", 5*time.Second)
+	_, err = n.Notify([]string{"ops@example.com, Admin <admin@example.com>"}, &Email{Title: "cpu high", Content: "<b>node1</b> cpu 95%"})
+	if err != nil {
+		t.Fatalf("Notify failed: %+v", err)
+	}
+
+	received := <-ch
+	if len(received) != 3 || received[0] != "<ops@example.com>" || received[1] != "<admin@example.com>" {
+		t.Fatalf("Notify expect recipients ops and admin but get %v", received)
+	}
+	data := received[2]
+	if !strings.Contains(data, "Subject: cpu high\r\n") || !strings.Contains(data, "To: ops@example.com, admin@example.com\r\n") ||
+		!strings.HasSuffix(data, "\r\n\r\n<b>node1</b> cpu 95%\r\n") {
+		t.Fatalf("Notify get unexpected message %q", data)
+	}
+
+	if _, err := n.Notify([]string{"ops"}, &Email{}); err == nil {
+		t.Fatalf("Notify invalid email address should fail")
+	}
+}
+
+func TestSmtpNotifierTimeout(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %+v", err)
+	}
+	defer l.Close()
+
+	//Server accepts but never greets
+	go func() {
+		conn, err := l.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(2 * time.Second)
+		}
+	}()
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	n := NewSmtpNotifier(host, port, "", "", "alert@example.com", 100*time.Millisecond)
+	startTime := time.Now()
+	_, err = n.Notify([]string{"ops@example.com"}, &Email{Title: "cpu high"})
+	if err == nil || time.Since(startTime) > time.Second {
+		t.Fatalf("Notify silent smtp server should time out, error %+v after %v", err, time.Since(startTime))
+	}
+}
@@ -0,0 +1,197 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+)
+
+//Formats of template, text content is rendered by text/template and html content by html/template which escapes values.
+const (
+	TemplateFormatText = "text"
+	TemplateFormatHtml = "html"
+)
+
+//Languages of built-in templates, unknown language falls back to english.
+const (
+	LanguageEn = "en"
+	LanguageZh = "zh"
+)
+
+//TemplateData is the data of title and content templates, Resume is true for resumed notifications and digests of resumed ones only.
+type TemplateData struct {
+	NotificationParam
+	Resume bool
+}
+
+type executor interface {
+	Execute(w io.Writer, data interface{}) error
+}
+
+//Template renders email of notification param.
+type Template struct {
+	Format  string
+	title   executor
+	content executor
+}
+
+//NewTemplate parses title and content templates, title is always rendered as text.
+func NewTemplate(format string, title string, content string) (*Template, error) {
+	titleTemplate, err := texttemplate.New("title").Parse(title)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Template{
+		Format: format,
+		title:  titleTemplate,
+	}
+
+	switch format {
+	case "", TemplateFormatText:
+		t.Format = TemplateFormatText
+		t.content, err = texttemplate.New("content").Parse(content)
+	case TemplateFormatHtml:
+		t.content, err = htmltemplate.New("content").Parse(content)
+	default:
+		return nil, fmt.Errorf("unsupported template format [%s]", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+//Render fills event of notification param by resume if it is empty.
+func (t *Template) Render(notificationParam NotificationParam, resume bool) (*Email, error) {
+	if notificationParam.Event == "" {
+		notificationParam.Event = EventFiring
+		if resume {
+			notificationParam.Event = EventResumed
+		}
+	}
+	data := TemplateData{
+		NotificationParam: notificationParam,
+		Resume:            resume,
+	}
+
+	var title, content bytes.Buffer
+	err := t.title.Execute(&title, data)
+	if err != nil {
+		return nil, err
+	}
+	err = t.content.Execute(&content, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Email{
+		Title:   strings.TrimSpace(title.String()),
+		Content: content.String(),
+		Html:    t.Format == TemplateFormatHtml,
+	}, nil
+}
+
+const defaultTitleEn = `[{{.AlertName}}] ` +
+	`{{if eq .Event "digest" "group" "storm"}}{{.CumulatedCount}} {{if .Resume}}resumed {{end}}notifications` +
+	`{{else if eq .Event "escalation"}}Escalated: {{.RuleName}} on {{.ResourceName}}` +
+	`{{else if eq .Event "flapping_started"}}Flapping: {{.RuleName}} on {{.ResourceName}}` +
+	`{{else if eq .Event "flapping_stopped"}}Stopped flapping: {{.RuleName}} on {{.ResourceName}}` +
+	`{{else if .Resume}}Resumed: {{.RuleName}} on {{.ResourceName}}` +
+	`{{else}}Firing: {{.RuleName}} on {{.ResourceName}}{{end}}`
+
+const defaultContentEn = `Alert: {{.AlertName}}
+{{if .Digest}}Notifications: {{.CumulatedCount}}
+From: {{.FirstTime}}
+To: {{.LastTime}}
+{{range .Digest}}
+- [{{.Event}}] {{if .AlertName}}{{.AlertName}} {{end}}{{.RuleName}} on {{.ResourceName}}, value {{.LastValue}}, {{.FirstTime}} ~ {{.LastTime}}
+{{- end}}
+{{else}}Rule: {{.RuleName}}
+Resource: {{.ResourceName}}
+Event: {{.Event}}
+Value: {{.LastValue}}
+{{if .ForecastTime}}Forecast time: {{.ForecastTime}}
+{{end}}Count: {{.CumulatedCount}}
+First time: {{.FirstTime}}
+Last time: {{.LastTime}}
+{{end}}`
+
+const defaultTitleZh = `[{{.AlertName}}] ` +
+	`{{if eq .Event "digest" "group" "storm"}}{{.CumulatedCount}} 条{{if .Resume}}恢复{{end}}通知` +
+	`{{else if eq .Event "escalation"}}告警升级: {{.ResourceName}} {{.RuleName}}` +
+	`{{else if eq .Event "flapping_started"}}告警抖动: {{.ResourceName}} {{.RuleName}}` +
+	`{{else if eq .Event "flapping_stopped"}}停止抖动: {{.ResourceName}} {{.RuleName}}` +
+	`{{else if .Resume}}告警恢复: {{.ResourceName}} {{.RuleName}}` +
+	`{{else}}告警: {{.ResourceName}} {{.RuleName}}{{end}}`
+
+const defaultContentZh = `告警: {{.AlertName}}
+{{if .Digest}}通知数: {{.CumulatedCount}}
+开始时间: {{.FirstTime}}
+结束时间: {{.LastTime}}
+{{range .Digest}}
+- [{{.Event}}] {{if .AlertName}}{{.AlertName}} {{end}}{{.ResourceName}} {{.RuleName}}, 当前值 {{.LastValue}}, {{.FirstTime}} ~ {{.LastTime}}
+{{- end}}
+{{else}}规则: {{.RuleName}}
+资源: {{.ResourceName}}
+事件: {{.Event}}
+当前值: {{.LastValue}}
+{{if .ForecastTime}}预测时间: {{.ForecastTime}}
+{{end}}次数: {{.CumulatedCount}}
+首次告警时间: {{.FirstTime}}
+最近告警时间: {{.LastTime}}
+{{end}}`
+
+var defaultTemplates = map[string]*Template{}
+
+func init() {
+	defaultTemplates[LanguageEn], _ = NewTemplate(TemplateFormatText, defaultTitleEn, defaultContentEn)
+	defaultTemplates[LanguageZh], _ = NewTemplate(TemplateFormatText, defaultTitleZh, defaultContentZh)
+}
+
+//DefaultTemplate returns built-in template of language, eg. "zh" or "zh_CN" for chinese.
+func DefaultTemplate(language string) *Template {
+	language = strings.ToLower(language)
+	if strings.HasPrefix(language, LanguageZh) {
+		return defaultTemplates[LanguageZh]
+	}
+	return defaultTemplates[LanguageEn]
+}
+
+//SampleNotificationParam is rendered when previewing template without notification param.
+func SampleNotificationParam() NotificationParam {
+	return NotificationParam{
+		AlertName:      "alert-sample",
+		ResourceName:   "node1",
+		RuleName:       "node cpu utilization high",
+		CumulatedCount: 3,
+		FirstTime:      "2019-01-01 10:00:00",
+		LastTime:       "2019-01-01 10:10:00",
+		LastValue:      "95.00%",
+	}
+}
+
+//CheckTemplate parses templates and renders them with sample notification params,
+//so that a template referring to unknown fields is rejected before it is used.
+func CheckTemplate(format string, title string, content string) error {
+	t, err := NewTemplate(format, title, content)
+	if err != nil {
+		return err
+	}
+
+	sample := SampleNotificationParam()
+	digest := sample
+	digest.Event = EventDigest
+	digest.Digest = []NotificationParam{sample}
+	for _, param := range []NotificationParam{sample, digest} {
+		_, err = t.Render(param, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultTemplate(t *testing.T) {
+	param := NotificationParam{
+		AlertName:    "alert-1",
+		RuleName:     "cpu high",
+		ResourceName: "node1",
+		LastValue:    "95.00%",
+	}
+
+	email, err := DefaultTemplate("en").Render(param, false)
+	if err != nil || email.Title != "[alert-1] Firing: cpu high on node1" || !strings.Contains(email.Content, "Event: firing\n") {
+		t.Fatalf("Render firing get %+v %+v", email, err)
+	}
+
+	email, err = DefaultTemplate("").Render(param, true)
+	if err != nil || email.Title != "[alert-1] Resumed: cpu high on node1" {
+		t.Fatalf("Render resumed get %+v %+v", email, err)
+	}
+
+	email, err = DefaultTemplate("zh_CN").Render(param, false)
+	if err != nil || email.Title != "[alert-1] 告警: node1 cpu high" {
+		t.Fatalf("Render chinese get %+v %+v", email, err)
+	}
+
+	flappingParam := param
+	flappingParam.Event = EventFlappingStopped
+	email, err = DefaultTemplate("en").Render(flappingParam, false)
+	if err != nil || email.Title != "[alert-1] Stopped flapping: cpu high on node1" {
+		t.Fatalf("Render flapping stopped get %+v %+v", email, err)
+	}
+
+	digestParam := NotificationParam{
+		AlertName:      "alert-1",
+		CumulatedCount: 2,
+		Event:          EventDigest,
+		Digest:         []NotificationParam{param, param},
+	}
+	email, err = DefaultTemplate("en").Render(digestParam, false)
+	if err != nil || email.Title != "[alert-1] 2 notifications" || strings.Count(email.Content, "cpu high on node1") != 2 {
+		t.Fatalf("Render digest get %+v %+v", email, err)
+	}
+
+	resumedParam := param
+	resumedParam.Event = EventResumed
+	groupParam := NotificationParam{
+		AlertName:      "alert-1",
+		CumulatedCount: 2,
+		Event:          EventGroup,
+		Digest:         []NotificationParam{resumedParam, resumedParam},
+	}
+	email, err = DefaultTemplate("en").Render(groupParam, true)
+	if err != nil || email.Title != "[alert-1] 2 resumed notifications" || strings.Count(email.Content, "[resumed] alert-1 cpu high on node1") != 2 {
+		t.Fatalf("Render resumed group get %+v %+v", email, err)
+	}
+}
+
+func TestNewTemplate(t *testing.T) {
+	param := NotificationParam{ResourceName: "<node1>"}
+
+	tpl, err := NewTemplate(TemplateFormatHtml, "{{.ResourceName}} {{.Event}}", "<b>{{.ResourceName}}</b>")
+	if err != nil {
+		t.Fatalf("NewTemplate html failed: %+v", err)
+	}
+	email, err := tpl.Render(param, true)
+	if err != nil || email.Title != "<node1> resumed" || email.Content != "<b>&lt;node1&gt;</b>" || !email.Html {
+		t.Fatalf("Render html get %+v %+v", email, err)
+	}
+
+	tpl, err = NewTemplate("", "", "{{.ResourceName}}")
+	if err != nil {
+		t.Fatalf("NewTemplate text failed: %+v", err)
+	}
+	email, err = tpl.Render(param, false)
+	if err != nil || email.Content != "<node1>" || email.Html {
+		t.Fatalf("Render text get %+v %+v", email, err)
+	}
+
+	if _, err := NewTemplate("markdown", "", ""); err == nil {
+		t.Fatalf("NewTemplate unsupported format should fail")
+	}
+	if _, err := NewTemplate("", "{{.ResourceName", ""); err == nil {
+		t.Fatalf("NewTemplate illegal title should fail")
+	}
+	if err := CheckTemplate("", "", "{{.Unknown}}"); err == nil {
+		t.Fatalf("CheckTemplate unknown field should fail")
+	}
+	if err := CheckTemplate(TemplateFormatHtml, defaultTitleEn, defaultContentEn); err != nil {
+		t.Fatalf("CheckTemplate default template failed: %+v", err)
+	}
+}
@@ -0,0 +1,135 @@
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+//Payload formats of incoming webhooks.
+const (
+	WebhookFormatJson     = "json"
+	WebhookFormatSlack    = "slack"
+	WebhookFormatDingTalk = "dingtalk"
+	WebhookFormatWeChat   = "wechat"
+)
+
+//Response of webhook is read up to maxWebhookResponseSize bytes, and at most maxWebhookErrorBodySize bytes of it are kept in error.
+const (
+	maxWebhookResponseSize  = 64 * 1024
+	maxWebhookErrorBodySize = 256
+)
+
+//WebhookNotifier posts email to incoming webhooks, addresses are urls of webhooks.
+type WebhookNotifier struct {
+	Format string
+	Client *http.Client
+}
+
+func NewWebhookNotifier(format string, timeout time.Duration) *WebhookNotifier {
+	return &WebhookNotifier{
+		Format: format,
+		Client: &http.Client{Timeout: timeout},
+	}
+}
+
+//chatResponse is the response of dingtalk and wechat, which reply http 200 with non-zero errcode on failure.
+type chatResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (n *WebhookNotifier) formatPayload(email *Email) interface{} {
+	switch n.Format {
+	case WebhookFormatSlack:
+		return map[string]string{
+			"text": fmt.Sprintf("*%s*\n%s", email.Title, email.Content),
+		}
+	case WebhookFormatDingTalk:
+		return map[string]interface{}{
+			"msgtype": "markdown",
+			"markdown": map[string]string{
+				"title": email.Title,
+				"text":  fmt.Sprintf("### %s\n\n%s", email.Title, email.Content),
+			},
+		}
+	case WebhookFormatWeChat:
+		return map[string]interface{}{
+			"msgtype": "markdown",
+			"markdown": map[string]string{
+				"content": fmt.Sprintf("### %s\n%s", email.Title, email.Content),
+			},
+		}
+	}
+	return email
+}
+
+func (n *WebhookNotifier) post(url string, payload []byte, idempotencyKey string) error {
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := n.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseSize))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook [%s] responds %d: %s", url, resp.StatusCode, truncateWebhookBody(body))
+	}
+
+	if n.Format == WebhookFormatDingTalk || n.Format == WebhookFormatWeChat {
+		chatResp := chatResponse{}
+		err = json.Unmarshal(body, &chatResp)
+		if err != nil {
+			return fmt.Errorf("webhook [%s] responds unknown body %s", url, truncateWebhookBody(body))
+		}
+		if chatResp.ErrCode != 0 {
+			return fmt.Errorf("webhook [%s] responds errcode %d: %s", url, chatResp.ErrCode, chatResp.ErrMsg)
+		}
+	}
+	return nil
+}
+
+func truncateWebhookBody(body []byte) string {
+	if len(body) > maxWebhookErrorBodySize {
+		return string(body[:maxWebhookErrorBodySize]) + "..."
+	}
+	return string(body)
+}
+
+//NotifyPerAddress is true since slack and dingtalk ignore Idempotency-Key.
+func (n *WebhookNotifier) NotifyPerAddress() bool {
+	return true
+}
+
+//Notify posts to every webhook even if some of them fail, and returns the first error.
+func (n *WebhookNotifier) Notify(addresses []string, email *Email) (string, error) {
+	payload, err := json.Marshal(n.formatPayload(email))
+	if err != nil {
+		return "", err
+	}
+
+	var firstErr error
+	for _, url := range addresses {
+		err = n.post(url, payload, email.IdempotencyKey)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return "", firstErr
+}
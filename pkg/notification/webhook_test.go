@@ -0,0 +1,93 @@
+package notification
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	email := &Email{Title: "cpu high", Content: "node1 cpu 95%", IdempotencyKey: "key-1"}
+	testCase := map[string]string{
+		WebhookFormatJson:     `{"title":"cpu high","content":"node1 cpu 95%"}`,
+		WebhookFormatSlack:    `{"text":"*cpu high*\nnode1 cpu 95%"}`,
+		WebhookFormatDingTalk: `{"markdown":{"text":"### cpu high\n\nnode1 cpu 95%","title":"cpu high"},"msgtype":"markdown"}`,
+		WebhookFormatWeChat:   `{"markdown":{"content":"### cpu high\nnode1 cpu 95%"},"msgtype":"markdown"}`,
+	}
+	for format, expect := range testCase {
+		received := ""
+		idempotencyKey := ""
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			received = string(body)
+			idempotencyKey = r.Header.Get("Idempotency-Key")
+			w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+		}))
+
+		_, err := NewWebhookNotifier(format, time.Second).Notify([]string{server.URL}, email)
+		server.Close()
+		if err != nil {
+			t.Fatalf("Notify [%s] failed: %+v", format, err)
+		}
+		if idempotencyKey != email.IdempotencyKey {
+			t.Fatalf("Notify [%s] expect idempotency key [%s] but get [%s]", format, email.IdempotencyKey, idempotencyKey)
+		}
+
+		var expectPayload, receivedPayload interface{}
+		json.Unmarshal([]byte(expect), &expectPayload)
+		json.Unmarshal([]byte(received), &receivedPayload)
+		expectBytes, _ := json.Marshal(expectPayload)
+		receivedBytes, _ := json.Marshal(receivedPayload)
+		if string(expectBytes) != string(receivedBytes) {
+			t.Fatalf("Notify [%s] expect payload %s but get %s", format, expect, received)
+		}
+	}
+}
+
+func TestWebhookNotifierFailure(t *testing.T) {
+	email := &Email{Title: "cpu high", Content: "node1 cpu 95%"}
+
+	errcodeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errcode":310000,"errmsg":"keywords not in content"}`))
+	}))
+	defer errcodeServer.Close()
+	if _, err := NewWebhookNotifier(WebhookFormatDingTalk, time.Second).Notify([]string{errcodeServer.URL}, email); err == nil {
+		t.Fatalf("Notify dingtalk with non-zero errcode should fail")
+	}
+
+	count := 0
+	statusServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		if count == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer statusServer.Close()
+	_, err := NewWebhookNotifier(WebhookFormatJson, time.Second).Notify([]string{statusServer.URL, statusServer.URL}, email)
+	if err == nil || count != 2 {
+		t.Fatalf("Notify should post to every webhook and fail on http error, get count [%d] %+v", count, err)
+	}
+
+	largeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(strings.Repeat("x", maxWebhookResponseSize*2)))
+	}))
+	defer largeServer.Close()
+	_, err = NewWebhookNotifier(WebhookFormatJson, time.Second).Notify([]string{largeServer.URL}, email)
+	if err == nil || len(err.Error()) > len(largeServer.URL)+maxWebhookErrorBodySize+64 {
+		t.Fatalf("Notify should fail with truncated response body, get %+v", err)
+	}
+}
+
+func TestWebhookNotifyPerAddress(t *testing.T) {
+	if !IsPerAddress(NewWebhookNotifier(WebhookFormatSlack, time.Second)) {
+		t.Fatalf("Webhook notifier should be queued per address")
+	}
+	if IsPerAddress(NewSmtpNotifier("", "", "", "", "", time.Second)) {
+		t.Fatalf("Smtp notifier should be queued for all addresses at once")
+	}
+}
@@ -2020,20 +2020,26 @@ func (m *DeleteMetricsResponse) GetMetricId() []string {
 //4.Policy
 //********************************************************************************************************
 type Policy struct {
-	PolicyId             string               `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	PolicyName           string               `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
-	PolicyDescription    string               `protobuf:"bytes,3,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
-	PolicyConfig         string               `protobuf:"bytes,4,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
-	Creator              string               `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator"`
-	AvailableStartTime   string               `protobuf:"bytes,6,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
-	AvailableEndTime     string               `protobuf:"bytes,7,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
-	CreateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time"`
-	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
-	RsTypeId             string               `protobuf:"bytes,10,opt,name=rs_type_id,json=rsTypeId,proto3" json:"rs_type_id"`
-	Language             string               `protobuf:"bytes,11,opt,name=language,proto3" json:"language"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	PolicyId           string               `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	PolicyName         string               `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
+	PolicyDescription  string               `protobuf:"bytes,3,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
+	PolicyConfig       string               `protobuf:"bytes,4,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
+	Creator            string               `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator"`
+	AvailableStartTime string               `protobuf:"bytes,6,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
+	AvailableEndTime   string               `protobuf:"bytes,7,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
+	CreateTime         *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime         *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	RsTypeId           string               `protobuf:"bytes,10,opt,name=rs_type_id,json=rsTypeId,proto3" json:"rs_type_id"`
+	Language           string               `protobuf:"bytes,11,opt,name=language,proto3" json:"language"`
+	//json of timezone, windows and exclude dates, takes the place of available start and end time
+	AvailableSchedule string `protobuf:"bytes,12,opt,name=available_schedule,json=availableSchedule,proto3" json:"available_schedule"`
+	//json of group by, group wait and group interval, empty sends one notification for each resource
+	GroupConfig string `protobuf:"bytes,13,opt,name=group_config,json=groupConfig,proto3" json:"group_config"`
+	//json of routing tree, routes matching severity, rule name, resource name pattern or labels to address lists and repeat configs
+	RouteConfig          string   `protobuf:"bytes,14,opt,name=route_config,json=routeConfig,proto3" json:"route_config"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -2138,6 +2144,27 @@ func (m *Policy) GetLanguage() string {
 	return ""
 }
 
+func (m *Policy) GetAvailableSchedule() string {
+	if m != nil {
+		return m.AvailableSchedule
+	}
+	return ""
+}
+
+func (m *Policy) GetGroupConfig() string {
+	if m != nil {
+		return m.GroupConfig
+	}
+	return ""
+}
+
+func (m *Policy) GetRouteConfig() string {
+	if m != nil {
+		return m.RouteConfig
+	}
+	return ""
+}
+
 type CreatePolicyRequest struct {
 	PolicyName           string   `protobuf:"bytes,1,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
 	PolicyDescription    string   `protobuf:"bytes,2,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
@@ -2147,6 +2174,9 @@ type CreatePolicyRequest struct {
 	AvailableEndTime     string   `protobuf:"bytes,6,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
 	RsTypeId             string   `protobuf:"bytes,7,opt,name=rs_type_id,json=rsTypeId,proto3" json:"rs_type_id"`
 	Language             string   `protobuf:"bytes,8,opt,name=language,proto3" json:"language"`
+	AvailableSchedule    string   `protobuf:"bytes,9,opt,name=available_schedule,json=availableSchedule,proto3" json:"available_schedule"`
+	GroupConfig          string   `protobuf:"bytes,10,opt,name=group_config,json=groupConfig,proto3" json:"group_config"`
+	RouteConfig          string   `protobuf:"bytes,11,opt,name=route_config,json=routeConfig,proto3" json:"route_config"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2233,6 +2263,27 @@ func (m *CreatePolicyRequest) GetLanguage() string {
 	return ""
 }
 
+func (m *CreatePolicyRequest) GetAvailableSchedule() string {
+	if m != nil {
+		return m.AvailableSchedule
+	}
+	return ""
+}
+
+func (m *CreatePolicyRequest) GetGroupConfig() string {
+	if m != nil {
+		return m.GroupConfig
+	}
+	return ""
+}
+
+func (m *CreatePolicyRequest) GetRouteConfig() string {
+	if m != nil {
+		return m.RouteConfig
+	}
+	return ""
+}
+
 type CreatePolicyResponse struct {
 	PolicyId             string   `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2431,18 +2482,24 @@ func (m *DescribePoliciesResponse) GetPolicySet() []*Policy {
 }
 
 type ModifyPolicyRequest struct {
-	PolicyId             string   `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	PolicyName           string   `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
-	PolicyDescription    string   `protobuf:"bytes,3,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
-	PolicyConfig         string   `protobuf:"bytes,4,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
-	Creator              string   `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator"`
-	AvailableStartTime   string   `protobuf:"bytes,6,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
-	AvailableEndTime     string   `protobuf:"bytes,7,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
-	RsTypeId             string   `protobuf:"bytes,8,opt,name=rs_type_id,json=rsTypeId,proto3" json:"rs_type_id"`
-	Language             string   `protobuf:"bytes,9,opt,name=language,proto3" json:"language"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PolicyId               string   `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	PolicyName             string   `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
+	PolicyDescription      string   `protobuf:"bytes,3,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
+	PolicyConfig           string   `protobuf:"bytes,4,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
+	Creator                string   `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator"`
+	AvailableStartTime     string   `protobuf:"bytes,6,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
+	AvailableEndTime       string   `protobuf:"bytes,7,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
+	RsTypeId               string   `protobuf:"bytes,8,opt,name=rs_type_id,json=rsTypeId,proto3" json:"rs_type_id"`
+	Language               string   `protobuf:"bytes,9,opt,name=language,proto3" json:"language"`
+	AvailableSchedule      string   `protobuf:"bytes,10,opt,name=available_schedule,json=availableSchedule,proto3" json:"available_schedule"`
+	GroupConfig            string   `protobuf:"bytes,11,opt,name=group_config,json=groupConfig,proto3" json:"group_config"`
+	RouteConfig            string   `protobuf:"bytes,12,opt,name=route_config,json=routeConfig,proto3" json:"route_config"`
+	ClearAvailableSchedule bool     `protobuf:"varint,13,opt,name=clear_available_schedule,json=clearAvailableSchedule,proto3" json:"clear_available_schedule"`
+	ClearGroupConfig       bool     `protobuf:"varint,14,opt,name=clear_group_config,json=clearGroupConfig,proto3" json:"clear_group_config"`
+	ClearRouteConfig       bool     `protobuf:"varint,15,opt,name=clear_route_config,json=clearRouteConfig,proto3" json:"clear_route_config"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
 }
 
 func (m *ModifyPolicyRequest) Reset()         { *m = ModifyPolicyRequest{} }
@@ -2533,6 +2590,48 @@ func (m *ModifyPolicyRequest) GetLanguage() string {
 	return ""
 }
 
+func (m *ModifyPolicyRequest) GetAvailableSchedule() string {
+	if m != nil {
+		return m.AvailableSchedule
+	}
+	return ""
+}
+
+func (m *ModifyPolicyRequest) GetGroupConfig() string {
+	if m != nil {
+		return m.GroupConfig
+	}
+	return ""
+}
+
+func (m *ModifyPolicyRequest) GetRouteConfig() string {
+	if m != nil {
+		return m.RouteConfig
+	}
+	return ""
+}
+
+func (m *ModifyPolicyRequest) GetClearAvailableSchedule() bool {
+	if m != nil {
+		return m.ClearAvailableSchedule
+	}
+	return false
+}
+
+func (m *ModifyPolicyRequest) GetClearGroupConfig() bool {
+	if m != nil {
+		return m.ClearGroupConfig
+	}
+	return false
+}
+
+func (m *ModifyPolicyRequest) GetClearRouteConfig() bool {
+	if m != nil {
+		return m.ClearRouteConfig
+	}
+	return false
+}
+
 type ModifyPolicyResponse struct {
 	PolicyId             string   `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2653,24 +2752,29 @@ func (m *DeletePoliciesResponse) GetPolicyId() []string {
 //5.Rule
 //********************************************************************************************************
 type Rule struct {
-	RuleId               string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
-	RuleName             string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32               `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string               `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string               `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string               `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string               `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string               `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32               `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool                 `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
-	CreateTime           *timestamp.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time"`
-	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
-	PolicyId             string               `protobuf:"bytes,14,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	MetricId             string               `protobuf:"bytes,15,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	RuleId                string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	RuleName              string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled              bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods        uint32               `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity              string               `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
+	MetricsType           string               `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType         string               `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds            string               `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                  string               `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount      uint32               `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit               bool                 `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
+	CreateTime            *timestamp.Timestamp `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime            *timestamp.Timestamp `protobuf:"bytes,13,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	PolicyId              string               `protobuf:"bytes,14,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	MetricId              string               `protobuf:"bytes,15,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
+	PredictHorizon        uint32               `protobuf:"varint,16,opt,name=predict_horizon,json=predictHorizon,proto3" json:"predict_horizon"`
+	NodataState           string               `protobuf:"bytes,17,opt,name=nodata_state,json=nodataState,proto3" json:"nodata_state"`
+	RecoveryThresholds    string               `protobuf:"bytes,18,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveClearCount uint32               `protobuf:"varint,19,opt,name=consecutive_clear_count,json=consecutiveClearCount,proto3" json:"consecutive_clear_count"`
+	Levels                string               `protobuf:"bytes,20,opt,name=levels,proto3" json:"levels"`
+	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
+	XXX_unrecognized      []byte               `json:"-"`
+	XXX_sizecache         int32                `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -2803,22 +2907,62 @@ func (m *Rule) GetMetricId() string {
 	return ""
 }
 
+func (m *Rule) GetPredictHorizon() uint32 {
+	if m != nil {
+		return m.PredictHorizon
+	}
+	return 0
+}
+
+func (m *Rule) GetNodataState() string {
+	if m != nil {
+		return m.NodataState
+	}
+	return ""
+}
+
+func (m *Rule) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *Rule) GetConsecutiveClearCount() uint32 {
+	if m != nil {
+		return m.ConsecutiveClearCount
+	}
+	return 0
+}
+
+func (m *Rule) GetLevels() string {
+	if m != nil {
+		return m.Levels
+	}
+	return ""
+}
+
 type CreateRuleRequest struct {
-	RuleName             string   `protobuf:"bytes,1,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool     `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32   `protobuf:"varint,3,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string   `protobuf:"bytes,5,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string   `protobuf:"bytes,6,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string   `protobuf:"bytes,7,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string   `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32   `protobuf:"varint,9,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool     `protobuf:"varint,10,opt,name=inhibit,proto3" json:"inhibit"`
-	PolicyId             string   `protobuf:"bytes,11,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	MetricId             string   `protobuf:"bytes,12,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RuleName              string   `protobuf:"bytes,1,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled              bool     `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods        uint32   `protobuf:"varint,3,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity              string   `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity"`
+	MetricsType           string   `protobuf:"bytes,5,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType         string   `protobuf:"bytes,6,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds            string   `protobuf:"bytes,7,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                  string   `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount      uint32   `protobuf:"varint,9,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit               bool     `protobuf:"varint,10,opt,name=inhibit,proto3" json:"inhibit"`
+	PolicyId              string   `protobuf:"bytes,11,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	MetricId              string   `protobuf:"bytes,12,opt,name=metric_id,json=metricId,proto3" json:"metric_id"`
+	PredictHorizon        uint32   `protobuf:"varint,13,opt,name=predict_horizon,json=predictHorizon,proto3" json:"predict_horizon"`
+	NodataState           string   `protobuf:"bytes,14,opt,name=nodata_state,json=nodataState,proto3" json:"nodata_state"`
+	RecoveryThresholds    string   `protobuf:"bytes,15,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveClearCount uint32   `protobuf:"varint,16,opt,name=consecutive_clear_count,json=consecutiveClearCount,proto3" json:"consecutive_clear_count"`
+	Levels                string   `protobuf:"bytes,17,opt,name=levels,proto3" json:"levels"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *CreateRuleRequest) Reset()         { *m = CreateRuleRequest{} }
@@ -2930,6 +3074,41 @@ func (m *CreateRuleRequest) GetMetricId() string {
 	return ""
 }
 
+func (m *CreateRuleRequest) GetPredictHorizon() uint32 {
+	if m != nil {
+		return m.PredictHorizon
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetNodataState() string {
+	if m != nil {
+		return m.NodataState
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *CreateRuleRequest) GetConsecutiveClearCount() uint32 {
+	if m != nil {
+		return m.ConsecutiveClearCount
+	}
+	return 0
+}
+
+func (m *CreateRuleRequest) GetLevels() string {
+	if m != nil {
+		return m.Levels
+	}
+	return ""
+}
+
 type CreateRuleResponse struct {
 	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3192,20 +3371,25 @@ func (m *DescribeRulesResponse) GetRuleSet() []*Rule {
 }
 
 type ModifyRuleRequest struct {
-	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
-	RuleName             string   `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
-	Disabled             bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
-	MonitorPeriods       uint32   `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
-	Severity             string   `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
-	MetricsType          string   `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
-	ConditionType        string   `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
-	Thresholds           string   `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
-	Unit                 string   `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
-	ConsecutiveCount     uint32   `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
-	Inhibit              bool     `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RuleId                string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	RuleName              string   `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
+	Disabled              bool     `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	MonitorPeriods        uint32   `protobuf:"varint,4,opt,name=monitor_periods,json=monitorPeriods,proto3" json:"monitor_periods"`
+	Severity              string   `protobuf:"bytes,5,opt,name=severity,proto3" json:"severity"`
+	MetricsType           string   `protobuf:"bytes,6,opt,name=metrics_type,json=metricsType,proto3" json:"metrics_type"`
+	ConditionType         string   `protobuf:"bytes,7,opt,name=condition_type,json=conditionType,proto3" json:"condition_type"`
+	Thresholds            string   `protobuf:"bytes,8,opt,name=thresholds,proto3" json:"thresholds"`
+	Unit                  string   `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit"`
+	ConsecutiveCount      uint32   `protobuf:"varint,10,opt,name=consecutive_count,json=consecutiveCount,proto3" json:"consecutive_count"`
+	Inhibit               bool     `protobuf:"varint,11,opt,name=inhibit,proto3" json:"inhibit"`
+	PredictHorizon        uint32   `protobuf:"varint,12,opt,name=predict_horizon,json=predictHorizon,proto3" json:"predict_horizon"`
+	NodataState           string   `protobuf:"bytes,13,opt,name=nodata_state,json=nodataState,proto3" json:"nodata_state"`
+	RecoveryThresholds    string   `protobuf:"bytes,14,opt,name=recovery_thresholds,json=recoveryThresholds,proto3" json:"recovery_thresholds"`
+	ConsecutiveClearCount uint32   `protobuf:"varint,15,opt,name=consecutive_clear_count,json=consecutiveClearCount,proto3" json:"consecutive_clear_count"`
+	Levels                string   `protobuf:"bytes,16,opt,name=levels,proto3" json:"levels"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *ModifyRuleRequest) Reset()         { *m = ModifyRuleRequest{} }
@@ -3310,6 +3494,41 @@ func (m *ModifyRuleRequest) GetInhibit() bool {
 	return false
 }
 
+func (m *ModifyRuleRequest) GetPredictHorizon() uint32 {
+	if m != nil {
+		return m.PredictHorizon
+	}
+	return 0
+}
+
+func (m *ModifyRuleRequest) GetNodataState() string {
+	if m != nil {
+		return m.NodataState
+	}
+	return ""
+}
+
+func (m *ModifyRuleRequest) GetRecoveryThresholds() string {
+	if m != nil {
+		return m.RecoveryThresholds
+	}
+	return ""
+}
+
+func (m *ModifyRuleRequest) GetConsecutiveClearCount() uint32 {
+	if m != nil {
+		return m.ConsecutiveClearCount
+	}
+	return 0
+}
+
+func (m *ModifyRuleRequest) GetLevels() string {
+	if m != nil {
+		return m.Levels
+	}
+	return ""
+}
+
 type ModifyRuleResponse struct {
 	RuleId               string   `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5153,6 +5372,7 @@ type Action struct {
 	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
 	PolicyId             string               `protobuf:"bytes,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
 	NfAddressListId      string               `protobuf:"bytes,8,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
+	EscalationConfig     string               `protobuf:"bytes,9,opt,name=escalation_config,json=escalationConfig,proto3" json:"escalation_config"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -5239,12 +5459,20 @@ func (m *Action) GetNfAddressListId() string {
 	return ""
 }
 
+func (m *Action) GetEscalationConfig() string {
+	if m != nil {
+		return m.EscalationConfig
+	}
+	return ""
+}
+
 type CreateActionRequest struct {
 	ActionName           string   `protobuf:"bytes,1,opt,name=action_name,json=actionName,proto3" json:"action_name"`
 	TriggerStatus        string   `protobuf:"bytes,2,opt,name=trigger_status,json=triggerStatus,proto3" json:"trigger_status"`
 	TriggerAction        string   `protobuf:"bytes,3,opt,name=trigger_action,json=triggerAction,proto3" json:"trigger_action"`
 	PolicyId             string   `protobuf:"bytes,4,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
 	NfAddressListId      string   `protobuf:"bytes,5,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
+	EscalationConfig     string   `protobuf:"bytes,6,opt,name=escalation_config,json=escalationConfig,proto3" json:"escalation_config"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5310,6 +5538,13 @@ func (m *CreateActionRequest) GetNfAddressListId() string {
 	return ""
 }
 
+func (m *CreateActionRequest) GetEscalationConfig() string {
+	if m != nil {
+		return m.EscalationConfig
+	}
+	return ""
+}
+
 type CreateActionResponse struct {
 	ActionId             string   `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5516,15 +5751,17 @@ func (m *DescribeActionsResponse) GetActionSet() []*Action {
 }
 
 type ModifyActionRequest struct {
-	ActionId             string   `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id"`
-	ActionName           string   `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name"`
-	TriggerStatus        string   `protobuf:"bytes,3,opt,name=trigger_status,json=triggerStatus,proto3" json:"trigger_status"`
-	TriggerAction        string   `protobuf:"bytes,4,opt,name=trigger_action,json=triggerAction,proto3" json:"trigger_action"`
-	PolicyId             string   `protobuf:"bytes,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	NfAddressListId      string   `protobuf:"bytes,6,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ActionId              string   `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id"`
+	ActionName            string   `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name"`
+	TriggerStatus         string   `protobuf:"bytes,3,opt,name=trigger_status,json=triggerStatus,proto3" json:"trigger_status"`
+	TriggerAction         string   `protobuf:"bytes,4,opt,name=trigger_action,json=triggerAction,proto3" json:"trigger_action"`
+	PolicyId              string   `protobuf:"bytes,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	NfAddressListId       string   `protobuf:"bytes,6,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
+	EscalationConfig      string   `protobuf:"bytes,7,opt,name=escalation_config,json=escalationConfig,proto3" json:"escalation_config"`
+	ClearEscalationConfig bool     `protobuf:"varint,8,opt,name=clear_escalation_config,json=clearEscalationConfig,proto3" json:"clear_escalation_config"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *ModifyActionRequest) Reset()         { *m = ModifyActionRequest{} }
@@ -5594,6 +5831,20 @@ func (m *ModifyActionRequest) GetNfAddressListId() string {
 	return ""
 }
 
+func (m *ModifyActionRequest) GetEscalationConfig() string {
+	if m != nil {
+		return m.EscalationConfig
+	}
+	return ""
+}
+
+func (m *ModifyActionRequest) GetClearEscalationConfig() bool {
+	if m != nil {
+		return m.ClearEscalationConfig
+	}
+	return false
+}
+
 type ModifyActionResponse struct {
 	ActionId             string   `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5711,78 +5962,2334 @@ func (m *DeleteActionsResponse) GetActionId() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Executor)(nil), "kubesphere.alert.Executor")
-	proto.RegisterType((*CreateExecutorRequest)(nil), "kubesphere.alert.CreateExecutorRequest")
-	proto.RegisterType((*CreateExecutorResponse)(nil), "kubesphere.alert.CreateExecutorResponse")
-	proto.RegisterType((*DescribeExecutorsRequest)(nil), "kubesphere.alert.DescribeExecutorsRequest")
-	proto.RegisterType((*DescribeExecutorsResponse)(nil), "kubesphere.alert.DescribeExecutorsResponse")
-	proto.RegisterType((*ModifyExecutorRequest)(nil), "kubesphere.alert.ModifyExecutorRequest")
-	proto.RegisterType((*ModifyExecutorResponse)(nil), "kubesphere.alert.ModifyExecutorResponse")
-	proto.RegisterType((*DeleteExecutorsRequest)(nil), "kubesphere.alert.DeleteExecutorsRequest")
-	proto.RegisterType((*DeleteExecutorsResponse)(nil), "kubesphere.alert.DeleteExecutorsResponse")
-	proto.RegisterType((*ResourceType)(nil), "kubesphere.alert.ResourceType")
-	proto.RegisterType((*CreateResourceTypeRequest)(nil), "kubesphere.alert.CreateResourceTypeRequest")
-	proto.RegisterType((*CreateResourceTypeResponse)(nil), "kubesphere.alert.CreateResourceTypeResponse")
-	proto.RegisterType((*DescribeResourceTypesRequest)(nil), "kubesphere.alert.DescribeResourceTypesRequest")
-	proto.RegisterType((*DescribeResourceTypesResponse)(nil), "kubesphere.alert.DescribeResourceTypesResponse")
-	proto.RegisterType((*ModifyResourceTypeRequest)(nil), "kubesphere.alert.ModifyResourceTypeRequest")
-	proto.RegisterType((*ModifyResourceTypeResponse)(nil), "kubesphere.alert.ModifyResourceTypeResponse")
-	proto.RegisterType((*DeleteResourceTypesRequest)(nil), "kubesphere.alert.DeleteResourceTypesRequest")
-	proto.RegisterType((*DeleteResourceTypesResponse)(nil), "kubesphere.alert.DeleteResourceTypesResponse")
-	proto.RegisterType((*ResourceFilter)(nil), "kubesphere.alert.ResourceFilter")
-	proto.RegisterType((*CreateResourceFilterRequest)(nil), "kubesphere.alert.CreateResourceFilterRequest")
-	proto.RegisterType((*CreateResourceFilterResponse)(nil), "kubesphere.alert.CreateResourceFilterResponse")
-	proto.RegisterType((*DescribeResourceFiltersRequest)(nil), "kubesphere.alert.DescribeResourceFiltersRequest")
-	proto.RegisterType((*DescribeResourceFiltersResponse)(nil), "kubesphere.alert.DescribeResourceFiltersResponse")
-	proto.RegisterType((*ModifyResourceFilterRequest)(nil), "kubesphere.alert.ModifyResourceFilterRequest")
-	proto.RegisterType((*ModifyResourceFilterResponse)(nil), "kubesphere.alert.ModifyResourceFilterResponse")
-	proto.RegisterType((*DeleteResourceFiltersRequest)(nil), "kubesphere.alert.DeleteResourceFiltersRequest")
-	proto.RegisterType((*DeleteResourceFiltersResponse)(nil), "kubesphere.alert.DeleteResourceFiltersResponse")
-	proto.RegisterType((*Metric)(nil), "kubesphere.alert.Metric")
-	proto.RegisterType((*CreateMetricRequest)(nil), "kubesphere.alert.CreateMetricRequest")
-	proto.RegisterType((*CreateMetricResponse)(nil), "kubesphere.alert.CreateMetricResponse")
-	proto.RegisterType((*DescribeMetricsRequest)(nil), "kubesphere.alert.DescribeMetricsRequest")
-	proto.RegisterType((*DescribeMetricsResponse)(nil), "kubesphere.alert.DescribeMetricsResponse")
-	proto.RegisterType((*ModifyMetricRequest)(nil), "kubesphere.alert.ModifyMetricRequest")
-	proto.RegisterType((*ModifyMetricResponse)(nil), "kubesphere.alert.ModifyMetricResponse")
-	proto.RegisterType((*DeleteMetricsRequest)(nil), "kubesphere.alert.DeleteMetricsRequest")
-	proto.RegisterType((*DeleteMetricsResponse)(nil), "kubesphere.alert.DeleteMetricsResponse")
-	proto.RegisterType((*Policy)(nil), "kubesphere.alert.Policy")
-	proto.RegisterType((*CreatePolicyRequest)(nil), "kubesphere.alert.CreatePolicyRequest")
-	proto.RegisterType((*CreatePolicyResponse)(nil), "kubesphere.alert.CreatePolicyResponse")
-	proto.RegisterType((*DescribePoliciesRequest)(nil), "kubesphere.alert.DescribePoliciesRequest")
-	proto.RegisterType((*DescribePoliciesResponse)(nil), "kubesphere.alert.DescribePoliciesResponse")
-	proto.RegisterType((*ModifyPolicyRequest)(nil), "kubesphere.alert.ModifyPolicyRequest")
-	proto.RegisterType((*ModifyPolicyResponse)(nil), "kubesphere.alert.ModifyPolicyResponse")
-	proto.RegisterType((*DeletePoliciesRequest)(nil), "kubesphere.alert.DeletePoliciesRequest")
-	proto.RegisterType((*DeletePoliciesResponse)(nil), "kubesphere.alert.DeletePoliciesResponse")
-	proto.RegisterType((*Rule)(nil), "kubesphere.alert.Rule")
-	proto.RegisterType((*CreateRuleRequest)(nil), "kubesphere.alert.CreateRuleRequest")
-	proto.RegisterType((*CreateRuleResponse)(nil), "kubesphere.alert.CreateRuleResponse")
-	proto.RegisterType((*DescribeRulesRequest)(nil), "kubesphere.alert.DescribeRulesRequest")
-	proto.RegisterType((*DescribeRulesResponse)(nil), "kubesphere.alert.DescribeRulesResponse")
-	proto.RegisterType((*ModifyRuleRequest)(nil), "kubesphere.alert.ModifyRuleRequest")
-	proto.RegisterType((*ModifyRuleResponse)(nil), "kubesphere.alert.ModifyRuleResponse")
-	proto.RegisterType((*DeleteRulesRequest)(nil), "kubesphere.alert.DeleteRulesRequest")
-	proto.RegisterType((*DeleteRulesResponse)(nil), "kubesphere.alert.DeleteRulesResponse")
-	proto.RegisterType((*Alert)(nil), "kubesphere.alert.Alert")
-	proto.RegisterType((*CreateAlertRequest)(nil), "kubesphere.alert.CreateAlertRequest")
-	proto.RegisterType((*CreateAlertResponse)(nil), "kubesphere.alert.CreateAlertResponse")
-	proto.RegisterType((*DescribeAlertsRequest)(nil), "kubesphere.alert.DescribeAlertsRequest")
-	proto.RegisterType((*DescribeAlertsResponse)(nil), "kubesphere.alert.DescribeAlertsResponse")
-	proto.RegisterType((*ModifyAlertRequest)(nil), "kubesphere.alert.ModifyAlertRequest")
-	proto.RegisterType((*ModifyAlertResponse)(nil), "kubesphere.alert.ModifyAlertResponse")
-	proto.RegisterType((*DeleteAlertsRequest)(nil), "kubesphere.alert.DeleteAlertsRequest")
-	proto.RegisterType((*DeleteAlertsResponse)(nil), "kubesphere.alert.DeleteAlertsResponse")
-	proto.RegisterType((*History)(nil), "kubesphere.alert.History")
-	proto.RegisterType((*CreateHistoryRequest)(nil), "kubesphere.alert.CreateHistoryRequest")
-	proto.RegisterType((*CreateHistoryResponse)(nil), "kubesphere.alert.CreateHistoryResponse")
-	proto.RegisterType((*DescribeHistoriesRequest)(nil), "kubesphere.alert.DescribeHistoriesRequest")
-	proto.RegisterType((*DescribeHistoriesResponse)(nil), "kubesphere.alert.DescribeHistoriesResponse")
-	proto.RegisterType((*ModifyHistoryRequest)(nil), "kubesphere.alert.ModifyHistoryRequest")
-	proto.RegisterType((*ModifyHistoryResponse)(nil), "kubesphere.alert.ModifyHistoryResponse")
-	proto.RegisterType((*DeleteHistoriesRequest)(nil), "kubesphere.alert.DeleteHistoriesRequest")
+//10.InhibitRule
+//********************************************************************************************************
+type InhibitRule struct {
+	InhibitRuleId        string               `protobuf:"bytes,1,opt,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	InhibitRuleName      string               `protobuf:"bytes,2,opt,name=inhibit_rule_name,json=inhibitRuleName,proto3" json:"inhibit_rule_name"`
+	SourceRsTypeName     string               `protobuf:"bytes,3,opt,name=source_rs_type_name,json=sourceRsTypeName,proto3" json:"source_rs_type_name"`
+	SourceSeverity       string               `protobuf:"bytes,4,opt,name=source_severity,json=sourceSeverity,proto3" json:"source_severity"`
+	TargetRsTypeName     string               `protobuf:"bytes,5,opt,name=target_rs_type_name,json=targetRsTypeName,proto3" json:"target_rs_type_name"`
+	EqualLabel           string               `protobuf:"bytes,6,opt,name=equal_label,json=equalLabel,proto3" json:"equal_label"`
+	Disabled             bool                 `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,9,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *InhibitRule) Reset()         { *m = InhibitRule{} }
+func (m *InhibitRule) String() string { return proto.CompactTextString(m) }
+func (*InhibitRule) ProtoMessage()    {}
+func (*InhibitRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{90}
+}
+
+func (m *InhibitRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InhibitRule.Unmarshal(m, b)
+}
+func (m *InhibitRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InhibitRule.Marshal(b, m, deterministic)
+}
+func (m *InhibitRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InhibitRule.Merge(m, src)
+}
+func (m *InhibitRule) XXX_Size() int {
+	return xxx_messageInfo_InhibitRule.Size(m)
+}
+func (m *InhibitRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_InhibitRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InhibitRule proto.InternalMessageInfo
+
+func (m *InhibitRule) GetInhibitRuleId() string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetInhibitRuleName() string {
+	if m != nil {
+		return m.InhibitRuleName
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetSourceRsTypeName() string {
+	if m != nil {
+		return m.SourceRsTypeName
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetSourceSeverity() string {
+	if m != nil {
+		return m.SourceSeverity
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetTargetRsTypeName() string {
+	if m != nil {
+		return m.TargetRsTypeName
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetEqualLabel() string {
+	if m != nil {
+		return m.EqualLabel
+	}
+	return ""
+}
+
+func (m *InhibitRule) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *InhibitRule) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *InhibitRule) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type CreateInhibitRuleRequest struct {
+	InhibitRuleName      string   `protobuf:"bytes,1,opt,name=inhibit_rule_name,json=inhibitRuleName,proto3" json:"inhibit_rule_name"`
+	SourceRsTypeName     string   `protobuf:"bytes,2,opt,name=source_rs_type_name,json=sourceRsTypeName,proto3" json:"source_rs_type_name"`
+	SourceSeverity       string   `protobuf:"bytes,3,opt,name=source_severity,json=sourceSeverity,proto3" json:"source_severity"`
+	TargetRsTypeName     string   `protobuf:"bytes,4,opt,name=target_rs_type_name,json=targetRsTypeName,proto3" json:"target_rs_type_name"`
+	EqualLabel           string   `protobuf:"bytes,5,opt,name=equal_label,json=equalLabel,proto3" json:"equal_label"`
+	Disabled             bool     `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInhibitRuleRequest) Reset()         { *m = CreateInhibitRuleRequest{} }
+func (m *CreateInhibitRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInhibitRuleRequest) ProtoMessage()    {}
+func (*CreateInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{91}
+}
+
+func (m *CreateInhibitRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInhibitRuleRequest.Unmarshal(m, b)
+}
+func (m *CreateInhibitRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInhibitRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateInhibitRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInhibitRuleRequest.Merge(m, src)
+}
+func (m *CreateInhibitRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInhibitRuleRequest.Size(m)
+}
+func (m *CreateInhibitRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInhibitRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInhibitRuleRequest proto.InternalMessageInfo
+
+func (m *CreateInhibitRuleRequest) GetInhibitRuleName() string {
+	if m != nil {
+		return m.InhibitRuleName
+	}
+	return ""
+}
+
+func (m *CreateInhibitRuleRequest) GetSourceRsTypeName() string {
+	if m != nil {
+		return m.SourceRsTypeName
+	}
+	return ""
+}
+
+func (m *CreateInhibitRuleRequest) GetSourceSeverity() string {
+	if m != nil {
+		return m.SourceSeverity
+	}
+	return ""
+}
+
+func (m *CreateInhibitRuleRequest) GetTargetRsTypeName() string {
+	if m != nil {
+		return m.TargetRsTypeName
+	}
+	return ""
+}
+
+func (m *CreateInhibitRuleRequest) GetEqualLabel() string {
+	if m != nil {
+		return m.EqualLabel
+	}
+	return ""
+}
+
+func (m *CreateInhibitRuleRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type CreateInhibitRuleResponse struct {
+	InhibitRuleId        string   `protobuf:"bytes,1,opt,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInhibitRuleResponse) Reset()         { *m = CreateInhibitRuleResponse{} }
+func (m *CreateInhibitRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateInhibitRuleResponse) ProtoMessage()    {}
+func (*CreateInhibitRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{92}
+}
+
+func (m *CreateInhibitRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInhibitRuleResponse.Unmarshal(m, b)
+}
+func (m *CreateInhibitRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInhibitRuleResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateInhibitRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInhibitRuleResponse.Merge(m, src)
+}
+func (m *CreateInhibitRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateInhibitRuleResponse.Size(m)
+}
+func (m *CreateInhibitRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInhibitRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInhibitRuleResponse proto.InternalMessageInfo
+
+func (m *CreateInhibitRuleResponse) GetInhibitRuleId() string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return ""
+}
+
+type DescribeInhibitRulesRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	InhibitRuleId        []string `protobuf:"bytes,6,rep,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	InhibitRuleName      []string `protobuf:"bytes,7,rep,name=inhibit_rule_name,json=inhibitRuleName,proto3" json:"inhibit_rule_name"`
+	SourceRsTypeName     []string `protobuf:"bytes,8,rep,name=source_rs_type_name,json=sourceRsTypeName,proto3" json:"source_rs_type_name"`
+	TargetRsTypeName     []string `protobuf:"bytes,9,rep,name=target_rs_type_name,json=targetRsTypeName,proto3" json:"target_rs_type_name"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeInhibitRulesRequest) Reset()         { *m = DescribeInhibitRulesRequest{} }
+func (m *DescribeInhibitRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeInhibitRulesRequest) ProtoMessage()    {}
+func (*DescribeInhibitRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{93}
+}
+
+func (m *DescribeInhibitRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeInhibitRulesRequest.Unmarshal(m, b)
+}
+func (m *DescribeInhibitRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeInhibitRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeInhibitRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeInhibitRulesRequest.Merge(m, src)
+}
+func (m *DescribeInhibitRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeInhibitRulesRequest.Size(m)
+}
+func (m *DescribeInhibitRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeInhibitRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeInhibitRulesRequest proto.InternalMessageInfo
+
+func (m *DescribeInhibitRulesRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeInhibitRulesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeInhibitRulesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeInhibitRulesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeInhibitRulesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeInhibitRulesRequest) GetInhibitRuleId() []string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return nil
+}
+
+func (m *DescribeInhibitRulesRequest) GetInhibitRuleName() []string {
+	if m != nil {
+		return m.InhibitRuleName
+	}
+	return nil
+}
+
+func (m *DescribeInhibitRulesRequest) GetSourceRsTypeName() []string {
+	if m != nil {
+		return m.SourceRsTypeName
+	}
+	return nil
+}
+
+func (m *DescribeInhibitRulesRequest) GetTargetRsTypeName() []string {
+	if m != nil {
+		return m.TargetRsTypeName
+	}
+	return nil
+}
+
+type DescribeInhibitRulesResponse struct {
+	Total                uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	InhibitRuleSet       []*InhibitRule `protobuf:"bytes,2,rep,name=inhibit_rule_set,json=inhibitRuleSet,proto3" json:"inhibit_rule_set"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DescribeInhibitRulesResponse) Reset()         { *m = DescribeInhibitRulesResponse{} }
+func (m *DescribeInhibitRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeInhibitRulesResponse) ProtoMessage()    {}
+func (*DescribeInhibitRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{94}
+}
+
+func (m *DescribeInhibitRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeInhibitRulesResponse.Unmarshal(m, b)
+}
+func (m *DescribeInhibitRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeInhibitRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeInhibitRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeInhibitRulesResponse.Merge(m, src)
+}
+func (m *DescribeInhibitRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeInhibitRulesResponse.Size(m)
+}
+func (m *DescribeInhibitRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeInhibitRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeInhibitRulesResponse proto.InternalMessageInfo
+
+func (m *DescribeInhibitRulesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeInhibitRulesResponse) GetInhibitRuleSet() []*InhibitRule {
+	if m != nil {
+		return m.InhibitRuleSet
+	}
+	return nil
+}
+
+type ModifyInhibitRuleRequest struct {
+	InhibitRuleId        string   `protobuf:"bytes,1,opt,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	InhibitRuleName      string   `protobuf:"bytes,2,opt,name=inhibit_rule_name,json=inhibitRuleName,proto3" json:"inhibit_rule_name"`
+	SourceRsTypeName     string   `protobuf:"bytes,3,opt,name=source_rs_type_name,json=sourceRsTypeName,proto3" json:"source_rs_type_name"`
+	SourceSeverity       string   `protobuf:"bytes,4,opt,name=source_severity,json=sourceSeverity,proto3" json:"source_severity"`
+	TargetRsTypeName     string   `protobuf:"bytes,5,opt,name=target_rs_type_name,json=targetRsTypeName,proto3" json:"target_rs_type_name"`
+	EqualLabel           string   `protobuf:"bytes,6,opt,name=equal_label,json=equalLabel,proto3" json:"equal_label"`
+	Disabled             bool     `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyInhibitRuleRequest) Reset()         { *m = ModifyInhibitRuleRequest{} }
+func (m *ModifyInhibitRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyInhibitRuleRequest) ProtoMessage()    {}
+func (*ModifyInhibitRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{95}
+}
+
+func (m *ModifyInhibitRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyInhibitRuleRequest.Unmarshal(m, b)
+}
+func (m *ModifyInhibitRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyInhibitRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyInhibitRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyInhibitRuleRequest.Merge(m, src)
+}
+func (m *ModifyInhibitRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyInhibitRuleRequest.Size(m)
+}
+func (m *ModifyInhibitRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyInhibitRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyInhibitRuleRequest proto.InternalMessageInfo
+
+func (m *ModifyInhibitRuleRequest) GetInhibitRuleId() string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetInhibitRuleName() string {
+	if m != nil {
+		return m.InhibitRuleName
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetSourceRsTypeName() string {
+	if m != nil {
+		return m.SourceRsTypeName
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetSourceSeverity() string {
+	if m != nil {
+		return m.SourceSeverity
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetTargetRsTypeName() string {
+	if m != nil {
+		return m.TargetRsTypeName
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetEqualLabel() string {
+	if m != nil {
+		return m.EqualLabel
+	}
+	return ""
+}
+
+func (m *ModifyInhibitRuleRequest) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type ModifyInhibitRuleResponse struct {
+	InhibitRuleId        string   `protobuf:"bytes,1,opt,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyInhibitRuleResponse) Reset()         { *m = ModifyInhibitRuleResponse{} }
+func (m *ModifyInhibitRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyInhibitRuleResponse) ProtoMessage()    {}
+func (*ModifyInhibitRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{96}
+}
+
+func (m *ModifyInhibitRuleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyInhibitRuleResponse.Unmarshal(m, b)
+}
+func (m *ModifyInhibitRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyInhibitRuleResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyInhibitRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyInhibitRuleResponse.Merge(m, src)
+}
+func (m *ModifyInhibitRuleResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyInhibitRuleResponse.Size(m)
+}
+func (m *ModifyInhibitRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyInhibitRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyInhibitRuleResponse proto.InternalMessageInfo
+
+func (m *ModifyInhibitRuleResponse) GetInhibitRuleId() string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return ""
+}
+
+type DeleteInhibitRulesRequest struct {
+	InhibitRuleId        []string `protobuf:"bytes,1,rep,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInhibitRulesRequest) Reset()         { *m = DeleteInhibitRulesRequest{} }
+func (m *DeleteInhibitRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteInhibitRulesRequest) ProtoMessage()    {}
+func (*DeleteInhibitRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{97}
+}
+
+func (m *DeleteInhibitRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInhibitRulesRequest.Unmarshal(m, b)
+}
+func (m *DeleteInhibitRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInhibitRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteInhibitRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInhibitRulesRequest.Merge(m, src)
+}
+func (m *DeleteInhibitRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteInhibitRulesRequest.Size(m)
+}
+func (m *DeleteInhibitRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInhibitRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInhibitRulesRequest proto.InternalMessageInfo
+
+func (m *DeleteInhibitRulesRequest) GetInhibitRuleId() []string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return nil
+}
+
+type DeleteInhibitRulesResponse struct {
+	InhibitRuleId        []string `protobuf:"bytes,1,rep,name=inhibit_rule_id,json=inhibitRuleId,proto3" json:"inhibit_rule_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInhibitRulesResponse) Reset()         { *m = DeleteInhibitRulesResponse{} }
+func (m *DeleteInhibitRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteInhibitRulesResponse) ProtoMessage()    {}
+func (*DeleteInhibitRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{98}
+}
+
+func (m *DeleteInhibitRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInhibitRulesResponse.Unmarshal(m, b)
+}
+func (m *DeleteInhibitRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInhibitRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteInhibitRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInhibitRulesResponse.Merge(m, src)
+}
+func (m *DeleteInhibitRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteInhibitRulesResponse.Size(m)
+}
+func (m *DeleteInhibitRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInhibitRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInhibitRulesResponse proto.InternalMessageInfo
+
+func (m *DeleteInhibitRulesResponse) GetInhibitRuleId() []string {
+	if m != nil {
+		return m.InhibitRuleId
+	}
+	return nil
+}
+
+//11.Silence
+//********************************************************************************************************
+type Silence struct {
+	SilenceId            string               `protobuf:"bytes,1,opt,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	Creator              string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator"`
+	Comment              string               `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Matchers             string               `protobuf:"bytes,4,opt,name=matchers,proto3" json:"matchers"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Silence) Reset()         { *m = Silence{} }
+func (m *Silence) String() string { return proto.CompactTextString(m) }
+func (*Silence) ProtoMessage()    {}
+func (*Silence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{99}
+}
+
+func (m *Silence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Silence.Unmarshal(m, b)
+}
+func (m *Silence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Silence.Marshal(b, m, deterministic)
+}
+func (m *Silence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Silence.Merge(m, src)
+}
+func (m *Silence) XXX_Size() int {
+	return xxx_messageInfo_Silence.Size(m)
+}
+func (m *Silence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Silence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Silence proto.InternalMessageInfo
+
+func (m *Silence) GetSilenceId() string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return ""
+}
+
+func (m *Silence) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Silence) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *Silence) GetMatchers() string {
+	if m != nil {
+		return m.Matchers
+	}
+	return ""
+}
+
+func (m *Silence) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Silence) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *Silence) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Silence) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type CreateSilenceRequest struct {
+	Creator              string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator"`
+	Comment              string               `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	Matchers             string               `protobuf:"bytes,3,opt,name=matchers,proto3" json:"matchers"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CreateSilenceRequest) Reset()         { *m = CreateSilenceRequest{} }
+func (m *CreateSilenceRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSilenceRequest) ProtoMessage()    {}
+func (*CreateSilenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{100}
+}
+
+func (m *CreateSilenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSilenceRequest.Unmarshal(m, b)
+}
+func (m *CreateSilenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSilenceRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateSilenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSilenceRequest.Merge(m, src)
+}
+func (m *CreateSilenceRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateSilenceRequest.Size(m)
+}
+func (m *CreateSilenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSilenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSilenceRequest proto.InternalMessageInfo
+
+func (m *CreateSilenceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *CreateSilenceRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *CreateSilenceRequest) GetMatchers() string {
+	if m != nil {
+		return m.Matchers
+	}
+	return ""
+}
+
+func (m *CreateSilenceRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *CreateSilenceRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type CreateSilenceResponse struct {
+	SilenceId            string   `protobuf:"bytes,1,opt,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateSilenceResponse) Reset()         { *m = CreateSilenceResponse{} }
+func (m *CreateSilenceResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSilenceResponse) ProtoMessage()    {}
+func (*CreateSilenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{101}
+}
+
+func (m *CreateSilenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateSilenceResponse.Unmarshal(m, b)
+}
+func (m *CreateSilenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateSilenceResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateSilenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSilenceResponse.Merge(m, src)
+}
+func (m *CreateSilenceResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateSilenceResponse.Size(m)
+}
+func (m *CreateSilenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSilenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSilenceResponse proto.InternalMessageInfo
+
+func (m *CreateSilenceResponse) GetSilenceId() string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return ""
+}
+
+type DescribeSilencesRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	SilenceId            []string `protobuf:"bytes,6,rep,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	Creator              []string `protobuf:"bytes,7,rep,name=creator,proto3" json:"creator"`
+	Active               bool     `protobuf:"varint,8,opt,name=active,proto3" json:"active"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeSilencesRequest) Reset()         { *m = DescribeSilencesRequest{} }
+func (m *DescribeSilencesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSilencesRequest) ProtoMessage()    {}
+func (*DescribeSilencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{102}
+}
+
+func (m *DescribeSilencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSilencesRequest.Unmarshal(m, b)
+}
+func (m *DescribeSilencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSilencesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeSilencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSilencesRequest.Merge(m, src)
+}
+func (m *DescribeSilencesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSilencesRequest.Size(m)
+}
+func (m *DescribeSilencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSilencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSilencesRequest proto.InternalMessageInfo
+
+func (m *DescribeSilencesRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeSilencesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeSilencesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeSilencesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeSilencesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeSilencesRequest) GetSilenceId() []string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return nil
+}
+
+func (m *DescribeSilencesRequest) GetCreator() []string {
+	if m != nil {
+		return m.Creator
+	}
+	return nil
+}
+
+func (m *DescribeSilencesRequest) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+type DescribeSilencesResponse struct {
+	Total                uint32     `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	SilenceSet           []*Silence `protobuf:"bytes,2,rep,name=silence_set,json=silenceSet,proto3" json:"silence_set"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *DescribeSilencesResponse) Reset()         { *m = DescribeSilencesResponse{} }
+func (m *DescribeSilencesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSilencesResponse) ProtoMessage()    {}
+func (*DescribeSilencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{103}
+}
+
+func (m *DescribeSilencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSilencesResponse.Unmarshal(m, b)
+}
+func (m *DescribeSilencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSilencesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeSilencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSilencesResponse.Merge(m, src)
+}
+func (m *DescribeSilencesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSilencesResponse.Size(m)
+}
+func (m *DescribeSilencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSilencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeSilencesResponse proto.InternalMessageInfo
+
+func (m *DescribeSilencesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeSilencesResponse) GetSilenceSet() []*Silence {
+	if m != nil {
+		return m.SilenceSet
+	}
+	return nil
+}
+
+type ModifySilenceRequest struct {
+	SilenceId            string               `protobuf:"bytes,1,opt,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	Creator              string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator"`
+	Comment              string               `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Matchers             string               `protobuf:"bytes,4,opt,name=matchers,proto3" json:"matchers"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ModifySilenceRequest) Reset()         { *m = ModifySilenceRequest{} }
+func (m *ModifySilenceRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifySilenceRequest) ProtoMessage()    {}
+func (*ModifySilenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{104}
+}
+
+func (m *ModifySilenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySilenceRequest.Unmarshal(m, b)
+}
+func (m *ModifySilenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySilenceRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifySilenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySilenceRequest.Merge(m, src)
+}
+func (m *ModifySilenceRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifySilenceRequest.Size(m)
+}
+func (m *ModifySilenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySilenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySilenceRequest proto.InternalMessageInfo
+
+func (m *ModifySilenceRequest) GetSilenceId() string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return ""
+}
+
+func (m *ModifySilenceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ModifySilenceRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ModifySilenceRequest) GetMatchers() string {
+	if m != nil {
+		return m.Matchers
+	}
+	return ""
+}
+
+func (m *ModifySilenceRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *ModifySilenceRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+type ModifySilenceResponse struct {
+	SilenceId            string   `protobuf:"bytes,1,opt,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifySilenceResponse) Reset()         { *m = ModifySilenceResponse{} }
+func (m *ModifySilenceResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifySilenceResponse) ProtoMessage()    {}
+func (*ModifySilenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{105}
+}
+
+func (m *ModifySilenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySilenceResponse.Unmarshal(m, b)
+}
+func (m *ModifySilenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySilenceResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifySilenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySilenceResponse.Merge(m, src)
+}
+func (m *ModifySilenceResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifySilenceResponse.Size(m)
+}
+func (m *ModifySilenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySilenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySilenceResponse proto.InternalMessageInfo
+
+func (m *ModifySilenceResponse) GetSilenceId() string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return ""
+}
+
+type DeleteSilencesRequest struct {
+	SilenceId            []string `protobuf:"bytes,1,rep,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSilencesRequest) Reset()         { *m = DeleteSilencesRequest{} }
+func (m *DeleteSilencesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSilencesRequest) ProtoMessage()    {}
+func (*DeleteSilencesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{106}
+}
+
+func (m *DeleteSilencesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSilencesRequest.Unmarshal(m, b)
+}
+func (m *DeleteSilencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSilencesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteSilencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSilencesRequest.Merge(m, src)
+}
+func (m *DeleteSilencesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSilencesRequest.Size(m)
+}
+func (m *DeleteSilencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSilencesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSilencesRequest proto.InternalMessageInfo
+
+func (m *DeleteSilencesRequest) GetSilenceId() []string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return nil
+}
+
+type DeleteSilencesResponse struct {
+	SilenceId            []string `protobuf:"bytes,1,rep,name=silence_id,json=silenceId,proto3" json:"silence_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSilencesResponse) Reset()         { *m = DeleteSilencesResponse{} }
+func (m *DeleteSilencesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteSilencesResponse) ProtoMessage()    {}
+func (*DeleteSilencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{107}
+}
+
+func (m *DeleteSilencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSilencesResponse.Unmarshal(m, b)
+}
+func (m *DeleteSilencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSilencesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteSilencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSilencesResponse.Merge(m, src)
+}
+func (m *DeleteSilencesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteSilencesResponse.Size(m)
+}
+func (m *DeleteSilencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSilencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSilencesResponse proto.InternalMessageInfo
+
+func (m *DeleteSilencesResponse) GetSilenceId() []string {
+	if m != nil {
+		return m.SilenceId
+	}
+	return nil
+}
+
+//12.Template
+//********************************************************************************************************
+type Template struct {
+	TemplateId           string               `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	PolicyId             string               `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	Language             string               `protobuf:"bytes,3,opt,name=language,proto3" json:"language"`
+	Format               string               `protobuf:"bytes,4,opt,name=format,proto3" json:"format"`
+	Title                string               `protobuf:"bytes,5,opt,name=title,proto3" json:"title"`
+	Content              string               `protobuf:"bytes,6,opt,name=content,proto3" json:"content"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Template) Reset()         { *m = Template{} }
+func (m *Template) String() string { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()    {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{108}
+}
+
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Template.Unmarshal(m, b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+}
+func (m *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(m, src)
+}
+func (m *Template) XXX_Size() int {
+	return xxx_messageInfo_Template.Size(m)
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Template proto.InternalMessageInfo
+
+func (m *Template) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *Template) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *Template) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *Template) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *Template) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Template) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Template) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Template) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type CreateTemplateRequest struct {
+	PolicyId             string   `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	Language             string   `protobuf:"bytes,2,opt,name=language,proto3" json:"language"`
+	Format               string   `protobuf:"bytes,3,opt,name=format,proto3" json:"format"`
+	Title                string   `protobuf:"bytes,4,opt,name=title,proto3" json:"title"`
+	Content              string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTemplateRequest) Reset()         { *m = CreateTemplateRequest{} }
+func (m *CreateTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTemplateRequest) ProtoMessage()    {}
+func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{109}
+}
+
+func (m *CreateTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTemplateRequest.Merge(m, src)
+}
+func (m *CreateTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTemplateRequest.Size(m)
+}
+func (m *CreateTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateTemplateRequest) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateTemplateRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type CreateTemplateResponse struct {
+	TemplateId           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTemplateResponse) Reset()         { *m = CreateTemplateResponse{} }
+func (m *CreateTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTemplateResponse) ProtoMessage()    {}
+func (*CreateTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{110}
+}
+
+func (m *CreateTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTemplateResponse.Unmarshal(m, b)
+}
+func (m *CreateTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTemplateResponse.Merge(m, src)
+}
+func (m *CreateTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTemplateResponse.Size(m)
+}
+func (m *CreateTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTemplateResponse proto.InternalMessageInfo
+
+func (m *CreateTemplateResponse) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+type DescribeTemplatesRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	TemplateId           []string `protobuf:"bytes,6,rep,name=template_id,json=templateId,proto3" json:"template_id"`
+	PolicyId             []string `protobuf:"bytes,7,rep,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	Language             []string `protobuf:"bytes,8,rep,name=language,proto3" json:"language"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeTemplatesRequest) Reset()         { *m = DescribeTemplatesRequest{} }
+func (m *DescribeTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTemplatesRequest) ProtoMessage()    {}
+func (*DescribeTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{111}
+}
+
+func (m *DescribeTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeTemplatesRequest.Unmarshal(m, b)
+}
+func (m *DescribeTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeTemplatesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTemplatesRequest.Merge(m, src)
+}
+func (m *DescribeTemplatesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeTemplatesRequest.Size(m)
+}
+func (m *DescribeTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTemplatesRequest proto.InternalMessageInfo
+
+func (m *DescribeTemplatesRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeTemplatesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeTemplatesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeTemplatesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeTemplatesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeTemplatesRequest) GetTemplateId() []string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return nil
+}
+
+func (m *DescribeTemplatesRequest) GetPolicyId() []string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return nil
+}
+
+func (m *DescribeTemplatesRequest) GetLanguage() []string {
+	if m != nil {
+		return m.Language
+	}
+	return nil
+}
+
+type DescribeTemplatesResponse struct {
+	Total                uint32      `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	TemplateSet          []*Template `protobuf:"bytes,2,rep,name=template_set,json=templateSet,proto3" json:"template_set"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DescribeTemplatesResponse) Reset()         { *m = DescribeTemplatesResponse{} }
+func (m *DescribeTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTemplatesResponse) ProtoMessage()    {}
+func (*DescribeTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{112}
+}
+
+func (m *DescribeTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeTemplatesResponse.Unmarshal(m, b)
+}
+func (m *DescribeTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeTemplatesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTemplatesResponse.Merge(m, src)
+}
+func (m *DescribeTemplatesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeTemplatesResponse.Size(m)
+}
+func (m *DescribeTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTemplatesResponse proto.InternalMessageInfo
+
+func (m *DescribeTemplatesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeTemplatesResponse) GetTemplateSet() []*Template {
+	if m != nil {
+		return m.TemplateSet
+	}
+	return nil
+}
+
+type ModifyTemplateRequest struct {
+	TemplateId           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format"`
+	Title                string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	Content              string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyTemplateRequest) Reset()         { *m = ModifyTemplateRequest{} }
+func (m *ModifyTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyTemplateRequest) ProtoMessage()    {}
+func (*ModifyTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{113}
+}
+
+func (m *ModifyTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyTemplateRequest.Unmarshal(m, b)
+}
+func (m *ModifyTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyTemplateRequest.Merge(m, src)
+}
+func (m *ModifyTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyTemplateRequest.Size(m)
+}
+func (m *ModifyTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyTemplateRequest proto.InternalMessageInfo
+
+func (m *ModifyTemplateRequest) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *ModifyTemplateRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *ModifyTemplateRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ModifyTemplateRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+type ModifyTemplateResponse struct {
+	TemplateId           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyTemplateResponse) Reset()         { *m = ModifyTemplateResponse{} }
+func (m *ModifyTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyTemplateResponse) ProtoMessage()    {}
+func (*ModifyTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{114}
+}
+
+func (m *ModifyTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyTemplateResponse.Unmarshal(m, b)
+}
+func (m *ModifyTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyTemplateResponse.Merge(m, src)
+}
+func (m *ModifyTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyTemplateResponse.Size(m)
+}
+func (m *ModifyTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyTemplateResponse proto.InternalMessageInfo
+
+func (m *ModifyTemplateResponse) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+type DeleteTemplatesRequest struct {
+	TemplateId           []string `protobuf:"bytes,1,rep,name=template_id,json=templateId,proto3" json:"template_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplatesRequest) Reset()         { *m = DeleteTemplatesRequest{} }
+func (m *DeleteTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplatesRequest) ProtoMessage()    {}
+func (*DeleteTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{115}
+}
+
+func (m *DeleteTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplatesRequest.Unmarshal(m, b)
+}
+func (m *DeleteTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplatesRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplatesRequest.Merge(m, src)
+}
+func (m *DeleteTemplatesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplatesRequest.Size(m)
+}
+func (m *DeleteTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplatesRequest proto.InternalMessageInfo
+
+func (m *DeleteTemplatesRequest) GetTemplateId() []string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return nil
+}
+
+type DeleteTemplatesResponse struct {
+	TemplateId           []string `protobuf:"bytes,1,rep,name=template_id,json=templateId,proto3" json:"template_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplatesResponse) Reset()         { *m = DeleteTemplatesResponse{} }
+func (m *DeleteTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplatesResponse) ProtoMessage()    {}
+func (*DeleteTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{116}
+}
+
+func (m *DeleteTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplatesResponse.Unmarshal(m, b)
+}
+func (m *DeleteTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplatesResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplatesResponse.Merge(m, src)
+}
+func (m *DeleteTemplatesResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplatesResponse.Size(m)
+}
+func (m *DeleteTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplatesResponse proto.InternalMessageInfo
+
+func (m *DeleteTemplatesResponse) GetTemplateId() []string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return nil
+}
+
+// Preview renders title and content if given, or else template of template_id, or else template of policy in language.
+// notification_param is json of notification param, empty to render a sample.
+type PreviewTemplateRequest struct {
+	TemplateId           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id"`
+	PolicyId             string   `protobuf:"bytes,2,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	Language             string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language"`
+	Format               string   `protobuf:"bytes,4,opt,name=format,proto3" json:"format"`
+	Title                string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title"`
+	Content              string   `protobuf:"bytes,6,opt,name=content,proto3" json:"content"`
+	NotificationParam    string   `protobuf:"bytes,7,opt,name=notification_param,json=notificationParam,proto3" json:"notification_param"`
+	Resume               bool     `protobuf:"varint,8,opt,name=resume,proto3" json:"resume"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewTemplateRequest) Reset()         { *m = PreviewTemplateRequest{} }
+func (m *PreviewTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*PreviewTemplateRequest) ProtoMessage()    {}
+func (*PreviewTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{117}
+}
+
+func (m *PreviewTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTemplateRequest.Unmarshal(m, b)
+}
+func (m *PreviewTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *PreviewTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTemplateRequest.Merge(m, src)
+}
+func (m *PreviewTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_PreviewTemplateRequest.Size(m)
+}
+func (m *PreviewTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewTemplateRequest proto.InternalMessageInfo
+
+func (m *PreviewTemplateRequest) GetTemplateId() string {
+	if m != nil {
+		return m.TemplateId
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetNotificationParam() string {
+	if m != nil {
+		return m.NotificationParam
+	}
+	return ""
+}
+
+func (m *PreviewTemplateRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+type PreviewTemplateResponse struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	Content              string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PreviewTemplateResponse) Reset()         { *m = PreviewTemplateResponse{} }
+func (m *PreviewTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*PreviewTemplateResponse) ProtoMessage()    {}
+func (*PreviewTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{118}
+}
+
+func (m *PreviewTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PreviewTemplateResponse.Unmarshal(m, b)
+}
+func (m *PreviewTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PreviewTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *PreviewTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreviewTemplateResponse.Merge(m, src)
+}
+func (m *PreviewTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_PreviewTemplateResponse.Size(m)
+}
+func (m *PreviewTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreviewTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreviewTemplateResponse proto.InternalMessageInfo
+
+func (m *PreviewTemplateResponse) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PreviewTemplateResponse) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+//13.Outbox
+//********************************************************************************************************
+type Outbox struct {
+	OutboxId             string               `protobuf:"bytes,1,opt,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	IdempotencyKey       string               `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key"`
+	AlertId              string               `protobuf:"bytes,3,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	RuleId               string               `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName         string               `protobuf:"bytes,5,opt,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	Notifier             string               `protobuf:"bytes,6,opt,name=notifier,proto3" json:"notifier"`
+	Addresses            string               `protobuf:"bytes,7,opt,name=addresses,proto3" json:"addresses"`
+	Title                string               `protobuf:"bytes,8,opt,name=title,proto3" json:"title"`
+	Content              string               `protobuf:"bytes,9,opt,name=content,proto3" json:"content"`
+	Status               string               `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
+	Attempts             uint32               `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts"`
+	LastError            string               `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error"`
+	NotificationId       string               `protobuf:"bytes,13,opt,name=notification_id,json=notificationId,proto3" json:"notification_id"`
+	NextAttemptTime      *timestamp.Timestamp `protobuf:"bytes,14,opt,name=next_attempt_time,json=nextAttemptTime,proto3" json:"next_attempt_time"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,15,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	UpdateTime           *timestamp.Timestamp `protobuf:"bytes,16,opt,name=update_time,json=updateTime,proto3" json:"update_time"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Outbox) Reset()         { *m = Outbox{} }
+func (m *Outbox) String() string { return proto.CompactTextString(m) }
+func (*Outbox) ProtoMessage()    {}
+func (*Outbox) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{119}
+}
+
+func (m *Outbox) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Outbox.Unmarshal(m, b)
+}
+func (m *Outbox) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Outbox.Marshal(b, m, deterministic)
+}
+func (m *Outbox) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Outbox.Merge(m, src)
+}
+func (m *Outbox) XXX_Size() int {
+	return xxx_messageInfo_Outbox.Size(m)
+}
+func (m *Outbox) XXX_DiscardUnknown() {
+	xxx_messageInfo_Outbox.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Outbox proto.InternalMessageInfo
+
+func (m *Outbox) GetOutboxId() string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return ""
+}
+
+func (m *Outbox) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *Outbox) GetAlertId() string {
+	if m != nil {
+		return m.AlertId
+	}
+	return ""
+}
+
+func (m *Outbox) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *Outbox) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *Outbox) GetNotifier() string {
+	if m != nil {
+		return m.Notifier
+	}
+	return ""
+}
+
+func (m *Outbox) GetAddresses() string {
+	if m != nil {
+		return m.Addresses
+	}
+	return ""
+}
+
+func (m *Outbox) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Outbox) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *Outbox) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Outbox) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Outbox) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Outbox) GetNotificationId() string {
+	if m != nil {
+		return m.NotificationId
+	}
+	return ""
+}
+
+func (m *Outbox) GetNextAttemptTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextAttemptTime
+	}
+	return nil
+}
+
+func (m *Outbox) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Outbox) GetUpdateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return nil
+}
+
+type DescribeOutboxesRequest struct {
+	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
+	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
+	Reverse              bool     `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse"`
+	Offset               uint32   `protobuf:"varint,4,opt,name=offset,proto3" json:"offset"`
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit"`
+	OutboxId             []string `protobuf:"bytes,6,rep,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	AlertId              []string `protobuf:"bytes,7,rep,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	Status               []string `protobuf:"bytes,8,rep,name=status,proto3" json:"status"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DescribeOutboxesRequest) Reset()         { *m = DescribeOutboxesRequest{} }
+func (m *DescribeOutboxesRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeOutboxesRequest) ProtoMessage()    {}
+func (*DescribeOutboxesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{120}
+}
+
+func (m *DescribeOutboxesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeOutboxesRequest.Unmarshal(m, b)
+}
+func (m *DescribeOutboxesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeOutboxesRequest.Marshal(b, m, deterministic)
+}
+func (m *DescribeOutboxesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeOutboxesRequest.Merge(m, src)
+}
+func (m *DescribeOutboxesRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeOutboxesRequest.Size(m)
+}
+func (m *DescribeOutboxesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeOutboxesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeOutboxesRequest proto.InternalMessageInfo
+
+func (m *DescribeOutboxesRequest) GetSearchWord() string {
+	if m != nil {
+		return m.SearchWord
+	}
+	return ""
+}
+
+func (m *DescribeOutboxesRequest) GetSortKey() string {
+	if m != nil {
+		return m.SortKey
+	}
+	return ""
+}
+
+func (m *DescribeOutboxesRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
+func (m *DescribeOutboxesRequest) GetOffset() uint32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DescribeOutboxesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *DescribeOutboxesRequest) GetOutboxId() []string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return nil
+}
+
+func (m *DescribeOutboxesRequest) GetAlertId() []string {
+	if m != nil {
+		return m.AlertId
+	}
+	return nil
+}
+
+func (m *DescribeOutboxesRequest) GetStatus() []string {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type DescribeOutboxesResponse struct {
+	Total                uint32    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	OutboxSet            []*Outbox `protobuf:"bytes,2,rep,name=outbox_set,json=outboxSet,proto3" json:"outbox_set"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *DescribeOutboxesResponse) Reset()         { *m = DescribeOutboxesResponse{} }
+func (m *DescribeOutboxesResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeOutboxesResponse) ProtoMessage()    {}
+func (*DescribeOutboxesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{121}
+}
+
+func (m *DescribeOutboxesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeOutboxesResponse.Unmarshal(m, b)
+}
+func (m *DescribeOutboxesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeOutboxesResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeOutboxesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeOutboxesResponse.Merge(m, src)
+}
+func (m *DescribeOutboxesResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeOutboxesResponse.Size(m)
+}
+func (m *DescribeOutboxesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeOutboxesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeOutboxesResponse proto.InternalMessageInfo
+
+func (m *DescribeOutboxesResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DescribeOutboxesResponse) GetOutboxSet() []*Outbox {
+	if m != nil {
+		return m.OutboxSet
+	}
+	return nil
+}
+
+// Retry delivers pending, dead or discarded outboxes again from the first attempt.
+type RetryOutboxesRequest struct {
+	OutboxId             []string `protobuf:"bytes,1,rep,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOutboxesRequest) Reset()         { *m = RetryOutboxesRequest{} }
+func (m *RetryOutboxesRequest) String() string { return proto.CompactTextString(m) }
+func (*RetryOutboxesRequest) ProtoMessage()    {}
+func (*RetryOutboxesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{122}
+}
+
+func (m *RetryOutboxesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOutboxesRequest.Unmarshal(m, b)
+}
+func (m *RetryOutboxesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOutboxesRequest.Marshal(b, m, deterministic)
+}
+func (m *RetryOutboxesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOutboxesRequest.Merge(m, src)
+}
+func (m *RetryOutboxesRequest) XXX_Size() int {
+	return xxx_messageInfo_RetryOutboxesRequest.Size(m)
+}
+func (m *RetryOutboxesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOutboxesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOutboxesRequest proto.InternalMessageInfo
+
+func (m *RetryOutboxesRequest) GetOutboxId() []string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return nil
+}
+
+type RetryOutboxesResponse struct {
+	OutboxId             []string `protobuf:"bytes,1,rep,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryOutboxesResponse) Reset()         { *m = RetryOutboxesResponse{} }
+func (m *RetryOutboxesResponse) String() string { return proto.CompactTextString(m) }
+func (*RetryOutboxesResponse) ProtoMessage()    {}
+func (*RetryOutboxesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{123}
+}
+
+func (m *RetryOutboxesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetryOutboxesResponse.Unmarshal(m, b)
+}
+func (m *RetryOutboxesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetryOutboxesResponse.Marshal(b, m, deterministic)
+}
+func (m *RetryOutboxesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOutboxesResponse.Merge(m, src)
+}
+func (m *RetryOutboxesResponse) XXX_Size() int {
+	return xxx_messageInfo_RetryOutboxesResponse.Size(m)
+}
+func (m *RetryOutboxesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOutboxesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOutboxesResponse proto.InternalMessageInfo
+
+func (m *RetryOutboxesResponse) GetOutboxId() []string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return nil
+}
+
+// Discard stops delivery of pending or dead outboxes.
+type DiscardOutboxesRequest struct {
+	OutboxId             []string `protobuf:"bytes,1,rep,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscardOutboxesRequest) Reset()         { *m = DiscardOutboxesRequest{} }
+func (m *DiscardOutboxesRequest) String() string { return proto.CompactTextString(m) }
+func (*DiscardOutboxesRequest) ProtoMessage()    {}
+func (*DiscardOutboxesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{124}
+}
+
+func (m *DiscardOutboxesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscardOutboxesRequest.Unmarshal(m, b)
+}
+func (m *DiscardOutboxesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscardOutboxesRequest.Marshal(b, m, deterministic)
+}
+func (m *DiscardOutboxesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscardOutboxesRequest.Merge(m, src)
+}
+func (m *DiscardOutboxesRequest) XXX_Size() int {
+	return xxx_messageInfo_DiscardOutboxesRequest.Size(m)
+}
+func (m *DiscardOutboxesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscardOutboxesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscardOutboxesRequest proto.InternalMessageInfo
+
+func (m *DiscardOutboxesRequest) GetOutboxId() []string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return nil
+}
+
+type DiscardOutboxesResponse struct {
+	OutboxId             []string `protobuf:"bytes,1,rep,name=outbox_id,json=outboxId,proto3" json:"outbox_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscardOutboxesResponse) Reset()         { *m = DiscardOutboxesResponse{} }
+func (m *DiscardOutboxesResponse) String() string { return proto.CompactTextString(m) }
+func (*DiscardOutboxesResponse) ProtoMessage()    {}
+func (*DiscardOutboxesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b11b2fb4e5b6d61, []int{125}
+}
+
+func (m *DiscardOutboxesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscardOutboxesResponse.Unmarshal(m, b)
+}
+func (m *DiscardOutboxesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscardOutboxesResponse.Marshal(b, m, deterministic)
+}
+func (m *DiscardOutboxesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscardOutboxesResponse.Merge(m, src)
+}
+func (m *DiscardOutboxesResponse) XXX_Size() int {
+	return xxx_messageInfo_DiscardOutboxesResponse.Size(m)
+}
+func (m *DiscardOutboxesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscardOutboxesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscardOutboxesResponse proto.InternalMessageInfo
+
+func (m *DiscardOutboxesResponse) GetOutboxId() []string {
+	if m != nil {
+		return m.OutboxId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Executor)(nil), "kubesphere.alert.Executor")
+	proto.RegisterType((*CreateExecutorRequest)(nil), "kubesphere.alert.CreateExecutorRequest")
+	proto.RegisterType((*CreateExecutorResponse)(nil), "kubesphere.alert.CreateExecutorResponse")
+	proto.RegisterType((*DescribeExecutorsRequest)(nil), "kubesphere.alert.DescribeExecutorsRequest")
+	proto.RegisterType((*DescribeExecutorsResponse)(nil), "kubesphere.alert.DescribeExecutorsResponse")
+	proto.RegisterType((*ModifyExecutorRequest)(nil), "kubesphere.alert.ModifyExecutorRequest")
+	proto.RegisterType((*ModifyExecutorResponse)(nil), "kubesphere.alert.ModifyExecutorResponse")
+	proto.RegisterType((*DeleteExecutorsRequest)(nil), "kubesphere.alert.DeleteExecutorsRequest")
+	proto.RegisterType((*DeleteExecutorsResponse)(nil), "kubesphere.alert.DeleteExecutorsResponse")
+	proto.RegisterType((*ResourceType)(nil), "kubesphere.alert.ResourceType")
+	proto.RegisterType((*CreateResourceTypeRequest)(nil), "kubesphere.alert.CreateResourceTypeRequest")
+	proto.RegisterType((*CreateResourceTypeResponse)(nil), "kubesphere.alert.CreateResourceTypeResponse")
+	proto.RegisterType((*DescribeResourceTypesRequest)(nil), "kubesphere.alert.DescribeResourceTypesRequest")
+	proto.RegisterType((*DescribeResourceTypesResponse)(nil), "kubesphere.alert.DescribeResourceTypesResponse")
+	proto.RegisterType((*ModifyResourceTypeRequest)(nil), "kubesphere.alert.ModifyResourceTypeRequest")
+	proto.RegisterType((*ModifyResourceTypeResponse)(nil), "kubesphere.alert.ModifyResourceTypeResponse")
+	proto.RegisterType((*DeleteResourceTypesRequest)(nil), "kubesphere.alert.DeleteResourceTypesRequest")
+	proto.RegisterType((*DeleteResourceTypesResponse)(nil), "kubesphere.alert.DeleteResourceTypesResponse")
+	proto.RegisterType((*ResourceFilter)(nil), "kubesphere.alert.ResourceFilter")
+	proto.RegisterType((*CreateResourceFilterRequest)(nil), "kubesphere.alert.CreateResourceFilterRequest")
+	proto.RegisterType((*CreateResourceFilterResponse)(nil), "kubesphere.alert.CreateResourceFilterResponse")
+	proto.RegisterType((*DescribeResourceFiltersRequest)(nil), "kubesphere.alert.DescribeResourceFiltersRequest")
+	proto.RegisterType((*DescribeResourceFiltersResponse)(nil), "kubesphere.alert.DescribeResourceFiltersResponse")
+	proto.RegisterType((*ModifyResourceFilterRequest)(nil), "kubesphere.alert.ModifyResourceFilterRequest")
+	proto.RegisterType((*ModifyResourceFilterResponse)(nil), "kubesphere.alert.ModifyResourceFilterResponse")
+	proto.RegisterType((*DeleteResourceFiltersRequest)(nil), "kubesphere.alert.DeleteResourceFiltersRequest")
+	proto.RegisterType((*DeleteResourceFiltersResponse)(nil), "kubesphere.alert.DeleteResourceFiltersResponse")
+	proto.RegisterType((*Metric)(nil), "kubesphere.alert.Metric")
+	proto.RegisterType((*CreateMetricRequest)(nil), "kubesphere.alert.CreateMetricRequest")
+	proto.RegisterType((*CreateMetricResponse)(nil), "kubesphere.alert.CreateMetricResponse")
+	proto.RegisterType((*DescribeMetricsRequest)(nil), "kubesphere.alert.DescribeMetricsRequest")
+	proto.RegisterType((*DescribeMetricsResponse)(nil), "kubesphere.alert.DescribeMetricsResponse")
+	proto.RegisterType((*ModifyMetricRequest)(nil), "kubesphere.alert.ModifyMetricRequest")
+	proto.RegisterType((*ModifyMetricResponse)(nil), "kubesphere.alert.ModifyMetricResponse")
+	proto.RegisterType((*DeleteMetricsRequest)(nil), "kubesphere.alert.DeleteMetricsRequest")
+	proto.RegisterType((*DeleteMetricsResponse)(nil), "kubesphere.alert.DeleteMetricsResponse")
+	proto.RegisterType((*Policy)(nil), "kubesphere.alert.Policy")
+	proto.RegisterType((*CreatePolicyRequest)(nil), "kubesphere.alert.CreatePolicyRequest")
+	proto.RegisterType((*CreatePolicyResponse)(nil), "kubesphere.alert.CreatePolicyResponse")
+	proto.RegisterType((*DescribePoliciesRequest)(nil), "kubesphere.alert.DescribePoliciesRequest")
+	proto.RegisterType((*DescribePoliciesResponse)(nil), "kubesphere.alert.DescribePoliciesResponse")
+	proto.RegisterType((*ModifyPolicyRequest)(nil), "kubesphere.alert.ModifyPolicyRequest")
+	proto.RegisterType((*ModifyPolicyResponse)(nil), "kubesphere.alert.ModifyPolicyResponse")
+	proto.RegisterType((*DeletePoliciesRequest)(nil), "kubesphere.alert.DeletePoliciesRequest")
+	proto.RegisterType((*DeletePoliciesResponse)(nil), "kubesphere.alert.DeletePoliciesResponse")
+	proto.RegisterType((*Rule)(nil), "kubesphere.alert.Rule")
+	proto.RegisterType((*CreateRuleRequest)(nil), "kubesphere.alert.CreateRuleRequest")
+	proto.RegisterType((*CreateRuleResponse)(nil), "kubesphere.alert.CreateRuleResponse")
+	proto.RegisterType((*DescribeRulesRequest)(nil), "kubesphere.alert.DescribeRulesRequest")
+	proto.RegisterType((*DescribeRulesResponse)(nil), "kubesphere.alert.DescribeRulesResponse")
+	proto.RegisterType((*ModifyRuleRequest)(nil), "kubesphere.alert.ModifyRuleRequest")
+	proto.RegisterType((*ModifyRuleResponse)(nil), "kubesphere.alert.ModifyRuleResponse")
+	proto.RegisterType((*DeleteRulesRequest)(nil), "kubesphere.alert.DeleteRulesRequest")
+	proto.RegisterType((*DeleteRulesResponse)(nil), "kubesphere.alert.DeleteRulesResponse")
+	proto.RegisterType((*Alert)(nil), "kubesphere.alert.Alert")
+	proto.RegisterType((*CreateAlertRequest)(nil), "kubesphere.alert.CreateAlertRequest")
+	proto.RegisterType((*CreateAlertResponse)(nil), "kubesphere.alert.CreateAlertResponse")
+	proto.RegisterType((*DescribeAlertsRequest)(nil), "kubesphere.alert.DescribeAlertsRequest")
+	proto.RegisterType((*DescribeAlertsResponse)(nil), "kubesphere.alert.DescribeAlertsResponse")
+	proto.RegisterType((*ModifyAlertRequest)(nil), "kubesphere.alert.ModifyAlertRequest")
+	proto.RegisterType((*ModifyAlertResponse)(nil), "kubesphere.alert.ModifyAlertResponse")
+	proto.RegisterType((*DeleteAlertsRequest)(nil), "kubesphere.alert.DeleteAlertsRequest")
+	proto.RegisterType((*DeleteAlertsResponse)(nil), "kubesphere.alert.DeleteAlertsResponse")
+	proto.RegisterType((*History)(nil), "kubesphere.alert.History")
+	proto.RegisterType((*CreateHistoryRequest)(nil), "kubesphere.alert.CreateHistoryRequest")
+	proto.RegisterType((*CreateHistoryResponse)(nil), "kubesphere.alert.CreateHistoryResponse")
+	proto.RegisterType((*DescribeHistoriesRequest)(nil), "kubesphere.alert.DescribeHistoriesRequest")
+	proto.RegisterType((*DescribeHistoriesResponse)(nil), "kubesphere.alert.DescribeHistoriesResponse")
+	proto.RegisterType((*ModifyHistoryRequest)(nil), "kubesphere.alert.ModifyHistoryRequest")
+	proto.RegisterType((*ModifyHistoryResponse)(nil), "kubesphere.alert.ModifyHistoryResponse")
+	proto.RegisterType((*DeleteHistoriesRequest)(nil), "kubesphere.alert.DeleteHistoriesRequest")
 	proto.RegisterType((*DeleteHistoriesResponse)(nil), "kubesphere.alert.DeleteHistoriesResponse")
 	proto.RegisterType((*Comment)(nil), "kubesphere.alert.Comment")
 	proto.RegisterType((*CreateCommentRequest)(nil), "kubesphere.alert.CreateCommentRequest")
@@ -5802,254 +8309,392 @@ func init() {
 	proto.RegisterType((*ModifyActionResponse)(nil), "kubesphere.alert.ModifyActionResponse")
 	proto.RegisterType((*DeleteActionsRequest)(nil), "kubesphere.alert.DeleteActionsRequest")
 	proto.RegisterType((*DeleteActionsResponse)(nil), "kubesphere.alert.DeleteActionsResponse")
+	proto.RegisterType((*InhibitRule)(nil), "kubesphere.alert.InhibitRule")
+	proto.RegisterType((*CreateInhibitRuleRequest)(nil), "kubesphere.alert.CreateInhibitRuleRequest")
+	proto.RegisterType((*CreateInhibitRuleResponse)(nil), "kubesphere.alert.CreateInhibitRuleResponse")
+	proto.RegisterType((*DescribeInhibitRulesRequest)(nil), "kubesphere.alert.DescribeInhibitRulesRequest")
+	proto.RegisterType((*DescribeInhibitRulesResponse)(nil), "kubesphere.alert.DescribeInhibitRulesResponse")
+	proto.RegisterType((*ModifyInhibitRuleRequest)(nil), "kubesphere.alert.ModifyInhibitRuleRequest")
+	proto.RegisterType((*ModifyInhibitRuleResponse)(nil), "kubesphere.alert.ModifyInhibitRuleResponse")
+	proto.RegisterType((*DeleteInhibitRulesRequest)(nil), "kubesphere.alert.DeleteInhibitRulesRequest")
+	proto.RegisterType((*DeleteInhibitRulesResponse)(nil), "kubesphere.alert.DeleteInhibitRulesResponse")
+	proto.RegisterType((*Silence)(nil), "kubesphere.alert.Silence")
+	proto.RegisterType((*CreateSilenceRequest)(nil), "kubesphere.alert.CreateSilenceRequest")
+	proto.RegisterType((*CreateSilenceResponse)(nil), "kubesphere.alert.CreateSilenceResponse")
+	proto.RegisterType((*DescribeSilencesRequest)(nil), "kubesphere.alert.DescribeSilencesRequest")
+	proto.RegisterType((*DescribeSilencesResponse)(nil), "kubesphere.alert.DescribeSilencesResponse")
+	proto.RegisterType((*ModifySilenceRequest)(nil), "kubesphere.alert.ModifySilenceRequest")
+	proto.RegisterType((*ModifySilenceResponse)(nil), "kubesphere.alert.ModifySilenceResponse")
+	proto.RegisterType((*DeleteSilencesRequest)(nil), "kubesphere.alert.DeleteSilencesRequest")
+	proto.RegisterType((*DeleteSilencesResponse)(nil), "kubesphere.alert.DeleteSilencesResponse")
+	proto.RegisterType((*Template)(nil), "kubesphere.alert.Template")
+	proto.RegisterType((*CreateTemplateRequest)(nil), "kubesphere.alert.CreateTemplateRequest")
+	proto.RegisterType((*CreateTemplateResponse)(nil), "kubesphere.alert.CreateTemplateResponse")
+	proto.RegisterType((*DescribeTemplatesRequest)(nil), "kubesphere.alert.DescribeTemplatesRequest")
+	proto.RegisterType((*DescribeTemplatesResponse)(nil), "kubesphere.alert.DescribeTemplatesResponse")
+	proto.RegisterType((*ModifyTemplateRequest)(nil), "kubesphere.alert.ModifyTemplateRequest")
+	proto.RegisterType((*ModifyTemplateResponse)(nil), "kubesphere.alert.ModifyTemplateResponse")
+	proto.RegisterType((*DeleteTemplatesRequest)(nil), "kubesphere.alert.DeleteTemplatesRequest")
+	proto.RegisterType((*DeleteTemplatesResponse)(nil), "kubesphere.alert.DeleteTemplatesResponse")
+	proto.RegisterType((*PreviewTemplateRequest)(nil), "kubesphere.alert.PreviewTemplateRequest")
+	proto.RegisterType((*PreviewTemplateResponse)(nil), "kubesphere.alert.PreviewTemplateResponse")
+	proto.RegisterType((*Outbox)(nil), "kubesphere.alert.Outbox")
+	proto.RegisterType((*DescribeOutboxesRequest)(nil), "kubesphere.alert.DescribeOutboxesRequest")
+	proto.RegisterType((*DescribeOutboxesResponse)(nil), "kubesphere.alert.DescribeOutboxesResponse")
+	proto.RegisterType((*RetryOutboxesRequest)(nil), "kubesphere.alert.RetryOutboxesRequest")
+	proto.RegisterType((*RetryOutboxesResponse)(nil), "kubesphere.alert.RetryOutboxesResponse")
+	proto.RegisterType((*DiscardOutboxesRequest)(nil), "kubesphere.alert.DiscardOutboxesRequest")
+	proto.RegisterType((*DiscardOutboxesResponse)(nil), "kubesphere.alert.DiscardOutboxesResponse")
 }
 
 func init() { proto.RegisterFile("alert.proto", fileDescriptor_3b11b2fb4e5b6d61) }
 
 var fileDescriptor_3b11b2fb4e5b6d61 = []byte{
-	// 3858 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4f, 0x6c, 0x1c, 0x49,
-	0xd5, 0x57, 0x8f, 0xff, 0xcc, 0x4c, 0xcd, 0x1f, 0xdb, 0x15, 0xc7, 0xb1, 0x27, 0xd9, 0xc4, 0xdb,
-	0xf9, 0xe7, 0x75, 0x62, 0x3b, 0x71, 0xf6, 0xcf, 0xb7, 0xd9, 0xef, 0x93, 0x76, 0xbe, 0xec, 0x7e,
-	0xfa, 0x0c, 0x2c, 0xac, 0x9c, 0x95, 0x90, 0xb8, 0x0c, 0x93, 0x99, 0xb6, 0xdd, 0xda, 0xf1, 0xf4,
-	0xd0, 0xdd, 0x93, 0xc5, 0x12, 0x12, 0x5a, 0x0e, 0x08, 0x01, 0x82, 0xd5, 0xac, 0x40, 0x82, 0x1b,
-	0x1c, 0x90, 0x10, 0x1c, 0xf6, 0xc2, 0x85, 0x03, 0x07, 0xb8, 0x70, 0xe2, 0xc2, 0x05, 0xc1, 0x0d,
-	0x71, 0x5b, 0x24, 0x24, 0x2e, 0x80, 0xc4, 0x01, 0x55, 0xd5, 0xab, 0xee, 0x7a, 0xd5, 0x55, 0xdd,
-	0x93, 0x8d, 0x42, 0x8c, 0xb4, 0x27, 0xbb, 0x5f, 0xbd, 0x9a, 0x7e, 0xf5, 0x7b, 0xbf, 0x7a, 0xaf,
-	0xfa, 0x55, 0x15, 0xa9, 0x75, 0x07, 0x5e, 0x18, 0x6f, 0x8f, 0xc2, 0x20, 0x0e, 0xe8, 0xe2, 0xdb,
-	0xe3, 0x07, 0x5e, 0x34, 0x3a, 0xf2, 0x42, 0x6f, 0x9b, 0xcb, 0x5b, 0x17, 0x0e, 0x83, 0xe0, 0x70,
-	0xe0, 0xed, 0x74, 0x47, 0xfe, 0x4e, 0x77, 0x38, 0x0c, 0xe2, 0x6e, 0xec, 0x07, 0xc3, 0x48, 0xe8,
-	0xb7, 0x2e, 0x42, 0x2b, 0x7f, 0x7a, 0x30, 0x3e, 0xd8, 0x79, 0x27, 0xec, 0x8e, 0x46, 0x5e, 0x28,
-	0xdb, 0x6f, 0xf2, 0x3f, 0xbd, 0xad, 0x43, 0x6f, 0xb8, 0x15, 0xbd, 0xd3, 0x3d, 0x3c, 0xf4, 0xc2,
-	0x9d, 0x60, 0xc4, 0x7f, 0xc1, 0xf0, 0x6b, 0x97, 0xf4, 0x5f, 0x8b, 0xfd, 0x63, 0x2f, 0x8a, 0xbb,
-	0xc7, 0x23, 0xa1, 0xe0, 0xfe, 0xd1, 0x21, 0x95, 0xd7, 0xbf, 0xe8, 0xf5, 0xc6, 0x71, 0x10, 0xd2,
-	0x4b, 0xa4, 0xe6, 0xc1, 0xff, 0x1d, 0xbf, 0xbf, 0xea, 0xac, 0x3b, 0x1b, 0xd5, 0x7d, 0x22, 0x45,
-	0x7b, 0x7d, 0x7a, 0x99, 0x34, 0x12, 0x85, 0x61, 0xf7, 0xd8, 0x5b, 0x2d, 0x71, 0x95, 0xba, 0x14,
-	0x7e, 0xba, 0x7b, 0xec, 0xd1, 0x15, 0x32, 0x1f, 0xc5, 0xdd, 0x78, 0x1c, 0xad, 0xce, 0xf0, 0x56,
-	0x78, 0xa2, 0xaf, 0x90, 0x5a, 0x2f, 0xf4, 0xba, 0xb1, 0xd7, 0x61, 0x46, 0xac, 0xce, 0xae, 0x3b,
-	0x1b, 0xb5, 0xdd, 0xd6, 0xb6, 0xb0, 0x70, 0x5b, 0x5a, 0xb8, 0xfd, 0x96, 0xb4, 0x70, 0x9f, 0x08,
-	0x75, 0x26, 0x60, 0x9d, 0xc7, 0xa3, 0x7e, 0xd2, 0x79, 0xae, 0xb8, 0xb3, 0x50, 0x67, 0x02, 0xf7,
-	0xbf, 0xc9, 0xd9, 0x7b, 0xfc, 0xa7, 0xe4, 0x48, 0xf7, 0xbd, 0x2f, 0x8c, 0xbd, 0x28, 0xce, 0x8e,
-	0xc7, 0xc9, 0x8e, 0xc7, 0x7d, 0x99, 0xac, 0xe8, 0xbd, 0xa3, 0x51, 0x30, 0x8c, 0xbc, 0x42, 0xbc,
-	0xdc, 0x7f, 0x3a, 0x64, 0xf5, 0x35, 0x2f, 0xea, 0x85, 0xfe, 0x83, 0xa4, 0x77, 0x24, 0x5f, 0x7e,
-	0x89, 0xd4, 0x22, 0xaf, 0x1b, 0xf6, 0x8e, 0x3a, 0xef, 0x04, 0x61, 0xd2, 0x5b, 0x88, 0x3e, 0x1b,
-	0x84, 0x7d, 0xba, 0x46, 0x2a, 0x51, 0x10, 0xc6, 0x9d, 0xb7, 0xbd, 0x13, 0x00, 0xba, 0xcc, 0x9e,
-	0x3f, 0xe9, 0x9d, 0xd0, 0x55, 0x52, 0x0e, 0xbd, 0x87, 0x5e, 0x18, 0x79, 0x1c, 0xe4, 0xca, 0xbe,
-	0x7c, 0x64, 0xe8, 0x07, 0x07, 0x07, 0x91, 0x17, 0x73, 0x80, 0x1b, 0xfb, 0xf0, 0x44, 0x97, 0xc9,
-	0xdc, 0xc0, 0x3f, 0xf6, 0x63, 0x0e, 0x5d, 0x63, 0x5f, 0x3c, 0xe8, 0x23, 0x98, 0x5f, 0x9f, 0x29,
-	0xf2, 0x78, 0x99, 0xab, 0xd8, 0x3c, 0x5e, 0xe1, 0xad, 0xf0, 0xe4, 0x8e, 0xc8, 0x9a, 0x61, 0xf4,
-	0x00, 0xde, 0x32, 0x99, 0x8b, 0x83, 0xb8, 0x3b, 0xe0, 0x03, 0x6f, 0xec, 0x8b, 0x07, 0xfa, 0x3f,
-	0x24, 0xf9, 0xe9, 0x0e, 0x1b, 0x44, 0x69, 0x7d, 0x86, 0x3b, 0x5a, 0x9f, 0x45, 0xdb, 0x89, 0x33,
-	0x92, 0x01, 0xdc, 0xf7, 0x62, 0x77, 0x4c, 0xce, 0xbe, 0x11, 0xf4, 0xfd, 0x83, 0x13, 0xdd, 0xd3,
-	0x4f, 0x94, 0xda, 0x8c, 0x22, 0xfa, 0x6b, 0xa7, 0xa5, 0xc8, 0xcb, 0x64, 0xe5, 0x35, 0x6f, 0xe0,
-	0xc5, 0x46, 0x7e, 0xe0, 0xae, 0x9a, 0x6f, 0xdc, 0xbb, 0xe4, 0x5c, 0xa6, 0xab, 0xed, 0xb5, 0x7a,
-	0xdf, 0x3f, 0x3b, 0xa4, 0xbe, 0xef, 0x45, 0xc1, 0x38, 0xec, 0x79, 0x6f, 0x9d, 0x8c, 0x3c, 0x7a,
-	0x81, 0x90, 0x30, 0xea, 0xc4, 0x27, 0x23, 0x2f, 0xb5, 0xb3, 0x12, 0x46, 0xac, 0x6d, 0xaf, 0x4f,
-	0xd7, 0x49, 0x5d, 0xb6, 0x2a, 0xe0, 0x10, 0xd1, 0xce, 0xa1, 0x71, 0x49, 0x43, 0x6a, 0x8c, 0xba,
-	0x61, 0xf7, 0x18, 0x10, 0xaa, 0x09, 0x95, 0x37, 0x99, 0xe8, 0x29, 0x46, 0x80, 0x2e, 0x59, 0x13,
-	0x73, 0x58, 0x1d, 0xb3, 0x04, 0x5a, 0x1f, 0x9c, 0x53, 0x3c, 0xb8, 0x52, 0x66, 0x70, 0xee, 0x5d,
-	0xd2, 0x32, 0xbd, 0x02, 0x1c, 0x92, 0x0b, 0x2f, 0x8b, 0xc2, 0x17, 0xe4, 0x4c, 0x51, 0xbb, 0x9f,
-	0xaa, 0x58, 0x81, 0x87, 0x20, 0x42, 0x85, 0x9d, 0x21, 0x22, 0x4e, 0x28, 0x20, 0xba, 0xef, 0x3a,
-	0xe4, 0x19, 0xcb, 0x20, 0x73, 0x43, 0xc2, 0x27, 0xc8, 0x52, 0x08, 0xea, 0xe2, 0xf7, 0xd3, 0xb8,
-	0x70, 0x31, 0x1b, 0x17, 0x10, 0xfa, 0x0b, 0xa1, 0xf2, 0xc4, 0xe2, 0xc3, 0x97, 0xc9, 0x9a, 0x98,
-	0xa8, 0x26, 0x1e, 0xfc, 0x1b, 0xa6, 0x00, 0x63, 0x89, 0xc9, 0x80, 0xa9, 0x58, 0x72, 0x97, 0xb4,
-	0xc4, 0x7c, 0x37, 0x52, 0x44, 0xef, 0x8b, 0xdc, 0xe3, 0xbe, 0x42, 0xce, 0x1b, 0xfb, 0x5a, 0x5e,
-	0x8c, 0x3b, 0x7f, 0x50, 0x22, 0x4d, 0xd9, 0xef, 0xff, 0xfc, 0x41, 0xec, 0x85, 0x80, 0xc6, 0x01,
-	0x7f, 0x50, 0x02, 0x5b, 0x18, 0x89, 0xf6, 0xbd, 0x3e, 0xbd, 0x42, 0x9a, 0xa9, 0x86, 0x1a, 0x51,
-	0xa5, 0x0e, 0xc7, 0xec, 0x1a, 0x59, 0x48, 0xb5, 0x54, 0xd4, 0x1a, 0x52, 0x4d, 0x84, 0x8e, 0x34,
-	0xf2, 0xce, 0xe6, 0x2d, 0x2a, 0xe6, 0x1e, 0x27, 0xa4, 0xcc, 0x3f, 0x4a, 0x48, 0xd1, 0x20, 0x2b,
-	0x6b, 0xbe, 0xfa, 0x81, 0x43, 0xce, 0xe3, 0x70, 0x20, 0x46, 0x23, 0xbd, 0x95, 0x45, 0xc7, 0x99,
-	0x0e, 0x9d, 0x52, 0x3e, 0x3a, 0x78, 0xc9, 0x85, 0x6d, 0x9c, 0xd5, 0x6c, 0x7c, 0x95, 0x5c, 0x30,
-	0x9b, 0x08, 0xa4, 0x28, 0xf4, 0xb1, 0xfb, 0xc3, 0x12, 0xb9, 0xa8, 0x4f, 0x69, 0xd1, 0x78, 0xaa,
-	0x22, 0x97, 0x3e, 0x90, 0x79, 0x19, 0x9b, 0x72, 0xc8, 0x0a, 0xeb, 0x1c, 0xe4, 0x0e, 0xcb, 0x3a,
-	0x47, 0x83, 0xb9, 0xaa, 0xcd, 0x9e, 0xaf, 0x39, 0xe4, 0x92, 0x15, 0xa4, 0xdc, 0xc8, 0xf7, 0x19,
-	0x42, 0x65, 0x00, 0x03, 0xd3, 0xd2, 0xd0, 0xb7, 0x6e, 0x0f, 0x7d, 0xe0, 0xc6, 0x25, 0xdc, 0x97,
-	0x85, 0xbf, 0x5f, 0x39, 0xe4, 0x3c, 0x0e, 0x3f, 0x98, 0x95, 0xa7, 0x65, 0x56, 0x63, 0x40, 0xe7,
-	0xb2, 0xbc, 0x35, 0x0f, 0x62, 0x6a, 0xde, 0xbe, 0xca, 0xd2, 0xad, 0x1a, 0x0d, 0x35, 0xd2, 0x66,
-	0x7f, 0x41, 0x23, 0x8c, 0xdb, 0x66, 0xb9, 0xcc, 0xf8, 0x0b, 0x56, 0x23, 0xf4, 0x9f, 0xf8, 0x5e,
-	0x89, 0xcc, 0xbf, 0xe1, 0xc5, 0xa1, 0xdf, 0xa3, 0xe7, 0x49, 0xf5, 0x98, 0xff, 0xa7, 0x84, 0x7d,
-	0x21, 0xd8, 0xeb, 0xb3, 0x19, 0x04, 0x8d, 0x6a, 0xde, 0x11, 0x22, 0x8e, 0xf6, 0xb3, 0xa4, 0x0e,
-	0x0a, 0x28, 0xed, 0x08, 0xd9, 0x7f, 0x66, 0xf8, 0xfc, 0xb6, 0x43, 0xce, 0x88, 0xd8, 0x24, 0x10,
-	0x52, 0xa2, 0x89, 0x8a, 0x85, 0x53, 0x88, 0x45, 0x29, 0x0f, 0x8b, 0x47, 0x09, 0x96, 0x77, 0xc8,
-	0x32, 0x36, 0x08, 0xfc, 0x9c, 0xe7, 0x3a, 0xf7, 0xbd, 0x12, 0x5b, 0xdd, 0x8b, 0xa9, 0x2f, 0xfa,
-	0x9d, 0xaa, 0xb8, 0x88, 0x6c, 0x87, 0x05, 0x9d, 0x8d, 0x76, 0xb0, 0x9e, 0x53, 0xa0, 0xfe, 0x68,
-	0xd1, 0xf0, 0x88, 0x7d, 0xb4, 0x68, 0x88, 0xe4, 0x06, 0xc1, 0x97, 0x08, 0xbc, 0x54, 0x09, 0x7e,
-	0xab, 0xd9, 0xe0, 0x07, 0x6e, 0x81, 0x01, 0xb1, 0x60, 0xf7, 0x53, 0x87, 0x9c, 0x11, 0x71, 0x02,
-	0x73, 0xe8, 0xa9, 0x4d, 0xb6, 0xfc, 0xa8, 0x76, 0x87, 0x2c, 0x63, 0x6b, 0xa7, 0x21, 0xd8, 0x1d,
-	0xb2, 0x2c, 0xc2, 0x90, 0xc6, 0x2e, 0xad, 0x13, 0xf2, 0xac, 0xfb, 0x3c, 0x39, 0xab, 0x75, 0x32,
-	0xbf, 0x0a, 0xf7, 0xfa, 0xf5, 0x0c, 0x99, 0x7f, 0x33, 0x18, 0xf8, 0xbd, 0x13, 0xa6, 0x37, 0xe2,
-	0xff, 0x29, 0x26, 0x09, 0x81, 0x40, 0x10, 0x1a, 0x55, 0x04, 0x85, 0x88, 0x23, 0xb8, 0x45, 0x28,
-	0x28, 0xf4, 0x39, 0x11, 0x78, 0xf1, 0x0a, 0x70, 0x5c, 0x12, 0x2d, 0xaf, 0xa5, 0x0d, 0xec, 0xc3,
-	0x1c, 0xd4, 0x7b, 0xc1, 0xf0, 0xc0, 0x3f, 0x04, 0x50, 0xeb, 0x42, 0x78, 0x8f, 0xcb, 0xd8, 0x8c,
-	0xe0, 0x81, 0x29, 0x08, 0x01, 0x57, 0xf9, 0x48, 0x6f, 0x91, 0xe5, 0xee, 0xc3, 0xae, 0x3f, 0xe8,
-	0x3e, 0x18, 0x78, 0x9d, 0x28, 0xee, 0x86, 0x71, 0x1a, 0xad, 0xaa, 0xfb, 0x34, 0x69, 0xbb, 0xcf,
-	0x9a, 0x78, 0x64, 0xba, 0x49, 0x52, 0x69, 0xc7, 0x1b, 0xf6, 0x85, 0xbe, 0x88, 0x50, 0x8b, 0x49,
-	0xcb, 0xeb, 0xc3, 0xbe, 0x0c, 0x82, 0x6a, 0x04, 0xad, 0x3c, 0x4e, 0x04, 0xad, 0x3e, 0x46, 0x04,
-	0x25, 0xda, 0xe7, 0x4a, 0x8b, 0x54, 0x06, 0xdd, 0xe1, 0xe1, 0xb8, 0x7b, 0xe8, 0xad, 0xd6, 0x44,
-	0x9b, 0x7c, 0x76, 0x7f, 0x51, 0x92, 0xd1, 0x55, 0x38, 0x54, 0x89, 0x49, 0xaa, 0xeb, 0x9c, 0x29,
-	0x5d, 0x57, 0x9a, 0xda, 0x75, 0x33, 0xf9, 0xae, 0x9b, 0x9d, 0xce, 0x75, 0x73, 0x8f, 0xe8, 0xba,
-	0x79, 0x8b, 0xeb, 0x72, 0x53, 0x10, 0x02, 0xb0, 0xa2, 0x01, 0x98, 0x24, 0x03, 0x89, 0x5f, 0x3a,
-	0x81, 0xac, 0x13, 0xc3, 0xfd, 0x65, 0x29, 0x0d, 0x7d, 0xbc, 0x9f, 0xef, 0x9d, 0xb6, 0x6c, 0x90,
-	0x1a, 0x0f, 0xd9, 0xc0, 0x36, 0xab, 0x21, 0x1b, 0x14, 0x52, 0x43, 0x64, 0x06, 0x03, 0x35, 0x14,
-	0xaf, 0x8b, 0x0c, 0x91, 0x78, 0x5d, 0xa7, 0x35, 0x4e, 0x1f, 0x7e, 0x5a, 0x50, 0x4d, 0x31, 0x2c,
-	0xca, 0x1f, 0x60, 0x58, 0x6e, 0xfe, 0x00, 0x4f, 0x02, 0x04, 0x2c, 0x7f, 0xfc, 0xa1, 0x24, 0xf3,
-	0x07, 0x9e, 0x25, 0x1f, 0x47, 0x3f, 0xdb, 0x14, 0xaa, 0xe4, 0x4c, 0xa1, 0x6a, 0x76, 0x0a, 0x61,
-	0x70, 0xa7, 0x99, 0x42, 0x49, 0xe6, 0xd2, 0xe7, 0x8f, 0xd6, 0x0b, 0x71, 0xd7, 0x7d, 0x41, 0x96,
-	0x58, 0x33, 0x8c, 0xc9, 0xed, 0xf6, 0xdd, 0x59, 0x32, 0xbb, 0x3f, 0x1e, 0x78, 0xf4, 0x1c, 0x29,
-	0x87, 0xe3, 0x81, 0x52, 0x92, 0x99, 0x67, 0x8f, 0x7b, 0x7d, 0xd6, 0x9d, 0x37, 0x28, 0xae, 0xae,
-	0x30, 0x01, 0x77, 0x74, 0x8b, 0x54, 0xfa, 0x7e, 0xc4, 0xc0, 0xea, 0xc3, 0xbc, 0x4c, 0x9e, 0xe9,
-	0x75, 0xb2, 0x70, 0x1c, 0x0c, 0xfd, 0x38, 0x08, 0x3b, 0x23, 0x2f, 0xf4, 0x83, 0x7e, 0x04, 0x33,
-	0xb4, 0x09, 0xe2, 0x37, 0x85, 0x94, 0xfd, 0x48, 0xc4, 0x26, 0xb3, 0x1f, 0x9f, 0xc8, 0x05, 0x83,
-	0x7c, 0x4e, 0x57, 0x22, 0xc2, 0x01, 0xe0, 0x53, 0x58, 0x89, 0x70, 0x17, 0xd0, 0xab, 0xa4, 0xd9,
-	0x0b, 0x86, 0x7d, 0x9f, 0x51, 0x49, 0x28, 0x09, 0x47, 0x36, 0x12, 0x29, 0x57, 0xbb, 0x48, 0x48,
-	0x7c, 0x14, 0x7a, 0xd1, 0x51, 0x30, 0xe8, 0x47, 0xe0, 0x45, 0x45, 0x42, 0x29, 0x99, 0x1d, 0x0f,
-	0xfd, 0x18, 0x7c, 0xc8, 0xff, 0xa7, 0x37, 0xc8, 0x52, 0x8f, 0x61, 0xd8, 0x1b, 0xc7, 0xfe, 0x43,
-	0xaf, 0xd3, 0x0b, 0xc6, 0xc3, 0x98, 0x27, 0xa1, 0xc6, 0xfe, 0xa2, 0xd2, 0x70, 0x8f, 0xc9, 0x19,
-	0x41, 0xfd, 0xe1, 0x91, 0xff, 0xc0, 0x8f, 0x79, 0x2e, 0xaa, 0xec, 0xcb, 0x47, 0x3d, 0x7d, 0xd6,
-	0x1f, 0x27, 0x7d, 0x36, 0x1e, 0x29, 0x7d, 0x22, 0xdf, 0x37, 0xb5, 0x69, 0x8c, 0x56, 0x42, 0x0b,
-	0xfa, 0xaa, 0x7e, 0x86, 0x2c, 0x41, 0xe1, 0x64, 0x3c, 0xf0, 0x14, 0x0a, 0xa6, 0x64, 0x70, 0x72,
-	0xc8, 0x50, 0x2a, 0x26, 0xc3, 0x4c, 0x21, 0x19, 0x66, 0x0b, 0xc8, 0x30, 0x37, 0x0d, 0x19, 0xe6,
-	0x8b, 0xc9, 0x50, 0xb6, 0x92, 0xa1, 0x52, 0x44, 0x86, 0x6a, 0x31, 0x19, 0x08, 0x26, 0x03, 0x72,
-	0x49, 0x2d, 0xcf, 0x25, 0x75, 0xcd, 0x25, 0x5b, 0x84, 0xaa, 0x1e, 0x81, 0xe9, 0x6d, 0x9b, 0xb8,
-	0xee, 0x07, 0xb3, 0x6c, 0xdd, 0x0c, 0x25, 0x99, 0xf1, 0xe0, 0x74, 0xe5, 0x61, 0xc5, 0x6a, 0x91,
-	0x85, 0x8d, 0xe1, 0xa6, 0x0c, 0x89, 0xd1, 0xc4, 0x30, 0x96, 0x75, 0x0b, 0x18, 0xc6, 0x92, 0x6e,
-	0x3e, 0xc3, 0x20, 0xf3, 0x5a, 0x19, 0x56, 0xe3, 0xed, 0x05, 0x0c, 0xab, 0x73, 0xa5, 0x5c, 0x86,
-	0x35, 0xc4, 0x52, 0xc2, 0xc0, 0xb0, 0x26, 0x6f, 0xc9, 0x61, 0xd8, 0x02, 0x1f, 0x44, 0x2e, 0xc3,
-	0x16, 0x39, 0x14, 0x66, 0x86, 0x2d, 0x69, 0x6b, 0x1c, 0xc4, 0x30, 0xaa, 0x7d, 0xfe, 0x7c, 0x9e,
-	0xa5, 0x1e, 0xc4, 0x98, 0xdc, 0x55, 0xc7, 0x6d, 0xc2, 0x5d, 0xa3, 0xac, 0x39, 0x56, 0x0c, 0x05,
-	0x3b, 0x46, 0x56, 0xee, 0x6c, 0xb6, 0xde, 0xf8, 0x4b, 0x89, 0x2c, 0x41, 0x5d, 0x4b, 0x09, 0x2b,
-	0x1f, 0x27, 0x9f, 0x27, 0x96, 0x7c, 0x58, 0xd4, 0x50, 0x01, 0x2f, 0x8a, 0x1a, 0x5b, 0x84, 0x42,
-	0xcd, 0x4f, 0x0d, 0x19, 0x48, 0x5d, 0x99, 0xae, 0xee, 0x36, 0x39, 0x83, 0xd4, 0x4d, 0x3f, 0xaf,
-	0xea, 0xbf, 0x3b, 0x43, 0xe6, 0xda, 0x8c, 0x17, 0x2c, 0xc8, 0x70, 0x82, 0xa4, 0x26, 0x94, 0xf9,
-	0xf3, 0x5e, 0x9f, 0x3e, 0x43, 0x88, 0x68, 0x52, 0xdc, 0x5e, 0xe5, 0x92, 0x42, 0xbf, 0x5f, 0x25,
-	0xcd, 0x70, 0x3c, 0x1c, 0xfa, 0xc3, 0xc3, 0x0e, 0x2a, 0x4f, 0x34, 0x40, 0x7a, 0x5f, 0x54, 0x29,
-	0x9e, 0x25, 0x75, 0xf1, 0x06, 0x50, 0x82, 0x4c, 0xc2, 0x65, 0xf7, 0x8d, 0x55, 0xc3, 0xf9, 0xc7,
-	0x49, 0xda, 0xe5, 0x8f, 0x9e, 0xb4, 0x2b, 0x5a, 0x86, 0xd0, 0x4b, 0xae, 0xd5, 0x4c, 0xf5, 0x5a,
-	0xdb, 0x16, 0x27, 0x99, 0xdd, 0xf8, 0x6f, 0x39, 0x32, 0x91, 0x70, 0x4f, 0x48, 0x1f, 0x63, 0xd4,
-	0x9d, 0x3c, 0xd4, 0xf5, 0xec, 0x8e, 0x2c, 0x9e, 0x29, 0xb0, 0x78, 0x36, 0x53, 0xa9, 0xbe, 0x25,
-	0xbf, 0xd4, 0xc1, 0x1e, 0x20, 0x91, 0x9d, 0x21, 0xee, 0xdf, 0x4b, 0x69, 0xa4, 0xe2, 0x9d, 0x4e,
-	0x55, 0x72, 0x53, 0x0d, 0x17, 0xd9, 0xcd, 0x42, 0x6d, 0x91, 0xdf, 0x2c, 0x20, 0xeb, 0x09, 0x2e,
-	0x4b, 0x6d, 0xf1, 0x51, 0xa9, 0x51, 0x1b, 0xf9, 0x82, 0x68, 0xd1, 0x5f, 0xf7, 0x45, 0x2d, 0xb3,
-	0x49, 0xa4, 0xb1, 0xa7, 0x9e, 0x39, 0x54, 0xd1, 0x4f, 0xab, 0xbd, 0x12, 0xf9, 0xdc, 0x24, 0xf1,
-	0x3c, 0xa9, 0xc2, 0x54, 0x4b, 0xb2, 0xc4, 0xb9, 0x6c, 0x96, 0x10, 0x9e, 0x17, 0xb0, 0xb1, 0x3c,
-	0xf1, 0x63, 0x47, 0x86, 0x2d, 0xc4, 0xd1, 0x27, 0x13, 0x34, 0x10, 0x64, 0xb3, 0x05, 0xf4, 0x9d,
-	0x33, 0xd1, 0x17, 0x99, 0x5a, 0x4c, 0xdf, 0x5b, 0x32, 0x6a, 0x62, 0xee, 0xe2, 0x1e, 0x2a, 0x6f,
-	0xdc, 0xdb, 0xb2, 0x06, 0xaa, 0x61, 0x9e, 0xd3, 0xe5, 0x6f, 0x25, 0x52, 0xfe, 0x7f, 0x3f, 0x8a,
-	0x83, 0xf0, 0x84, 0x81, 0x73, 0x24, 0xfe, 0x4d, 0xad, 0xa9, 0x82, 0x64, 0xaf, 0xcf, 0xc2, 0xa1,
-	0x6c, 0x56, 0xd0, 0xab, 0x81, 0x8c, 0xe3, 0xb7, 0x4c, 0xe6, 0xbc, 0x87, 0xde, 0x30, 0x86, 0xe9,
-	0x2d, 0x1e, 0xf8, 0x47, 0x79, 0x30, 0x8c, 0x99, 0x5c, 0xd6, 0xb5, 0xc4, 0x23, 0x4b, 0xc0, 0xc3,
-	0x20, 0xf6, 0x0f, 0xfc, 0x1e, 0x3f, 0xab, 0x97, 0x22, 0xd7, 0x54, 0xc5, 0x7b, 0xfd, 0xa7, 0x18,
-	0x67, 0x55, 0xec, 0x2a, 0x98, 0x4c, 0x4a, 0xfe, 0xaa, 0xa2, 0x05, 0xc9, 0x65, 0xd2, 0x48, 0xce,
-	0x69, 0x70, 0xa8, 0x08, 0xec, 0x0c, 0x82, 0x90, 0x1f, 0x02, 0xf9, 0xd0, 0x91, 0xa5, 0x33, 0xc0,
-	0x5f, 0x3a, 0x58, 0xc7, 0xd9, 0xc9, 0xc1, 0xb9, 0x64, 0xc1, 0x79, 0xa6, 0x10, 0xe7, 0x59, 0x23,
-	0xce, 0xea, 0x68, 0xe7, 0xac, 0xa3, 0x9d, 0xcf, 0x1f, 0x6d, 0xd9, 0x30, 0xda, 0x17, 0xe5, 0xc1,
-	0xc3, 0x64, 0xb0, 0xc0, 0xcd, 0x7c, 0xd2, 0xb9, 0x93, 0x99, 0xb4, 0xcc, 0x25, 0xba, 0x9e, 0xb2,
-	0x5a, 0x21, 0xb6, 0x5f, 0x04, 0xf2, 0x9c, 0x49, 0x23, 0x82, 0xb9, 0xd9, 0x99, 0xa2, 0x44, 0x98,
-	0x75, 0xa6, 0x2c, 0x0b, 0xda, 0x9d, 0x29, 0x22, 0x78, 0x9e, 0x33, 0x6b, 0x38, 0xc3, 0x28, 0xce,
-	0xac, 0xa3, 0x2f, 0xab, 0x8c, 0x33, 0x1b, 0xb0, 0xfb, 0xaf, 0x3a, 0xf3, 0x38, 0x3d, 0xcd, 0xa8,
-	0xf8, 0x24, 0x37, 0xc0, 0xdf, 0x25, 0x72, 0xcc, 0x4a, 0x88, 0x5f, 0xcb, 0x86, 0x78, 0x49, 0x0f,
-	0x09, 0x2a, 0x0b, 0xf3, 0x5f, 0x2f, 0xc9, 0x0a, 0x99, 0x36, 0x53, 0x4e, 0x71, 0xc0, 0xc2, 0xd9,
-	0xdd, 0x36, 0x91, 0xca, 0xf9, 0x13, 0xa9, 0x62, 0x9e, 0x48, 0x1a, 0x16, 0xd3, 0x4d, 0xa4, 0x97,
-	0x64, 0xe9, 0x2f, 0x33, 0x8b, 0xf4, 0x8e, 0x98, 0xc1, 0xee, 0x7f, 0xc9, 0xb3, 0x95, 0x59, 0x57,
-	0x17, 0xf4, 0xfc, 0x87, 0x43, 0xca, 0xf7, 0x82, 0xe3, 0x63, 0x06, 0xdc, 0x33, 0x84, 0xf4, 0xc4,
-	0xbf, 0x8a, 0x75, 0x20, 0xd9, 0xeb, 0xd3, 0x0b, 0xa4, 0xda, 0xed, 0xf7, 0x43, 0x2f, 0x8a, 0xbc,
-	0x30, 0x49, 0xcb, 0x52, 0x90, 0x13, 0xd8, 0x9e, 0xda, 0x39, 0xca, 0xcc, 0xbc, 0xd7, 0xe0, 0x3e,
-	0x96, 0xc1, 0x1d, 0x00, 0x48, 0xcf, 0xa6, 0x29, 0x03, 0x75, 0x72, 0x06, 0x5a, 0xc2, 0x03, 0xc5,
-	0xaf, 0x9b, 0xd1, 0x5f, 0x97, 0x84, 0xd7, 0xe4, 0x75, 0xa9, 0x8b, 0x72, 0x70, 0x77, 0xdf, 0x57,
-	0x76, 0x62, 0xa0, 0xeb, 0x69, 0x8b, 0xae, 0x8a, 0xf9, 0x10, 0x5d, 0x2d, 0xb4, 0x91, 0xeb, 0x64,
-	0x13, 0x9a, 0x15, 0x1c, 0x42, 0x31, 0x9a, 0x55, 0x9d, 0xb8, 0x83, 0x34, 0xe7, 0xa4, 0xa0, 0x14,
-	0x85, 0x37, 0x69, 0x67, 0x6e, 0x78, 0x93, 0xee, 0x91, 0xa3, 0x62, 0xe1, 0xed, 0x9b, 0x8e, 0x0c,
-	0x6f, 0x1a, 0x57, 0x9e, 0xd0, 0x9c, 0xc1, 0x83, 0x9f, 0x35, 0x50, 0x49, 0xb3, 0x66, 0x3a, 0x2a,
-	0xbd, 0x28, 0x77, 0x24, 0x74, 0x1e, 0xe9, 0xfd, 0xb0, 0x0f, 0xd3, 0xc0, 0x94, 0x81, 0xba, 0xa0,
-	0xe3, 0xef, 0x4a, 0x64, 0xbe, 0xdd, 0xe3, 0x7b, 0x43, 0xe7, 0x49, 0xb5, 0xdb, 0x93, 0x01, 0x19,
-	0x2a, 0xce, 0x42, 0x20, 0x3e, 0x56, 0xa0, 0x51, 0xdd, 0x88, 0x12, 0x22, 0x9e, 0x04, 0xae, 0x92,
-	0x66, 0x1c, 0xfa, 0x87, 0x87, 0x5e, 0xd8, 0x41, 0xc7, 0x61, 0x1a, 0x20, 0x85, 0x6f, 0x26, 0x45,
-	0x4d, 0x74, 0x96, 0x55, 0x03, 0x90, 0x82, 0x2d, 0x4f, 0xef, 0x20, 0x11, 0xfa, 0x42, 0x29, 0x6b,
-	0x5f, 0x28, 0x37, 0x08, 0x1d, 0x1e, 0x74, 0x80, 0x1f, 0x9d, 0x81, 0x1f, 0x29, 0x2b, 0xda, 0x85,
-	0xe1, 0x41, 0x5b, 0x34, 0x7c, 0xca, 0x8f, 0x18, 0xb4, 0xbf, 0x49, 0x0e, 0x1d, 0x89, 0x41, 0x29,
-	0x21, 0x41, 0x85, 0xd2, 0x99, 0x02, 0xca, 0xd2, 0x74, 0x50, 0xce, 0x98, 0xa0, 0xcc, 0xfd, 0xe4,
-	0x32, 0x0f, 0x68, 0xce, 0x3c, 0xa0, 0x64, 0x9b, 0x5a, 0x8e, 0x27, 0xdd, 0xf6, 0xb2, 0x12, 0xc7,
-	0xfd, 0xab, 0x72, 0x66, 0x49, 0xf4, 0x3b, 0x6d, 0xbb, 0xd4, 0xa9, 0xed, 0xb0, 0x4b, 0x6d, 0x23,
-	0x3d, 0xec, 0x52, 0xe7, 0x7a, 0x0a, 0x0a, 0x05, 0x45, 0x9e, 0x22, 0x48, 0xcd, 0xe4, 0xa9, 0x9a,
-	0x56, 0x4f, 0x30, 0x7b, 0x4a, 0xac, 0x3b, 0x33, 0x9e, 0x52, 0x4e, 0x45, 0x25, 0x98, 0x17, 0xed,
-	0x6a, 0xc3, 0x48, 0x73, 0x77, 0xb5, 0xc1, 0xf1, 0x00, 0x19, 0x8b, 0xbb, 0x1f, 0x26, 0xa7, 0xa2,
-	0x30, 0xc9, 0x4f, 0x53, 0x30, 0x41, 0xb8, 0xce, 0x4d, 0x35, 0x03, 0xe6, 0xad, 0x33, 0x00, 0x0f,
-	0x76, 0x9a, 0x19, 0x90, 0x1c, 0xaa, 0xd2, 0xe8, 0xaf, 0x75, 0x42, 0xd4, 0x4b, 0xb7, 0xa6, 0x75,
-	0xff, 0xe5, 0xf5, 0xda, 0xfd, 0xfd, 0x36, 0xa9, 0xf3, 0xb2, 0xc5, 0x1b, 0xdd, 0x61, 0xf7, 0xd0,
-	0x0b, 0xe9, 0x7b, 0x0e, 0x69, 0xe2, 0xdb, 0x66, 0xf4, 0xba, 0x21, 0xa1, 0x9a, 0x6e, 0xb3, 0xb5,
-	0x36, 0x8a, 0x15, 0x85, 0x4d, 0xee, 0x8d, 0x49, 0x7b, 0x89, 0x2e, 0x88, 0x08, 0xbc, 0x2e, 0x0b,
-	0x58, 0x5f, 0xf9, 0xed, 0x9f, 0xde, 0x2f, 0x2d, 0xb9, 0xf5, 0x9d, 0x87, 0xb7, 0x77, 0xa4, 0xec,
-	0xae, 0xb3, 0x49, 0xbf, 0xef, 0x90, 0xa5, 0xcc, 0x35, 0x2e, 0xba, 0x99, 0x7d, 0x99, 0xed, 0xa6,
-	0x5b, 0xeb, 0xc6, 0x54, 0xba, 0x60, 0xdb, 0xcd, 0x49, 0x7b, 0x99, 0xd2, 0x3e, 0xb4, 0x27, 0xd6,
-	0x45, 0xdc, 0xbc, 0x05, 0xda, 0x50, 0xcd, 0x8b, 0x38, 0x5e, 0xf8, 0xea, 0x95, 0x09, 0x2f, 0xe3,
-	0x9d, 0x30, 0x13, 0x5e, 0xe6, 0x5b, 0x5c, 0x80, 0xd7, 0x31, 0x6f, 0xd4, 0xf0, 0xda, 0xcd, 0xe0,
-	0xf5, 0x1d, 0x87, 0x2c, 0x68, 0xf7, 0xb2, 0xe8, 0x86, 0x09, 0x01, 0xd3, 0xad, 0xaf, 0xd6, 0x73,
-	0x53, 0x68, 0x82, 0x55, 0x5b, 0x93, 0x36, 0xa5, 0x8b, 0x7d, 0xde, 0xaa, 0xe1, 0x44, 0x37, 0x31,
-	0x4e, 0xcc, 0xae, 0x1f, 0x25, 0xb5, 0x6d, 0x74, 0xf1, 0xeb, 0x86, 0x8d, 0x35, 0x86, 0x2b, 0x32,
-	0xad, 0x9b, 0xd3, 0x29, 0x83, 0x81, 0x2f, 0x4c, 0xda, 0x2b, 0x74, 0x19, 0x68, 0x26, 0xbf, 0xe7,
-	0xd6, 0xe3, 0x93, 0x91, 0xc7, 0x8d, 0x5c, 0x71, 0x97, 0x98, 0x91, 0xe8, 0x72, 0x0f, 0x33, 0xf4,
-	0x03, 0x47, 0xd9, 0x6b, 0x53, 0xaf, 0xab, 0xd0, 0x6d, 0x3b, 0x91, 0x4c, 0x77, 0x62, 0x5a, 0x3b,
-	0x53, 0xeb, 0x83, 0xc5, 0x2f, 0x4e, 0xda, 0x6b, 0xf4, 0x5c, 0x42, 0x3e, 0x64, 0xb3, 0x40, 0x76,
-	0x99, 0xd2, 0x8c, 0xd1, 0x11, 0xc7, 0x36, 0x7b, 0xaf, 0xc7, 0x84, 0xad, 0xf5, 0xfa, 0x91, 0x09,
-	0x5b, 0xfb, 0x55, 0x21, 0xc0, 0x16, 0x28, 0x69, 0xc0, 0x76, 0xd7, 0x8c, 0xed, 0x4f, 0x9c, 0x64,
-	0x57, 0x0a, 0x21, 0x7b, 0xd3, 0x46, 0x3b, 0x23, 0xae, 0x5b, 0x53, 0x6a, 0x83, 0xad, 0x2f, 0x4d,
-	0xda, 0xe7, 0xe8, 0x59, 0x20, 0xaa, 0x01, 0xd3, 0x73, 0x9b, 0x06, 0x4c, 0x81, 0x09, 0xcb, 0xa6,
-	0x2b, 0x2a, 0x74, 0xab, 0x88, 0x87, 0xe8, 0x5e, 0x43, 0x6b, 0x7b, 0x5a, 0x75, 0x30, 0xf8, 0xe5,
-	0x49, 0x7b, 0x95, 0xae, 0xe8, 0xc4, 0x15, 0x85, 0x6e, 0x6e, 0xf1, 0xaa, 0x7b, 0x06, 0x59, 0x2c,
-	0x9a, 0x98, 0xc9, 0x3f, 0x77, 0xd2, 0x54, 0xae, 0xdd, 0x0d, 0xa0, 0xb7, 0x8a, 0xe9, 0x88, 0x2f,
-	0x22, 0xb4, 0x6e, 0x3f, 0x42, 0x0f, 0xb0, 0xfd, 0xee, 0xa4, 0x7d, 0x9e, 0xae, 0x65, 0x29, 0x2c,
-	0x4c, 0x14, 0x80, 0xaf, 0xd0, 0x65, 0x83, 0xf9, 0x11, 0xc7, 0xdb, 0x74, 0xb5, 0xc2, 0x84, 0x77,
-	0xce, 0x3d, 0x12, 0x13, 0xde, 0x79, 0x37, 0x36, 0x00, 0x6f, 0x9d, 0xcc, 0x2a, 0xde, 0xbb, 0x36,
-	0xbc, 0x7f, 0xe6, 0xc8, 0xc4, 0xab, 0xa3, 0xbd, 0x5d, 0x44, 0x52, 0x0d, 0xeb, 0x9d, 0xa9, 0xf5,
-	0xc1, 0xea, 0x57, 0x20, 0x58, 0x60, 0x5a, 0xab, 0x38, 0xaf, 0x6d, 0x1a, 0x71, 0x66, 0x76, 0x7f,
-	0xd5, 0x21, 0x75, 0xf5, 0x42, 0x01, 0xbd, 0x6a, 0xe3, 0x28, 0x3a, 0xbd, 0xde, 0xba, 0x56, 0xa4,
-	0x06, 0xc6, 0x5d, 0x9f, 0xb4, 0x17, 0x68, 0x03, 0x28, 0x2c, 0xb6, 0xe5, 0x45, 0x06, 0x75, 0x09,
-	0x33, 0x49, 0x48, 0x98, 0x21, 0xef, 0xf1, 0x74, 0x85, 0x4e, 0xe4, 0x9b, 0xd3, 0x95, 0xe9, 0x1a,
-	0x83, 0x39, 0x5d, 0x19, 0x8f, 0xf7, 0xbb, 0x1b, 0x90, 0xae, 0x80, 0x98, 0x70, 0x54, 0x80, 0x1b,
-	0xd5, 0xa0, 0xb5, 0xd4, 0xa8, 0x88, 0x63, 0xa3, 0x9e, 0x85, 0x37, 0x61, 0x63, 0x38, 0xd9, 0x6f,
-	0xc2, 0xc6, 0x74, 0xa4, 0x1e, 0xb0, 0x01, 0xba, 0xa9, 0xd8, 0xec, 0x6a, 0xd8, 0x7c, 0xc3, 0x21,
-	0x0d, 0x74, 0x54, 0x9e, 0x5e, 0xb3, 0x91, 0x44, 0xc3, 0xe5, 0x7a, 0xa1, 0x1e, 0xd8, 0xf2, 0xdc,
-	0xa4, 0xbd, 0x48, 0x9b, 0x40, 0x22, 0x15, 0x93, 0xc5, 0x4d, 0x15, 0x13, 0x4c, 0x19, 0x38, 0x87,
-	0x6f, 0xa5, 0x0c, 0x3a, 0xb0, 0x6a, 0xa7, 0x0c, 0x3e, 0x7a, 0x89, 0x29, 0x23, 0xd6, 0xdb, 0x2a,
-	0x65, 0x84, 0x04, 0x56, 0x38, 0x8b, 0xfa, 0x29, 0x5c, 0x9a, 0xc3, 0x04, 0xed, 0xb4, 0x66, 0x6b,
-	0x73, 0x1a, 0x55, 0x30, 0x6a, 0x73, 0xd2, 0x3e, 0x43, 0x97, 0x12, 0xd6, 0x8c, 0xa0, 0x9d, 0x1b,
-	0xd6, 0xa4, 0xf5, 0xc4, 0x30, 0x66, 0x42, 0xca, 0x1b, 0x3b, 0x40, 0x86, 0x13, 0xbd, 0x76, 0xde,
-	0x18, 0x01, 0x02, 0xde, 0xa8, 0x00, 0xed, 0x6a, 0x00, 0xb1, 0x55, 0x29, 0x3e, 0x72, 0x4a, 0xad,
-	0x84, 0xd0, 0xc1, 0xd9, 0x28, 0x56, 0x44, 0xab, 0x52, 0xa0, 0x0e, 0x02, 0x66, 0x69, 0x13, 0x01,
-	0xc3, 0x4c, 0xfa, 0x12, 0x21, 0xe9, 0x09, 0x39, 0x7a, 0xd9, 0x9a, 0x10, 0xd3, 0xa3, 0x47, 0xad,
-	0x2b, 0xf9, 0x4a, 0x60, 0xc5, 0xe5, 0x49, 0xbb, 0x41, 0x6b, 0x32, 0x57, 0x8e, 0x07, 0x62, 0xfd,
-	0xd1, 0x70, 0x2b, 0x3c, 0xf2, 0x8d, 0x07, 0x1e, 0x50, 0xb7, 0x81, 0x8e, 0x4f, 0x99, 0x27, 0x52,
-	0xf6, 0x44, 0x9e, 0x79, 0x22, 0x19, 0xce, 0x61, 0xb9, 0x57, 0x60, 0x22, 0xc9, 0xbc, 0xc7, 0x1a,
-	0xb9, 0x29, 0x35, 0x5a, 0x95, 0xa6, 0x44, 0x0c, 0x86, 0xf4, 0xc8, 0x8f, 0x09, 0x86, 0xcc, 0x09,
-	0x2c, 0x13, 0x0c, 0xd9, 0x53, 0x43, 0x00, 0x83, 0x4c, 0x61, 0x09, 0x0c, 0xbb, 0x08, 0x86, 0x77,
-	0x1d, 0x52, 0x53, 0xce, 0x04, 0xd1, 0x2b, 0xd6, 0x94, 0xa3, 0x42, 0x70, 0xb5, 0x40, 0x0b, 0x2c,
-	0xb8, 0x3a, 0x69, 0x37, 0x69, 0x5d, 0xa6, 0xa3, 0x64, 0xf8, 0xcd, 0xcd, 0x74, 0xf8, 0xd2, 0x06,
-	0xe5, 0x48, 0x09, 0xb5, 0x7a, 0x59, 0x3d, 0x5d, 0xd0, 0xba, 0x5a, 0xa0, 0x85, 0x6c, 0x00, 0x32,
-	0x70, 0x35, 0x61, 0x83, 0xcb, 0x6d, 0xe0, 0x02, 0x88, 0xab, 0x4d, 0x7c, 0x52, 0x82, 0xe6, 0xf8,
-	0x19, 0x9d, 0x04, 0x68, 0x6d, 0x14, 0x2b, 0x82, 0x31, 0xd7, 0x60, 0x7e, 0x00, 0x23, 0xb8, 0xae,
-	0xc0, 0xa4, 0x4e, 0x49, 0x62, 0x4f, 0xc4, 0x11, 0x51, 0x4e, 0x29, 0x50, 0xab, 0xc3, 0x8b, 0x10,
-	0x31, 0x1c, 0x75, 0x00, 0x44, 0x80, 0x17, 0x0a, 0x22, 0xbb, 0x18, 0x11, 0x16, 0xba, 0xd4, 0x53,
-	0x0c, 0xd4, 0xea, 0x74, 0x8c, 0xc6, 0xb5, 0x22, 0x35, 0x14, 0xba, 0x80, 0x1c, 0x0a, 0x12, 0x0b,
-	0x9b, 0x0a, 0x12, 0x32, 0xe5, 0xa1, 0x3d, 0x6b, 0x6a, 0x4d, 0x1f, 0x78, 0x5f, 0xb2, 0x75, 0xbd,
-	0x50, 0x0f, 0xa5, 0x3c, 0x20, 0x09, 0x94, 0xe0, 0x45, 0xca, 0x73, 0x79, 0xca, 0x03, 0x91, 0x5e,
-	0x7b, 0x48, 0x76, 0xe2, 0xf2, 0x6a, 0x0f, 0xfa, 0x3e, 0x5f, 0x5e, 0xed, 0x21, 0xb3, 0xb5, 0xa7,
-	0xd7, 0x1e, 0x8e, 0xa4, 0x82, 0x5a, 0x7b, 0x48, 0x84, 0x1c, 0x2a, 0xb4, 0x2b, 0x49, 0xad, 0x89,
-	0xa4, 0x18, 0x2a, 0xe3, 0xf6, 0x26, 0x40, 0x05, 0xec, 0x41, 0x50, 0xed, 0xea, 0x50, 0xa5, 0x65,
-	0x87, 0x14, 0x28, 0x6b, 0x2e, 0xc9, 0xc0, 0xf4, 0xdc, 0x14, 0x9a, 0xa6, 0xb2, 0x03, 0x86, 0x08,
-	0xca, 0x0e, 0x89, 0x10, 0x13, 0x4a, 0xee, 0x8a, 0x5a, 0x09, 0x85, 0x77, 0x82, 0xec, 0x84, 0xd2,
-	0xf6, 0x68, 0x30, 0xa1, 0x60, 0xab, 0x44, 0x25, 0x14, 0x88, 0xf4, 0xa5, 0x8b, 0xdc, 0x7a, 0xc9,
-	0x5b, 0xba, 0x68, 0xdb, 0x3a, 0x79, 0x4b, 0x17, 0x7d, 0x27, 0x47, 0x5f, 0xba, 0x80, 0x15, 0x68,
-	0xe9, 0x22, 0x65, 0x0a, 0x97, 0x72, 0x50, 0x32, 0xed, 0x97, 0xd9, 0xb9, 0x64, 0x46, 0x09, 0xb8,
-	0x84, 0x50, 0xda, 0xd5, 0x51, 0x4a, 0xd7, 0x2f, 0x09, 0x46, 0xd6, 0xf5, 0x8b, 0x8e, 0xd0, 0x46,
-	0xb1, 0xa2, 0x69, 0xfd, 0x82, 0xd0, 0x81, 0xf5, 0x8b, 0x94, 0xe1, 0xc5, 0x2f, 0x14, 0x89, 0xed,
-	0x19, 0x49, 0xad, 0x6b, 0xdb, 0x17, 0xbf, 0xb8, 0x22, 0x8c, 0x17, 0xbf, 0xa2, 0x3e, 0xab, 0x2e,
-	0x7e, 0x85, 0x44, 0xff, 0x5e, 0x82, 0x5a, 0x6f, 0xde, 0xf7, 0x12, 0xae, 0x21, 0xe7, 0x7d, 0x2f,
-	0x69, 0x85, 0x63, 0xfd, 0x7b, 0x49, 0x58, 0x80, 0xbe, 0x97, 0x40, 0xa4, 0xac, 0x7b, 0xed, 0xd8,
-	0x18, 0x6a, 0xfe, 0xf6, 0x75, 0xaf, 0x11, 0x1b, 0x99, 0xc3, 0x14, 0x6c, 0x76, 0x35, 0x6c, 0xd2,
-	0xef, 0x25, 0x89, 0x8c, 0x3d, 0x3f, 0x61, 0x5c, 0xae, 0x17, 0xea, 0x99, 0xbe, 0x97, 0x54, 0x4c,
-	0xe0, 0x7b, 0x09, 0x44, 0x77, 0x9d, 0xcd, 0xff, 0x9d, 0xfd, 0x5c, 0x69, 0xf4, 0xe0, 0xc1, 0x3c,
-	0xdf, 0x3f, 0xbc, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x25, 0xa1, 0xf2, 0xba, 0x96, 0x4f,
-	0x00, 0x00,
+	// 5492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0xcf, 0x6f, 0x24, 0x49,
+	0x56, 0xbf, 0xb2, 0xaa, 0x5c, 0x3f, 0x5e, 0xfd, 0xb2, 0xc3, 0x6e, 0xbb, 0x9c, 0xdd, 0x33, 0x53,
+	0x9b, 0x33, 0xdd, 0x76, 0xbb, 0xbb, 0xed, 0x19, 0xf7, 0xfc, 0xd8, 0xe9, 0xf9, 0x7e, 0xa5, 0xad,
+	0xed, 0x99, 0xdd, 0x35, 0xec, 0xb0, 0x23, 0xf7, 0x48, 0x48, 0x5c, 0x4c, 0x75, 0x55, 0xda, 0x4e,
+	0x6d, 0xb9, 0xaa, 0x36, 0x33, 0xab, 0x67, 0x8c, 0x40, 0x30, 0x48, 0x8c, 0x10, 0x20, 0x58, 0x79,
+	0x85, 0x04, 0x08, 0x09, 0x2d, 0x87, 0x95, 0x10, 0x1c, 0x46, 0x20, 0x2e, 0x08, 0x2e, 0xf0, 0x07,
+	0x80, 0xb4, 0x17, 0x2e, 0x5c, 0x60, 0xc5, 0x85, 0x1f, 0x07, 0x2e, 0xbb, 0x08, 0x0e, 0x28, 0x22,
+	0x5e, 0x64, 0x46, 0x44, 0x46, 0xfe, 0xf0, 0xb4, 0x66, 0xdb, 0xa0, 0x39, 0xd9, 0x19, 0xf1, 0x22,
+	0xeb, 0xc5, 0xe7, 0x7d, 0xe2, 0xbd, 0xc8, 0x88, 0x17, 0x01, 0xcd, 0xe1, 0xc4, 0xf5, 0xc3, 0xdd,
+	0xb9, 0x3f, 0x0b, 0x67, 0x64, 0xf9, 0x9b, 0x8b, 0xc7, 0x6e, 0x30, 0x3f, 0x75, 0x7d, 0x77, 0x97,
+	0x95, 0xdb, 0x37, 0x4e, 0x66, 0xb3, 0x93, 0x89, 0xbb, 0x37, 0x9c, 0x7b, 0x7b, 0xc3, 0xe9, 0x74,
+	0x16, 0x0e, 0x43, 0x6f, 0x36, 0x0d, 0xb8, 0xbc, 0xfd, 0x3c, 0xd6, 0xb2, 0xa7, 0xc7, 0x8b, 0xe3,
+	0xbd, 0x0f, 0xfc, 0xe1, 0x7c, 0xee, 0xfa, 0xa2, 0xfe, 0x2e, 0xfb, 0x33, 0xba, 0x77, 0xe2, 0x4e,
+	0xef, 0x05, 0x1f, 0x0c, 0x4f, 0x4e, 0x5c, 0x7f, 0x6f, 0x36, 0x67, 0x6f, 0x30, 0xbc, 0xed, 0x05,
+	0xfd, 0x6d, 0xa1, 0x77, 0xe6, 0x06, 0xe1, 0xf0, 0x6c, 0xce, 0x05, 0x9c, 0x7f, 0xb4, 0xa0, 0xfe,
+	0xce, 0x87, 0xee, 0x68, 0x11, 0xce, 0x7c, 0xf2, 0x02, 0x34, 0x5d, 0xfc, 0xff, 0xc8, 0x1b, 0xf7,
+	0xac, 0xbe, 0xb5, 0xdd, 0x38, 0x04, 0x51, 0x74, 0x30, 0x26, 0x2f, 0x42, 0x3b, 0x12, 0x98, 0x0e,
+	0xcf, 0xdc, 0x5e, 0x89, 0x89, 0xb4, 0x44, 0xe1, 0x4f, 0x0d, 0xcf, 0x5c, 0xb2, 0x0e, 0xd5, 0x20,
+	0x1c, 0x86, 0x8b, 0xa0, 0x57, 0x66, 0xb5, 0xf8, 0x44, 0xde, 0x82, 0xe6, 0xc8, 0x77, 0x87, 0xa1,
+	0x7b, 0x44, 0x95, 0xe8, 0x55, 0xfa, 0xd6, 0x76, 0x73, 0xdf, 0xde, 0xe5, 0x1a, 0xee, 0x0a, 0x0d,
+	0x77, 0xdf, 0x17, 0x1a, 0x1e, 0x02, 0x17, 0xa7, 0x05, 0xb4, 0xf1, 0x62, 0x3e, 0x8e, 0x1a, 0x2f,
+	0xe5, 0x37, 0xe6, 0xe2, 0xb4, 0xc0, 0xf9, 0x7f, 0x70, 0xed, 0x21, 0x7b, 0x95, 0xe8, 0xe9, 0xa1,
+	0xfb, 0xad, 0x85, 0x1b, 0x84, 0xc9, 0xfe, 0x58, 0xc9, 0xfe, 0x38, 0x6f, 0xc2, 0xba, 0xde, 0x3a,
+	0x98, 0xcf, 0xa6, 0x81, 0x9b, 0x8b, 0x97, 0xf3, 0xdf, 0x16, 0xf4, 0xde, 0x76, 0x83, 0x91, 0xef,
+	0x3d, 0x8e, 0x5a, 0x07, 0xe2, 0xc7, 0x5f, 0x80, 0x66, 0xe0, 0x0e, 0xfd, 0xd1, 0xe9, 0xd1, 0x07,
+	0x33, 0x3f, 0x6a, 0xcd, 0x8b, 0x7e, 0x7a, 0xe6, 0x8f, 0xc9, 0x26, 0xd4, 0x83, 0x99, 0x1f, 0x1e,
+	0x7d, 0xd3, 0x3d, 0x47, 0xa0, 0x6b, 0xf4, 0xf9, 0x27, 0xdd, 0x73, 0xd2, 0x83, 0x9a, 0xef, 0x3e,
+	0x71, 0xfd, 0xc0, 0x65, 0x20, 0xd7, 0x0f, 0xc5, 0x23, 0x45, 0x7f, 0x76, 0x7c, 0x1c, 0xb8, 0x21,
+	0x03, 0xb8, 0x7d, 0x88, 0x4f, 0x64, 0x0d, 0x96, 0x26, 0xde, 0x99, 0x17, 0x32, 0xe8, 0xda, 0x87,
+	0xfc, 0x41, 0xef, 0x41, 0xb5, 0x5f, 0xce, 0xb3, 0x78, 0xad, 0x5f, 0xd6, 0x11, 0x92, 0x2c, 0x5e,
+	0x67, 0xb5, 0xf8, 0xe4, 0xcc, 0x61, 0xd3, 0xd0, 0x7b, 0x04, 0x6f, 0x0d, 0x96, 0xc2, 0x59, 0x38,
+	0x9c, 0xb0, 0x8e, 0xb7, 0x0f, 0xf9, 0x03, 0xf9, 0xff, 0x10, 0xbd, 0xfa, 0x88, 0x76, 0xa2, 0xd4,
+	0x2f, 0x33, 0x43, 0xeb, 0xa3, 0x68, 0x37, 0x32, 0x46, 0xd4, 0x81, 0x47, 0x6e, 0xe8, 0x2c, 0xe0,
+	0xda, 0xbb, 0xb3, 0xb1, 0x77, 0x7c, 0xae, 0x5b, 0xfa, 0x33, 0xa5, 0x36, 0xa5, 0x88, 0xfe, 0xb3,
+	0x45, 0x29, 0xf2, 0x26, 0xac, 0xbf, 0xed, 0x4e, 0xdc, 0xd0, 0xc8, 0x0f, 0xb5, 0xa9, 0x66, 0x1b,
+	0xe7, 0x01, 0x6c, 0x24, 0x9a, 0xa6, 0xfd, 0xac, 0xde, 0xf6, 0x5f, 0x2d, 0x68, 0x1d, 0xba, 0xc1,
+	0x6c, 0xe1, 0x8f, 0xdc, 0xf7, 0xcf, 0xe7, 0x2e, 0xb9, 0x01, 0xe0, 0x07, 0x47, 0xe1, 0xf9, 0xdc,
+	0x8d, 0xf5, 0xac, 0xfb, 0x01, 0xad, 0x3b, 0x18, 0x93, 0x3e, 0xb4, 0x44, 0xad, 0x04, 0x0e, 0xf0,
+	0x7a, 0x06, 0x8d, 0x03, 0x6d, 0x21, 0x31, 0x1f, 0xfa, 0xc3, 0x33, 0x44, 0xa8, 0xc9, 0x45, 0xde,
+	0xa3, 0x45, 0xcf, 0xd0, 0x03, 0x0c, 0x61, 0x93, 0x8f, 0x61, 0xb9, 0xcf, 0x02, 0x68, 0xbd, 0x73,
+	0x56, 0x7e, 0xe7, 0x4a, 0x89, 0xce, 0x39, 0x0f, 0xc0, 0x36, 0xfd, 0x04, 0x1a, 0x24, 0x13, 0x5e,
+	0xea, 0x85, 0x6f, 0x88, 0x91, 0x22, 0x37, 0xbf, 0x52, 0xbe, 0x42, 0xed, 0x02, 0x77, 0x15, 0xe9,
+	0x0c, 0xe1, 0x7e, 0x42, 0x02, 0xd1, 0xf9, 0xc8, 0x82, 0xe7, 0x52, 0x3a, 0x99, 0xe9, 0x12, 0x7e,
+	0x02, 0x56, 0x7c, 0x14, 0xe7, 0xef, 0x8f, 0xfd, 0xc2, 0xf3, 0x49, 0xbf, 0xa0, 0xa0, 0xdf, 0xf5,
+	0xa5, 0x27, 0xea, 0x1f, 0x7e, 0x11, 0x36, 0xf9, 0x40, 0x35, 0xf1, 0xe0, 0xc7, 0x30, 0x04, 0x28,
+	0x4b, 0x4c, 0x0a, 0x14, 0x62, 0xc9, 0x03, 0xb0, 0xf9, 0x78, 0x37, 0x52, 0x44, 0x6f, 0xab, 0x98,
+	0xc7, 0x79, 0x0b, 0xae, 0x1b, 0xdb, 0xa6, 0xfc, 0xb0, 0xda, 0xf8, 0x93, 0x12, 0x74, 0x44, 0xbb,
+	0xaf, 0x78, 0x93, 0xd0, 0xf5, 0x11, 0x8d, 0x63, 0xf6, 0x20, 0x39, 0x36, 0x3f, 0xe0, 0xf5, 0x07,
+	0x63, 0xf2, 0x12, 0x74, 0x62, 0x09, 0xd9, 0xa3, 0x0a, 0x19, 0x86, 0xd9, 0x2d, 0xe8, 0xc6, 0x52,
+	0x32, 0x6a, 0x6d, 0x21, 0xc6, 0x5d, 0x47, 0xec, 0x79, 0x2b, 0x59, 0x93, 0x8a, 0xa5, 0xa7, 0x71,
+	0x29, 0xd5, 0xcb, 0xb8, 0x14, 0x0d, 0xb2, 0x9a, 0x66, 0xab, 0xef, 0x5a, 0x70, 0x5d, 0x75, 0x07,
+	0xbc, 0x37, 0xc2, 0x5a, 0x49, 0x74, 0xac, 0x62, 0xe8, 0x94, 0xb2, 0xd1, 0x51, 0xa7, 0x5c, 0xaa,
+	0x8e, 0x15, 0x4d, 0xc7, 0x2f, 0xc1, 0x0d, 0xb3, 0x8a, 0x48, 0x8a, 0x5c, 0x1b, 0x3b, 0x7f, 0x58,
+	0x82, 0xe7, 0xf5, 0x21, 0xcd, 0x2b, 0xaf, 0x94, 0xe7, 0xd2, 0x3b, 0x52, 0x15, 0xbe, 0x29, 0x83,
+	0xac, 0x38, 0xcf, 0x51, 0xcc, 0x91, 0x32, 0xcf, 0xd1, 0x60, 0x6e, 0x68, 0xa3, 0xe7, 0x57, 0x2d,
+	0x78, 0x21, 0x15, 0xa4, 0x4c, 0xcf, 0xf7, 0x0d, 0x20, 0xc2, 0x81, 0xa1, 0x6a, 0xb1, 0xeb, 0xeb,
+	0xa7, 0xbb, 0x3e, 0x34, 0xe3, 0x8a, 0xda, 0x96, 0xba, 0xbf, 0xbf, 0xb1, 0xe0, 0xba, 0xea, 0x7e,
+	0x54, 0x56, 0x5e, 0x95, 0x51, 0xad, 0x02, 0xba, 0x94, 0xe4, 0xad, 0xb9, 0x13, 0x85, 0x79, 0xfb,
+	0x25, 0xb8, 0xa1, 0x7a, 0x43, 0x8d, 0xb4, 0xc9, 0x37, 0x68, 0x84, 0x71, 0x06, 0xf0, 0x5c, 0xca,
+	0x1b, 0x52, 0x95, 0xd0, 0x5f, 0xf1, 0xbb, 0x25, 0xa8, 0xbe, 0xeb, 0x86, 0xbe, 0x37, 0x22, 0xd7,
+	0xa1, 0x71, 0xc6, 0xfe, 0x93, 0xdc, 0x3e, 0x2f, 0x38, 0x18, 0xd3, 0x11, 0x84, 0x95, 0x72, 0xdc,
+	0xe1, 0x45, 0x0c, 0xed, 0x2f, 0x40, 0x0b, 0x05, 0x94, 0xb0, 0xc3, 0xcb, 0xfe, 0x77, 0xba, 0xcf,
+	0xdf, 0xb2, 0x60, 0x95, 0xfb, 0x26, 0x8e, 0x90, 0xe4, 0x4d, 0x64, 0x2c, 0xac, 0x5c, 0x2c, 0x4a,
+	0x59, 0x58, 0x5c, 0xc6, 0x59, 0xde, 0x87, 0x35, 0x55, 0x21, 0xb4, 0x73, 0x96, 0xe9, 0x9c, 0x6f,
+	0x97, 0x60, 0x5d, 0x0c, 0x7d, 0xde, 0xee, 0x4a, 0xf9, 0x45, 0x45, 0x77, 0x9c, 0xd0, 0xa5, 0xd1,
+	0x0e, 0xe7, 0x73, 0x12, 0xd4, 0x9f, 0xce, 0x1b, 0x9e, 0xc2, 0x46, 0x02, 0x91, 0x4c, 0x27, 0xf8,
+	0x06, 0xe0, 0x8f, 0x4a, 0xce, 0xaf, 0x97, 0x74, 0x7e, 0x68, 0x16, 0xec, 0x10, 0x75, 0x76, 0x7f,
+	0x62, 0xc1, 0x2a, 0xf7, 0x13, 0x2a, 0x87, 0x9e, 0xd9, 0x60, 0xcb, 0xf6, 0x6a, 0xf7, 0x61, 0x4d,
+	0xd5, 0xb6, 0x08, 0xc1, 0xee, 0xc3, 0x1a, 0x77, 0x43, 0x1a, 0xbb, 0xb4, 0x46, 0x8a, 0x65, 0x9d,
+	0x57, 0xe1, 0x9a, 0xd6, 0xc8, 0xfc, 0x53, 0x6a, 0xab, 0xbf, 0xaa, 0x40, 0xf5, 0xbd, 0xd9, 0xc4,
+	0x1b, 0x9d, 0x53, 0xb9, 0x39, 0xfb, 0x4f, 0x52, 0x89, 0x17, 0x70, 0x04, 0xb1, 0x52, 0x46, 0x90,
+	0x17, 0x31, 0x04, 0xef, 0x01, 0x41, 0x81, 0x31, 0x23, 0x02, 0x5b, 0xbc, 0x42, 0x1c, 0x57, 0x78,
+	0xcd, 0xdb, 0x71, 0x05, 0xfd, 0x30, 0x47, 0xf1, 0xd1, 0x6c, 0x7a, 0xec, 0x9d, 0x20, 0xa8, 0x2d,
+	0x5e, 0xf8, 0x90, 0x95, 0xd1, 0x11, 0xc1, 0x1c, 0xd3, 0xcc, 0x47, 0x5c, 0xc5, 0x23, 0x79, 0x19,
+	0xd6, 0x86, 0x4f, 0x86, 0xde, 0x64, 0xf8, 0x78, 0xe2, 0x1e, 0x05, 0xe1, 0xd0, 0x0f, 0x63, 0x6f,
+	0xd5, 0x38, 0x24, 0x51, 0xdd, 0x23, 0x5a, 0xc5, 0x3c, 0xd3, 0x5d, 0x88, 0x4b, 0x8f, 0xdc, 0xe9,
+	0x98, 0xcb, 0x73, 0x0f, 0xb5, 0x1c, 0xd5, 0xbc, 0x33, 0x1d, 0x0b, 0x27, 0x28, 0x7b, 0xd0, 0xfa,
+	0xd3, 0x78, 0xd0, 0xc6, 0x53, 0x78, 0x50, 0xd0, 0x3e, 0x57, 0x6c, 0xa8, 0x4f, 0x86, 0xd3, 0x93,
+	0xc5, 0xf0, 0xc4, 0xed, 0x35, 0x79, 0x9d, 0x78, 0xa6, 0x16, 0x90, 0x30, 0x19, 0x9d, 0xba, 0xe3,
+	0xc5, 0xc4, 0xed, 0xb5, 0xb8, 0x05, 0x62, 0x44, 0xb0, 0x82, 0x52, 0xfe, 0xc4, 0x9f, 0x2d, 0xe6,
+	0xc2, 0x00, 0x6d, 0x4e, 0x79, 0x56, 0x86, 0xf8, 0x7f, 0x01, 0x5a, 0xfe, 0x6c, 0x11, 0xba, 0x42,
+	0xa4, 0xc3, 0x45, 0x58, 0x19, 0x17, 0x71, 0xfe, 0xac, 0x2c, 0x5c, 0x3a, 0x67, 0x91, 0xe4, 0x08,
+	0x65, 0xbe, 0x58, 0x05, 0xf9, 0x52, 0x2a, 0xcc, 0x97, 0x72, 0x36, 0x5f, 0x2a, 0xc5, 0xf8, 0xb2,
+	0x74, 0x49, 0xbe, 0x54, 0x53, 0xf8, 0x92, 0x19, 0xf7, 0x14, 0xab, 0xd5, 0x0b, 0x59, 0xad, 0x51,
+	0xd4, 0x6a, 0x90, 0x6f, 0xb5, 0x66, 0xd2, 0x6a, 0x51, 0xd8, 0x13, 0x46, 0x8b, 0x5d, 0x45, 0xaa,
+	0x0b, 0x70, 0xfe, 0xba, 0x14, 0x3b, 0x79, 0xd6, 0xce, 0x73, 0xaf, 0x5a, 0xdc, 0x8b, 0x95, 0xc7,
+	0xb8, 0x97, 0xe6, 0xbf, 0x30, 0xee, 0xe5, 0xf2, 0x91, 0xc7, 0x40, 0x03, 0x1f, 0x25, 0xaa, 0xf1,
+	0x58, 0x28, 0x1e, 0x13, 0x03, 0x58, 0x0d, 0x94, 0x1e, 0xf4, 0x92, 0x18, 0xe6, 0x45, 0x4a, 0x54,
+	0x2c, 0x33, 0x52, 0xa2, 0x25, 0x11, 0x02, 0x1a, 0x29, 0xff, 0xb9, 0x22, 0x22, 0xa5, 0x3a, 0x34,
+	0x3f, 0xf7, 0xf3, 0x69, 0xe3, 0xb6, 0x9e, 0x31, 0x6e, 0x1b, 0x85, 0xc6, 0x2d, 0x14, 0x1d, 0xb7,
+	0xcd, 0xfc, 0x71, 0xdb, 0x4a, 0x8c, 0x5b, 0xf2, 0x45, 0xe8, 0x8d, 0x26, 0xee, 0xd0, 0x3f, 0x32,
+	0xfc, 0x74, 0x9b, 0x8d, 0x9d, 0x75, 0x56, 0x3f, 0x48, 0xfc, 0xfe, 0x5d, 0x20, 0xbc, 0xa5, 0xa2,
+	0x45, 0x87, 0xb5, 0x59, 0x66, 0x35, 0x5f, 0x95, 0x54, 0x89, 0xa4, 0x15, 0x85, 0xba, 0x92, 0xf4,
+	0xa1, 0xea, 0x4d, 0x54, 0x9e, 0x15, 0xf1, 0x26, 0xd1, 0x74, 0x45, 0x77, 0x25, 0x5a, 0x2b, 0x65,
+	0x18, 0x3b, 0xaf, 0xc1, 0xba, 0xde, 0xca, 0xfc, 0x63, 0x6a, 0xb3, 0x7f, 0x5a, 0x82, 0xca, 0x21,
+	0x85, 0x61, 0x03, 0x6a, 0xfe, 0x62, 0x22, 0xad, 0xc3, 0x55, 0xe9, 0xe3, 0xc1, 0x98, 0x36, 0x67,
+	0x15, 0x12, 0xeb, 0xeb, 0xb4, 0x80, 0x71, 0xde, 0x86, 0xfa, 0xd8, 0x0b, 0x28, 0x9e, 0x63, 0x74,
+	0x51, 0xd1, 0x33, 0xd9, 0x82, 0xee, 0xd9, 0x6c, 0xea, 0xd1, 0x25, 0xf9, 0xb9, 0xeb, 0x7b, 0xb3,
+	0x71, 0x80, 0xce, 0xaa, 0x83, 0xc5, 0xef, 0xf1, 0x52, 0xfa, 0x92, 0x80, 0xfa, 0x35, 0x2f, 0x3c,
+	0x17, 0xb3, 0x44, 0xf1, 0x1c, 0x4f, 0x3f, 0x39, 0x17, 0x7b, 0x55, 0x79, 0xfa, 0xc9, 0xd8, 0x48,
+	0x6e, 0x42, 0x67, 0x34, 0x9b, 0x8e, 0x3d, 0x3a, 0xaa, 0xb8, 0x10, 0xe7, 0x74, 0x3b, 0x2a, 0x65,
+	0x62, 0xcf, 0x03, 0x84, 0xa7, 0xbe, 0x1b, 0x9c, 0xce, 0x26, 0xe3, 0x00, 0x09, 0x2d, 0x95, 0x10,
+	0x02, 0x95, 0xc5, 0xd4, 0x0b, 0x91, 0xce, 0xec, 0x7f, 0x72, 0x07, 0x56, 0x46, 0x14, 0xc3, 0xd1,
+	0x22, 0xf4, 0x9e, 0x50, 0x6b, 0x2f, 0xa6, 0x21, 0x63, 0x72, 0xfb, 0x70, 0x59, 0xaa, 0x78, 0x48,
+	0xcb, 0xe9, 0x58, 0xf5, 0xa6, 0xa7, 0xde, 0x63, 0x2f, 0x64, 0x1c, 0xae, 0x1f, 0x8a, 0x47, 0x7d,
+	0xce, 0xd4, 0x7a, 0x9a, 0x39, 0x53, 0xfb, 0x52, 0x73, 0x26, 0xc5, 0xf6, 0x1d, 0xcd, 0xa3, 0x29,
+	0xd3, 0xdf, 0xae, 0xf6, 0x61, 0xb0, 0x05, 0xdd, 0xb9, 0xef, 0x8e, 0xbd, 0x51, 0x78, 0x74, 0x3a,
+	0xf3, 0xbd, 0x9f, 0x9b, 0x4d, 0x7b, 0xcb, 0xdc, 0x7a, 0x58, 0xfc, 0x35, 0x5e, 0x4a, 0x2d, 0x34,
+	0x9d, 0x8d, 0x87, 0xe1, 0x90, 0x7a, 0xa1, 0xd0, 0xed, 0xad, 0x70, 0x0b, 0xf1, 0xb2, 0x47, 0xb4,
+	0x88, 0xec, 0xc1, 0xaa, 0xef, 0x8e, 0x66, 0x4f, 0x5c, 0xff, 0xfc, 0x48, 0xb2, 0x01, 0x61, 0x92,
+	0x44, 0x54, 0xbd, 0x1f, 0xdb, 0xe2, 0x75, 0xd8, 0x50, 0x70, 0x67, 0x23, 0x8e, 0xa3, 0xbf, 0xca,
+	0x94, 0xb8, 0x26, 0xa3, 0x4f, 0x6b, 0xb9, 0x09, 0xd6, 0xa1, 0x3a, 0x71, 0x9f, 0xb8, 0x93, 0xa0,
+	0xb7, 0xc6, 0x39, 0xcc, 0x9f, 0x9c, 0x7f, 0xa8, 0xc0, 0x0a, 0x2e, 0xfd, 0x2d, 0x26, 0xae, 0x34,
+	0x9e, 0x62, 0x66, 0x5b, 0x19, 0xcc, 0x2e, 0xe5, 0x33, 0xbb, 0x9c, 0xcb, 0xec, 0x4a, 0x0e, 0xb3,
+	0x97, 0x8a, 0x30, 0xbb, 0x9a, 0xcf, 0xec, 0x5a, 0x2a, 0xb3, 0xeb, 0x79, 0xcc, 0x6e, 0xe4, 0x33,
+	0x1b, 0x54, 0x66, 0x2b, 0xfc, 0x6a, 0x66, 0xf1, 0xab, 0x95, 0xcf, 0xaf, 0x76, 0x21, 0x7e, 0x75,
+	0x0a, 0xf3, 0xab, 0xfb, 0x69, 0xf8, 0xb5, 0x5c, 0x8c, 0x5f, 0x2b, 0x0a, 0xbf, 0xee, 0x01, 0x91,
+	0xe9, 0x85, 0x8e, 0x37, 0xcd, 0xa5, 0x3a, 0x9f, 0x54, 0x60, 0x8d, 0x4f, 0x05, 0x1e, 0xb3, 0x16,
+	0x57, 0x6a, 0xb2, 0x28, 0x69, 0xcd, 0xa7, 0x8a, 0xc6, 0x40, 0x50, 0xeb, 0x97, 0x53, 0x87, 0x0b,
+	0x9d, 0x1a, 0xe6, 0x0c, 0x17, 0x3a, 0x33, 0xcc, 0x1e, 0x2e, 0x38, 0x3d, 0x4c, 0x1d, 0x2e, 0xcd,
+	0x7e, 0x39, 0x7f, 0xb8, 0xb4, 0xfa, 0xe5, 0xbc, 0xe1, 0xd2, 0x66, 0x22, 0xa6, 0xe1, 0xd2, 0x61,
+	0x35, 0x19, 0xc3, 0xa5, 0xdb, 0x2f, 0xe7, 0x0d, 0x97, 0x65, 0x06, 0x85, 0x79, 0xb8, 0xac, 0xf4,
+	0xcb, 0xe9, 0xc3, 0x85, 0x68, 0xab, 0x11, 0x3f, 0x0b, 0xd7, 0x34, 0xc6, 0x64, 0x4e, 0x8d, 0x5f,
+	0x01, 0x66, 0x1a, 0x69, 0x62, 0xbc, 0x6e, 0x58, 0x3f, 0xa7, 0x64, 0x65, 0xc6, 0xa6, 0x93, 0xe2,
+	0xbf, 0xac, 0xc0, 0x0a, 0x2e, 0x33, 0x4b, 0x3e, 0xf2, 0xf3, 0x69, 0xc1, 0x67, 0x37, 0x2d, 0x30,
+	0xb8, 0xc0, 0x56, 0x21, 0x17, 0xd8, 0x2e, 0xec, 0x02, 0x3b, 0x9f, 0xc6, 0x05, 0x76, 0x8b, 0xb9,
+	0xc0, 0x65, 0xdd, 0x05, 0xca, 0xec, 0xc9, 0x73, 0x81, 0xf7, 0x80, 0xe0, 0x7e, 0x82, 0xec, 0xff,
+	0x14, 0x71, 0xc9, 0xf7, 0x38, 0xbb, 0xb0, 0xaa, 0x88, 0x9b, 0x5e, 0x2f, 0xcb, 0x7f, 0x54, 0x86,
+	0xa5, 0x01, 0x25, 0x39, 0xf5, 0x98, 0x8c, 0xed, 0xb1, 0x0a, 0x35, 0xf6, 0x7c, 0x30, 0x26, 0xcf,
+	0x01, 0xf0, 0x2a, 0x89, 0xc3, 0x0d, 0x56, 0x92, 0x4b, 0xe2, 0x9b, 0xd0, 0xf1, 0x17, 0xd3, 0xa9,
+	0x37, 0x3d, 0x39, 0x52, 0x96, 0x3e, 0xdb, 0x58, 0xfa, 0x88, 0x15, 0x52, 0xc3, 0xf1, 0x5f, 0x40,
+	0x21, 0x8c, 0xf1, 0xac, 0xec, 0x91, 0x71, 0x47, 0xa2, 0xfa, 0x34, 0x73, 0xc3, 0xda, 0xa7, 0x9f,
+	0x1b, 0xd6, 0xb5, 0xd8, 0xad, 0x6f, 0xe7, 0x34, 0x12, 0x3b, 0x63, 0x5a, 0xca, 0x0d, 0x24, 0x32,
+	0x7d, 0x7e, 0xd3, 0x12, 0x51, 0x91, 0x59, 0x42, 0xd8, 0x58, 0x45, 0xdd, 0xca, 0x42, 0x5d, 0x9f,
+	0x77, 0x29, 0x1a, 0x97, 0x73, 0x34, 0xae, 0x24, 0x76, 0xc1, 0x5e, 0x86, 0x55, 0x45, 0x1f, 0x24,
+	0x51, 0x3a, 0x43, 0x9c, 0x1f, 0x95, 0x62, 0xb7, 0xcb, 0x1a, 0x5d, 0xa9, 0x48, 0x2d, 0x2b, 0xce,
+	0x43, 0x75, 0x0a, 0xb5, 0x79, 0xb0, 0x4e, 0x01, 0x59, 0x8f, 0xd6, 0x49, 0x6a, 0xf3, 0x65, 0x1c,
+	0x8d, 0xda, 0x8a, 0x2d, 0xa0, 0x5f, 0xce, 0xb4, 0x45, 0xb3, 0x5f, 0xce, 0x66, 0x4f, 0x2b, 0x91,
+	0xb0, 0x35, 0x86, 0x75, 0x1d, 0xf9, 0xcc, 0x88, 0xf7, 0x2a, 0x34, 0x70, 0xa8, 0x45, 0x21, 0x6f,
+	0x23, 0x19, 0xf2, 0xb8, 0xe5, 0x39, 0x6c, 0x34, 0xe8, 0xfd, 0x91, 0x25, 0xdc, 0x96, 0xc2, 0xd1,
+	0xcf, 0xc6, 0x69, 0x28, 0x90, 0x55, 0x72, 0xe8, 0xbb, 0x64, 0xa2, 0xaf, 0xa2, 0x6a, 0x3e, 0x7d,
+	0x5f, 0x16, 0x5e, 0x53, 0xe5, 0xae, 0xda, 0x42, 0xe6, 0x8d, 0xf3, 0x0a, 0xac, 0xa9, 0x2d, 0x8c,
+	0x3f, 0xa2, 0x34, 0xf9, 0x61, 0x09, 0x6a, 0x5f, 0xf3, 0x82, 0x70, 0xe6, 0x9f, 0x53, 0x70, 0x4e,
+	0xf9, 0xbf, 0xb1, 0x36, 0x0d, 0x2c, 0x39, 0x18, 0x53, 0x77, 0x28, 0xaa, 0x25, 0xf4, 0x9a, 0x58,
+	0xc6, 0xf0, 0x5b, 0x83, 0x25, 0xf7, 0x89, 0x3b, 0x0d, 0x71, 0x78, 0xf3, 0x07, 0xb6, 0x0c, 0x36,
+	0x9b, 0x86, 0xb4, 0x5c, 0x2c, 0x5f, 0xf3, 0x47, 0x1a, 0x43, 0xa7, 0xb3, 0xd0, 0x3b, 0xf6, 0x46,
+	0x2c, 0x0f, 0x38, 0x46, 0xae, 0x23, 0x17, 0x1f, 0x8c, 0x9f, 0xa1, 0x9f, 0x95, 0xb1, 0xab, 0xab,
+	0x64, 0x92, 0xe2, 0x57, 0x43, 0x99, 0x5d, 0xbd, 0x08, 0xed, 0x28, 0x07, 0x8c, 0x41, 0x05, 0x98,
+	0x75, 0x80, 0x85, 0x2c, 0xc1, 0xec, 0x5f, 0x2c, 0xb1, 0x58, 0x8d, 0xf8, 0x0b, 0x03, 0xeb, 0x38,
+	0x5b, 0x19, 0x38, 0x97, 0x52, 0x70, 0x2e, 0xe7, 0xe2, 0x5c, 0x31, 0xe2, 0x2c, 0xf7, 0x76, 0x29,
+	0xb5, 0xb7, 0xd5, 0xec, 0xde, 0xd6, 0x0c, 0xbd, 0x7d, 0x1d, 0xae, 0x69, 0x9d, 0x45, 0x6e, 0x66,
+	0x93, 0xce, 0xb9, 0x28, 0xc7, 0x0b, 0xcb, 0xbc, 0xe9, 0x15, 0x5b, 0x9d, 0x57, 0xf5, 0xe7, 0x8e,
+	0x3c, 0x63, 0xd0, 0x70, 0x67, 0x6e, 0x36, 0x26, 0x5f, 0x94, 0x4f, 0x1a, 0x53, 0x2c, 0xc4, 0xa7,
+	0x1b, 0x93, 0x7b, 0xf0, 0x2c, 0x63, 0x36, 0xfb, 0xe5, 0x14, 0x63, 0xb6, 0xfa, 0xe5, 0x2c, 0x63,
+	0xb6, 0x31, 0xb3, 0x48, 0x36, 0xe6, 0x19, 0x6c, 0x1a, 0x6c, 0x92, 0xe9, 0xe0, 0x1f, 0x80, 0xe8,
+	0xb3, 0xe4, 0xe2, 0x37, 0x93, 0x2e, 0x5e, 0xd0, 0x43, 0x80, 0x4a, 0xdd, 0xfc, 0xaf, 0x95, 0xc4,
+	0x42, 0xac, 0x36, 0x52, 0xae, 0xb0, 0xc3, 0x52, 0xa3, 0x7b, 0xda, 0x40, 0xaa, 0x65, 0x0f, 0xa4,
+	0xba, 0x79, 0x20, 0x69, 0x58, 0x14, 0x1b, 0x48, 0x6f, 0x88, 0x15, 0xe6, 0xc4, 0x28, 0xd2, 0x1b,
+	0xaa, 0x0c, 0x76, 0xbe, 0x08, 0x1b, 0x89, 0x86, 0x29, 0x3f, 0xa9, 0xb5, 0xfc, 0x4f, 0x0b, 0x6a,
+	0x0f, 0x67, 0x67, 0x67, 0x14, 0xb8, 0xe7, 0x00, 0x46, 0xfc, 0x5f, 0x49, 0x3b, 0x2c, 0x39, 0x18,
+	0x93, 0x1b, 0xd0, 0x18, 0x8e, 0xc7, 0xbe, 0x1b, 0x04, 0xae, 0x1f, 0x85, 0x65, 0x51, 0x90, 0xe1,
+	0xd8, 0x9e, 0x59, 0x8e, 0x76, 0x62, 0xdc, 0x6b, 0x70, 0x9f, 0x09, 0xe7, 0x8e, 0x00, 0xc4, 0x79,
+	0xaf, 0x52, 0x47, 0xad, 0x8c, 0x8e, 0x96, 0xd4, 0x8e, 0xaa, 0x3f, 0x57, 0xd6, 0x7f, 0x2e, 0x72,
+	0xaf, 0xd1, 0xcf, 0xc5, 0x26, 0xca, 0xc0, 0xdd, 0xf9, 0x8e, 0xb4, 0xf7, 0x89, 0x4d, 0xaf, 0x9a,
+	0x77, 0x95, 0xd4, 0x47, 0xef, 0x9a, 0x42, 0x1b, 0x31, 0x4f, 0x36, 0xa1, 0x59, 0xef, 0x97, 0xd3,
+	0xd1, 0x6c, 0xe8, 0xc4, 0x9d, 0x40, 0x2f, 0x09, 0x4a, 0x9e, 0x7b, 0x13, 0x7a, 0x66, 0xba, 0x37,
+	0x61, 0x1e, 0xd1, 0x2b, 0xea, 0xde, 0x7e, 0xc3, 0x12, 0xee, 0x4d, 0xe3, 0xca, 0x67, 0x34, 0x66,
+	0xd4, 0xce, 0x57, 0x0c, 0x54, 0xd2, 0xb4, 0x29, 0x46, 0xa5, 0xd7, 0xc5, 0xc6, 0x97, 0xce, 0x23,
+	0xbd, 0x9d, 0x6a, 0xc3, 0xd8, 0x31, 0x25, 0xa0, 0xce, 0x69, 0xf8, 0x2b, 0x65, 0xa8, 0x0e, 0x46,
+	0x6c, 0x37, 0xf6, 0x3a, 0x34, 0x86, 0x23, 0xe1, 0x90, 0x71, 0x2f, 0x80, 0x17, 0xf0, 0x8f, 0x15,
+	0xac, 0x94, 0xb7, 0x7e, 0x79, 0x11, 0x0b, 0x02, 0x37, 0xa1, 0x13, 0xfa, 0x1e, 0x3d, 0x9b, 0x76,
+	0xa4, 0xa4, 0xda, 0xb5, 0xb1, 0x14, 0xbf, 0x99, 0x24, 0x31, 0xde, 0x58, 0xac, 0x1a, 0x60, 0x29,
+	0xea, 0xf2, 0xec, 0x92, 0x14, 0x95, 0x2f, 0x94, 0x9a, 0xf6, 0x85, 0x72, 0x07, 0xc8, 0xf4, 0xf8,
+	0x08, 0xf9, 0x71, 0x34, 0xf1, 0x02, 0x69, 0x46, 0xdb, 0x9d, 0x1e, 0x0f, 0x78, 0xc5, 0xd7, 0xbd,
+	0x20, 0x64, 0xc2, 0x2b, 0x6e, 0x30, 0x1a, 0x4e, 0x78, 0x90, 0xc3, 0x6d, 0x52, 0x3e, 0xc7, 0x5d,
+	0x8e, 0x2b, 0x70, 0x9b, 0xf4, 0xbf, 0xa2, 0xec, 0x47, 0x8e, 0x80, 0xe4, 0x3f, 0x64, 0xdc, 0xad,
+	0x02, 0xb8, 0x97, 0x8a, 0xe1, 0x5e, 0x36, 0xe1, 0x9e, 0xf9, 0x7d, 0x66, 0xee, 0xfd, 0xd2, 0x25,
+	0x7a, 0x5f, 0x4d, 0xe9, 0x7d, 0x94, 0x72, 0x22, 0x3a, 0x1f, 0xef, 0xdb, 0xa6, 0x52, 0xd2, 0xf9,
+	0x0f, 0x29, 0xd3, 0x92, 0xb7, 0xbb, 0x6a, 0x19, 0x27, 0xb1, 0xee, 0x98, 0x71, 0x92, 0x36, 0x9c,
+	0x30, 0xe3, 0x24, 0xd3, 0xac, 0xb8, 0x04, 0x91, 0x67, 0x56, 0x50, 0xc4, 0x4c, 0x66, 0x6d, 0xf6,
+	0xcb, 0x05, 0xcc, 0xca, 0x67, 0xb4, 0xba, 0x59, 0xe5, 0x5c, 0xce, 0x08, 0xf3, 0xbc, 0x0c, 0x15,
+	0xec, 0x69, 0x66, 0x86, 0x0a, 0x1a, 0x1e, 0x21, 0xa3, 0x1e, 0xfd, 0xef, 0x4a, 0xd1, 0xc7, 0xbe,
+	0x32, 0x22, 0xae, 0x92, 0x9b, 0x52, 0x70, 0x5d, 0x2a, 0x34, 0x5c, 0xaa, 0x97, 0x18, 0x2e, 0x35,
+	0xf3, 0x70, 0x61, 0x0b, 0xd7, 0x6c, 0xb1, 0x3a, 0xd9, 0xa4, 0xce, 0x28, 0x7b, 0x8d, 0x55, 0xbf,
+	0x63, 0x18, 0x66, 0x2a, 0xa2, 0x45, 0x86, 0x59, 0x94, 0x6f, 0xaa, 0x8d, 0x31, 0xad, 0x91, 0xc2,
+	0xef, 0x38, 0x81, 0x43, 0x27, 0x49, 0x66, 0xab, 0x3f, 0x28, 0x43, 0xf3, 0x80, 0xef, 0x0c, 0xb0,
+	0x84, 0x8c, 0x5b, 0xd0, 0xc5, 0x8d, 0x82, 0x23, 0x75, 0x09, 0xbd, 0xed, 0xc5, 0x52, 0x07, 0x63,
+	0xb2, 0x03, 0x2b, 0x8a, 0x9c, 0x64, 0xfb, 0xae, 0x24, 0x89, 0x29, 0x4a, 0xab, 0xf8, 0x75, 0xa0,
+	0x1c, 0xed, 0xe2, 0x2c, 0x58, 0xe6, 0x55, 0x87, 0xf1, 0x01, 0xaf, 0x2d, 0xe8, 0xa2, 0xb8, 0xb6,
+	0x8b, 0xdd, 0xe1, 0xc5, 0x8f, 0xb0, 0x94, 0xbe, 0x37, 0x1c, 0xfa, 0x27, 0x6e, 0xa8, 0xbe, 0x97,
+	0x93, 0x62, 0x99, 0x57, 0x49, 0xef, 0xa5, 0x8b, 0x7f, 0xdf, 0x5a, 0x0c, 0x27, 0x47, 0x93, 0xe1,
+	0x63, 0x77, 0x82, 0xac, 0x00, 0x56, 0xf4, 0x75, 0x5a, 0xa2, 0xac, 0xa2, 0xd5, 0xb4, 0x55, 0xb4,
+	0x67, 0x96, 0x80, 0x4a, 0xbf, 0x22, 0x7b, 0xdc, 0x53, 0x4b, 0x76, 0x12, 0x8c, 0x30, 0x9a, 0xc1,
+	0xba, 0x94, 0x19, 0x4a, 0xc5, 0xcd, 0x50, 0xbe, 0x8c, 0x19, 0x2a, 0xc5, 0xcc, 0xb0, 0x94, 0x69,
+	0x86, 0xaa, 0x6a, 0x06, 0xe7, 0x21, 0x6c, 0x1a, 0xb0, 0x40, 0xa2, 0x17, 0xe4, 0xae, 0xf3, 0xfd,
+	0x12, 0x5c, 0x17, 0x1e, 0x55, 0x7a, 0xcf, 0x95, 0x0a, 0x65, 0x86, 0xbe, 0xf0, 0x80, 0x56, 0x64,
+	0x1c, 0xf2, 0xd8, 0x56, 0x94, 0x00, 0xfc, 0x1b, 0x23, 0x49, 0x80, 0x14, 0xbb, 0xf2, 0xa0, 0x98,
+	0xb0, 0xab, 0xf3, 0x0b, 0x70, 0xc3, 0x0c, 0x6a, 0x66, 0xac, 0xfa, 0x2a, 0x2c, 0x2b, 0xfa, 0xc7,
+	0x11, 0xeb, 0xb9, 0x64, 0xc4, 0x92, 0x8d, 0xde, 0x91, 0x7a, 0x47, 0x63, 0xd7, 0x9f, 0x96, 0xa0,
+	0xc7, 0x3d, 0xad, 0x61, 0x98, 0x7c, 0xee, 0xd5, 0x8c, 0x5e, 0x8d, 0x0e, 0x27, 0x03, 0x66, 0x97,
+	0x1c, 0x4e, 0x0f, 0x61, 0x93, 0x07, 0x1e, 0xd3, 0x58, 0x32, 0xbe, 0x24, 0xc9, 0x63, 0xe7, 0x6d,
+	0xb0, 0x4d, 0x2f, 0xc9, 0x52, 0xc5, 0xf0, 0x96, 0x1f, 0x94, 0xa0, 0xf6, 0xc8, 0x9b, 0xb8, 0xd3,
+	0x11, 0xfb, 0x0a, 0x0b, 0xf8, 0xbf, 0xd2, 0x67, 0x1f, 0x96, 0x1c, 0x8c, 0xe5, 0x1c, 0xd7, 0x92,
+	0x9a, 0xe3, 0x4a, 0x6b, 0xf8, 0xc7, 0x5a, 0xfc, 0x05, 0xca, 0x1e, 0x29, 0x94, 0x67, 0xc3, 0x70,
+	0x74, 0xea, 0xfa, 0x62, 0xe7, 0x35, 0x7a, 0x26, 0x6f, 0x02, 0x68, 0x79, 0xec, 0xd9, 0x2e, 0xbe,
+	0x11, 0x44, 0x29, 0xb2, 0xaf, 0x41, 0x5d, 0x49, 0x68, 0xcf, 0x6e, 0x58, 0x73, 0xcd, 0x67, 0x22,
+	0x6a, 0x4f, 0x13, 0x92, 0xea, 0x97, 0x0a, 0x49, 0x7f, 0x1f, 0x6d, 0x01, 0x20, 0xd8, 0xc2, 0xda,
+	0x12, 0xa8, 0x56, 0x2a, 0xa8, 0xa5, 0x74, 0x50, 0xcb, 0x99, 0xa0, 0x56, 0x3e, 0x2d, 0xa8, 0x4b,
+	0x85, 0x41, 0x8d, 0x17, 0xa4, 0xa2, 0x9e, 0xc5, 0x1f, 0xf5, 0x19, 0x74, 0x72, 0xfe, 0xdd, 0x8a,
+	0x67, 0xe9, 0xd8, 0xf4, 0xaa, 0x2d, 0x48, 0x49, 0xea, 0xe3, 0x82, 0x94, 0x71, 0x34, 0xd4, 0xd4,
+	0xf4, 0xf9, 0x75, 0xa8, 0xd2, 0xc9, 0xe2, 0x13, 0x17, 0xe7, 0xb9, 0xf8, 0x24, 0xaf, 0x35, 0xc5,
+	0xfd, 0xcd, 0x5b, 0x6b, 0x12, 0x2a, 0x64, 0xae, 0x35, 0x09, 0xe4, 0x85, 0xc2, 0xd4, 0xbb, 0xff,
+	0x30, 0x5a, 0x6b, 0xd2, 0x18, 0xf7, 0x7f, 0x7d, 0x94, 0xc7, 0xcb, 0x5a, 0x97, 0x24, 0x64, 0xb4,
+	0xac, 0xa5, 0xb3, 0x51, 0x6f, 0xa7, 0x32, 0x21, 0x5e, 0xd6, 0x4a, 0x58, 0x35, 0xa7, 0xe1, 0xf7,
+	0x4a, 0x50, 0x7f, 0xdf, 0x3d, 0x9b, 0x4f, 0x86, 0x21, 0x8b, 0x4a, 0x21, 0xfe, 0x1f, 0x6b, 0x07,
+	0xa2, 0xe8, 0x40, 0xdb, 0x95, 0x2e, 0x69, 0x9f, 0x71, 0x72, 0x9e, 0x7f, 0x59, 0xcb, 0xf3, 0x5f,
+	0x87, 0xea, 0xf1, 0xcc, 0x3f, 0x1b, 0x8a, 0x2d, 0x0e, 0x7c, 0x62, 0x9c, 0xf3, 0xc2, 0x89, 0x08,
+	0x94, 0xfc, 0x41, 0x5e, 0x4d, 0xac, 0x66, 0xae, 0xc0, 0xff, 0x18, 0xbd, 0xe7, 0xef, 0x58, 0xc2,
+	0xc7, 0x08, 0xb8, 0x0a, 0x9d, 0x04, 0x91, 0x41, 0x29, 0xa5, 0x82, 0x52, 0x36, 0x83, 0x52, 0x49,
+	0x01, 0x65, 0x49, 0x01, 0x25, 0xbe, 0x84, 0x29, 0xd6, 0x2c, 0xbe, 0xea, 0x26, 0xd3, 0xa0, 0xce,
+	0x8f, 0xa4, 0x4b, 0x98, 0x44, 0xeb, 0xab, 0x76, 0x09, 0x93, 0xdc, 0x03, 0xbc, 0x9d, 0x20, 0x8d,
+	0x92, 0xb5, 0x7e, 0x39, 0x15, 0x7d, 0x3e, 0x61, 0x8e, 0x9e, 0xe5, 0x0b, 0x98, 0xa4, 0x9e, 0xe7,
+	0x5d, 0xc0, 0x14, 0x29, 0x93, 0x79, 0x01, 0x53, 0x64, 0x88, 0x48, 0x79, 0xea, 0x0e, 0x7f, 0xc9,
+	0x12, 0x5e, 0x41, 0xa7, 0x50, 0xee, 0xc0, 0x8b, 0xa9, 0x52, 0x32, 0x53, 0xa5, 0x9c, 0x42, 0x95,
+	0x4a, 0x82, 0x2a, 0xba, 0x06, 0x45, 0xa9, 0x12, 0x5d, 0xc6, 0x64, 0xe2, 0x89, 0xda, 0x54, 0xb3,
+	0x51, 0x7c, 0x19, 0x53, 0x12, 0xe8, 0xdc, 0xb6, 0x1f, 0x95, 0x60, 0xfd, 0x3d, 0xdf, 0x7d, 0xe2,
+	0xb9, 0x1f, 0x5c, 0x1a, 0xb5, 0x67, 0xee, 0xae, 0xee, 0x01, 0x51, 0x36, 0x70, 0xf9, 0xb1, 0x68,
+	0xbe, 0x5a, 0xb5, 0x22, 0xd7, 0x44, 0x87, 0xa3, 0x7d, 0x37, 0x58, 0x9c, 0x45, 0x51, 0x9b, 0x3f,
+	0x39, 0x07, 0xb0, 0x91, 0x80, 0x40, 0x22, 0x2a, 0xd3, 0xc8, 0x4a, 0xd1, 0x48, 0xdd, 0xd9, 0x73,
+	0xfe, 0xb6, 0x02, 0xd5, 0x6f, 0x2c, 0xc2, 0xc7, 0xb3, 0x0f, 0x29, 0x3a, 0x33, 0xf6, 0x9f, 0xe4,
+	0xb7, 0x78, 0x01, 0x4f, 0xb9, 0xf7, 0xc6, 0xee, 0xd9, 0x7c, 0x16, 0xba, 0xd3, 0xd1, 0xb9, 0x34,
+	0xc2, 0x3b, 0x52, 0x31, 0x1d, 0xe8, 0xf2, 0xd6, 0x73, 0x39, 0x75, 0xeb, 0xb9, 0x92, 0xbd, 0xf5,
+	0xbc, 0x94, 0xdc, 0x7a, 0xa6, 0xf6, 0xe1, 0x08, 0xb9, 0x3e, 0xc2, 0x1a, 0x3d, 0xcb, 0x9b, 0x51,
+	0xe2, 0x10, 0x43, 0x5c, 0x10, 0x63, 0x52, 0x4f, 0xc1, 0xa4, 0xa1, 0x5a, 0x29, 0x3e, 0x93, 0x0e,
+	0xca, 0x99, 0x74, 0x1b, 0xea, 0xc3, 0x90, 0xd2, 0x29, 0x0c, 0x58, 0x3a, 0x6e, 0xfb, 0x30, 0x7a,
+	0xa6, 0x61, 0x75, 0x32, 0x0c, 0xc2, 0x23, 0xd7, 0xf7, 0x67, 0x3e, 0x1e, 0x58, 0x68, 0xd0, 0x92,
+	0x77, 0x68, 0x81, 0x69, 0xe7, 0xbe, 0x6d, 0xdc, 0xb9, 0xff, 0x0a, 0xac, 0x4c, 0xdd, 0x0f, 0xc3,
+	0x23, 0x7c, 0x31, 0x8f, 0x4c, 0x9d, 0xdc, 0xc8, 0xd4, 0xa5, 0x8d, 0x06, 0xbc, 0x8d, 0xe9, 0xb3,
+	0xa2, 0xfb, 0x34, 0x81, 0x71, 0xf9, 0x52, 0x81, 0xf1, 0xdf, 0xa4, 0x39, 0x34, 0x67, 0xd6, 0x95,
+	0x3b, 0xd0, 0x1a, 0xd3, 0x1c, 0xb7, 0x17, 0x22, 0x9a, 0xcb, 0xec, 0xad, 0xa9, 0x49, 0x2b, 0x69,
+	0x17, 0xf7, 0x49, 0x67, 0x4f, 0xe3, 0xee, 0xe6, 0xad, 0xec, 0xa3, 0x06, 0x99, 0x2b, 0xfb, 0xfc,
+	0x6d, 0x87, 0xa8, 0x2d, 0x0d, 0x18, 0xf7, 0x61, 0xed, 0xd0, 0x0d, 0xfd, 0x73, 0x1d, 0x56, 0x6d,
+	0xe4, 0x2a, 0x5d, 0xa2, 0x2b, 0xca, 0x5a, 0xa3, 0x78, 0x45, 0x39, 0xbd, 0x15, 0x3d, 0x12, 0xe8,
+	0x05, 0xa3, 0xa1, 0x3f, 0xbe, 0xd4, 0x8f, 0xbd, 0x0e, 0x1b, 0x89, 0x66, 0x05, 0x7e, 0x6e, 0xff,
+	0xe3, 0x2f, 0x43, 0x8b, 0xa5, 0x0d, 0xbe, 0x3b, 0x9c, 0x0e, 0x4f, 0x5c, 0x9f, 0x7c, 0xdb, 0x82,
+	0x8e, 0x7a, 0x93, 0x24, 0xd9, 0x32, 0x6c, 0x68, 0x9b, 0x6e, 0xaa, 0xb4, 0xb7, 0xf3, 0x05, 0xb9,
+	0x4e, 0xce, 0x9d, 0x8b, 0xc1, 0x0a, 0xe9, 0x72, 0xe6, 0xf7, 0x45, 0x02, 0xe9, 0x2f, 0x7f, 0xff,
+	0x07, 0xdf, 0x29, 0xad, 0x3c, 0xb0, 0x76, 0x9c, 0xd6, 0xde, 0x93, 0x57, 0xf6, 0x44, 0x31, 0xf9,
+	0x3d, 0x0b, 0x56, 0x12, 0x57, 0x34, 0x92, 0x9d, 0xe4, 0x8f, 0xa5, 0xdd, 0x62, 0x69, 0xdf, 0x29,
+	0x24, 0x8b, 0xba, 0xdd, 0xbd, 0x18, 0xac, 0x11, 0x32, 0xc6, 0xfa, 0x48, 0xbb, 0x80, 0xa9, 0xd7,
+	0x25, 0x6d, 0x59, 0xb7, 0x80, 0xe1, 0xa5, 0x5e, 0xab, 0x68, 0xc2, 0xcb, 0x78, 0xdf, 0xa3, 0xbd,
+	0x9d, 0x2f, 0xa8, 0xe0, 0x75, 0xc6, 0x2a, 0x93, 0x78, 0xed, 0xab, 0x78, 0xfd, 0xb6, 0x05, 0x5d,
+	0xed, 0xce, 0x45, 0xb2, 0x6d, 0x42, 0xc0, 0x74, 0xa3, 0xa3, 0x7d, 0xbb, 0x80, 0x24, 0x6a, 0x75,
+	0xef, 0x62, 0x40, 0xc8, 0xf2, 0x98, 0xd5, 0x6a, 0x38, 0x91, 0x07, 0xd6, 0xce, 0x8e, 0x06, 0xd5,
+	0xf7, 0xa2, 0xdc, 0x72, 0xe5, 0x52, 0xc7, 0x3b, 0x69, 0xac, 0x31, 0x5c, 0x7f, 0x67, 0xdf, 0x2d,
+	0x26, 0x8c, 0x0a, 0xbe, 0x76, 0x31, 0x58, 0x27, 0x6b, 0x48, 0x33, 0x11, 0xd4, 0xfa, 0x74, 0x55,
+	0x90, 0x29, 0xb9, 0x4e, 0xb9, 0xb6, 0x42, 0x95, 0x54, 0xee, 0xee, 0x23, 0x9f, 0x58, 0xd2, 0xc1,
+	0x1d, 0xe9, 0xbd, 0x01, 0xd9, 0x4d, 0x27, 0x92, 0xe9, 0xbe, 0x3b, 0x7b, 0xaf, 0xb0, 0x3c, 0x6a,
+	0xfc, 0xfa, 0xc5, 0x60, 0x93, 0x6c, 0x44, 0xe4, 0x53, 0x74, 0xe6, 0xc8, 0xae, 0x11, 0x92, 0xd0,
+	0x98, 0x63, 0x9b, 0xbc, 0xb3, 0xcf, 0x84, 0x6d, 0xea, 0xd5, 0x82, 0xf6, 0xdd, 0x62, 0xc2, 0x0a,
+	0xb6, 0x48, 0x49, 0x33, 0xb6, 0xfb, 0x06, 0x6c, 0xff, 0xd8, 0x8a, 0x4e, 0x85, 0x28, 0xc8, 0xde,
+	0x4d, 0xa3, 0x9d, 0x11, 0xd7, 0x7b, 0x05, 0xa5, 0x51, 0xd7, 0x37, 0x2e, 0x06, 0x1b, 0xe4, 0x1a,
+	0x12, 0xd5, 0x80, 0xe9, 0x06, 0x65, 0xab, 0x09, 0xd6, 0x4f, 0xa2, 0xa5, 0x3a, 0xed, 0x6a, 0xc1,
+	0x7b, 0x79, 0x3c, 0x54, 0xee, 0x2c, 0xb3, 0x77, 0x8b, 0x8a, 0xa3, 0xc2, 0x6f, 0x5e, 0x0c, 0x7a,
+	0x64, 0x5d, 0x27, 0x2e, 0x4f, 0x34, 0x67, 0x1a, 0xf7, 0x28, 0x75, 0x57, 0x15, 0x8d, 0x79, 0x2d,
+	0xf9, 0x0b, 0x69, 0x1a, 0xa0, 0xbe, 0x3d, 0x20, 0x2f, 0xe7, 0xd3, 0x51, 0xbd, 0x64, 0xcc, 0x7e,
+	0xe5, 0x12, 0x2d, 0x50, 0xf7, 0x07, 0x17, 0x83, 0xeb, 0x64, 0x33, 0x49, 0x61, 0xae, 0x1f, 0x07,
+	0x7c, 0x9d, 0xac, 0x19, 0x74, 0xe7, 0x78, 0x9b, 0xae, 0x4d, 0x33, 0xe1, 0x9d, 0x71, 0x47, 0x9c,
+	0xbd, 0x5b, 0x54, 0x5c, 0xc1, 0x5b, 0x27, 0xb3, 0x86, 0xf7, 0xbe, 0x11, 0xef, 0x3f, 0xb7, 0xc4,
+	0x52, 0x91, 0x8e, 0xf6, 0x6e, 0x1e, 0x49, 0x35, 0xac, 0xf7, 0x0a, 0xcb, 0xa3, 0xd6, 0x6f, 0xa1,
+	0xb3, 0x50, 0x69, 0x2d, 0xe3, 0xbc, 0x49, 0x89, 0x6d, 0x86, 0xfa, 0x63, 0x0b, 0x5a, 0xf2, 0x65,
+	0x61, 0xe4, 0x66, 0x1a, 0x47, 0x95, 0x9b, 0xa9, 0xec, 0x5b, 0x79, 0x62, 0xa8, 0xdc, 0xd6, 0xc5,
+	0xa0, 0x4b, 0xda, 0x48, 0x61, 0x7e, 0xc6, 0x8f, 0x47, 0x50, 0xca, 0x5c, 0xa0, 0x2a, 0xf1, 0x42,
+	0x1a, 0x41, 0xbb, 0xda, 0x6d, 0x5b, 0xe6, 0x70, 0x65, 0xba, 0xa2, 0xcc, 0xbe, 0x5d, 0x40, 0x12,
+	0x35, 0xda, 0xc6, 0x70, 0x85, 0xc4, 0xe4, 0x3f, 0xcf, 0x71, 0x6a, 0x93, 0x66, 0xac, 0x11, 0xc7,
+	0x46, 0xbe, 0xe7, 0xca, 0x84, 0x8d, 0xe1, 0xd6, 0x2e, 0xfb, 0x56, 0x9e, 0x98, 0x82, 0x0d, 0xd2,
+	0x4d, 0xc3, 0x66, 0x5f, 0xc6, 0xe6, 0xd7, 0x2d, 0x68, 0x2b, 0xd7, 0x60, 0x91, 0x5b, 0x69, 0x24,
+	0xd1, 0x70, 0xd9, 0xca, 0x95, 0x43, 0x5d, 0x6e, 0x5f, 0x0c, 0x96, 0x49, 0x07, 0x49, 0x24, 0x63,
+	0xb2, 0x4c, 0xb9, 0x93, 0x80, 0x45, 0xbe, 0x68, 0x27, 0x9d, 0x32, 0xca, 0x15, 0x2d, 0xf6, 0xad,
+	0x3c, 0x31, 0x13, 0x65, 0xf8, 0xfa, 0x80, 0x46, 0x19, 0x5e, 0x48, 0x67, 0x38, 0xcb, 0xfa, 0xbd,
+	0x33, 0x24, 0x83, 0x09, 0xda, 0xa5, 0x1c, 0xf6, 0x4e, 0x11, 0x51, 0x54, 0x6a, 0xe7, 0x62, 0xb0,
+	0x4a, 0x56, 0x22, 0xd6, 0xcc, 0xb1, 0x9e, 0x29, 0xd6, 0x21, 0xad, 0x48, 0x2b, 0xcf, 0xe5, 0x00,
+	0xc9, 0x77, 0x87, 0xa4, 0xf3, 0x26, 0x17, 0x20, 0xd3, 0x15, 0x24, 0x2a, 0x6f, 0x34, 0x80, 0xf6,
+	0x65, 0x80, 0xe8, 0xac, 0x54, 0xbd, 0x59, 0x84, 0xa4, 0x12, 0x42, 0x07, 0x67, 0x3b, 0x5f, 0x50,
+	0x99, 0x95, 0x22, 0x75, 0x14, 0x60, 0xe8, 0xac, 0x74, 0x47, 0xc5, 0xe6, 0xe7, 0x01, 0xe2, 0xe3,
+	0xf6, 0xe4, 0xc5, 0xd4, 0x80, 0x18, 0xef, 0x3b, 0xdb, 0x2f, 0x65, 0x0b, 0xa1, 0x16, 0x2f, 0x5e,
+	0x0c, 0xda, 0xa4, 0x29, 0x62, 0xe5, 0x62, 0xc2, 0xe7, 0x1f, 0x6d, 0xca, 0x99, 0x3a, 0xf3, 0x7c,
+	0xf4, 0xf7, 0x3e, 0x66, 0x03, 0x49, 0x3a, 0x8b, 0x6d, 0x1e, 0x48, 0xc9, 0xe3, 0xfd, 0xf6, 0x56,
+	0xae, 0x1c, 0xea, 0xf1, 0x12, 0x0e, 0x24, 0x11, 0xf7, 0x68, 0x25, 0x53, 0xa5, 0x49, 0x1a, 0x42,
+	0x0f, 0x06, 0x43, 0x7c, 0xe4, 0xd6, 0x04, 0x43, 0xe2, 0x38, 0xb7, 0xfd, 0x52, 0xb6, 0x90, 0x02,
+	0x83, 0x08, 0x61, 0x32, 0x0c, 0xfb, 0x31, 0x0c, 0x1f, 0x59, 0xd0, 0x94, 0xce, 0xe4, 0x92, 0x97,
+	0x52, 0x43, 0x8e, 0x0c, 0xc1, 0xcd, 0x1c, 0x29, 0xd4, 0xe0, 0xe6, 0xc5, 0xa0, 0x43, 0x5a, 0x22,
+	0x1c, 0x45, 0xdd, 0xef, 0x50, 0x2e, 0x48, 0x08, 0x50, 0x1d, 0xa4, 0x23, 0x9d, 0x24, 0xd5, 0xca,
+	0xf2, 0xe9, 0x3e, 0xfb, 0x66, 0x8e, 0x94, 0xa2, 0x03, 0x92, 0x81, 0x89, 0x45, 0x3a, 0x38, 0x4c,
+	0x07, 0x56, 0x46, 0xfd, 0x6a, 0x47, 0x3d, 0xa9, 0x48, 0x32, 0xec, 0xac, 0x9c, 0xc4, 0xb3, 0xb7,
+	0xf3, 0x05, 0x51, 0x99, 0x5b, 0x38, 0x3e, 0x90, 0x11, 0x4c, 0x96, 0x63, 0xd2, 0x22, 0x10, 0x29,
+	0xc3, 0x11, 0x91, 0x4e, 0x09, 0x92, 0x54, 0x83, 0xe7, 0x21, 0x62, 0x38, 0x6a, 0x88, 0x88, 0x20,
+	0x2f, 0x54, 0x44, 0xf6, 0x25, 0x44, 0xa8, 0xeb, 0x92, 0x4f, 0x11, 0x92, 0x54, 0xa3, 0xab, 0x68,
+	0xdc, 0xca, 0x13, 0x53, 0x5c, 0x17, 0x92, 0x43, 0x42, 0x82, 0xba, 0xae, 0x1d, 0x19, 0x0c, 0x1a,
+	0xf2, 0x94, 0x33, 0x63, 0x24, 0x35, 0x7c, 0xa8, 0xe7, 0x82, 0xec, 0xad, 0x5c, 0x39, 0x25, 0xe4,
+	0x21, 0x49, 0x30, 0x05, 0x3e, 0x0a, 0x79, 0x0e, 0x0b, 0x79, 0x58, 0xaa, 0xac, 0x3d, 0x44, 0x27,
+	0x61, 0xb2, 0xd6, 0x1e, 0xf4, 0x73, 0x36, 0xf6, 0x9d, 0x42, 0xb2, 0xe6, 0xb5, 0x87, 0x53, 0x21,
+	0x20, 0xaf, 0x3d, 0x44, 0x85, 0x0c, 0x2a, 0xe5, 0x54, 0x10, 0x49, 0x0d, 0x24, 0xf9, 0x50, 0x19,
+	0x8f, 0x17, 0x21, 0x54, 0xc8, 0x1e, 0x1d, 0xaa, 0x7d, 0x05, 0xaa, 0x78, 0xd9, 0x21, 0x06, 0x2a,
+	0x35, 0x96, 0x24, 0x60, 0xba, 0x5d, 0x40, 0xd2, 0xb4, 0xec, 0xa0, 0x42, 0x14, 0x2f, 0x3b, 0xa8,
+	0x28, 0x29, 0xa7, 0x64, 0xd2, 0x09, 0xa5, 0x9e, 0xc4, 0xb0, 0xb7, 0x72, 0xe5, 0x4c, 0x84, 0xc2,
+	0x8d, 0x70, 0x8d, 0x50, 0x58, 0xaa, 0x4c, 0x5d, 0xf0, 0x35, 0x99, 0x53, 0x17, 0xed, 0x58, 0x85,
+	0xbd, 0x53, 0x44, 0xd4, 0x3c, 0x75, 0x41, 0x15, 0x94, 0xa9, 0x8b, 0x28, 0x93, 0xb8, 0x94, 0x81,
+	0x92, 0xe9, 0xbc, 0x8a, 0xbd, 0x95, 0x2b, 0x67, 0xe2, 0x92, 0x8e, 0xd2, 0xbe, 0x82, 0x52, 0x3c,
+	0x7f, 0x89, 0x30, 0x4a, 0x9d, 0xbf, 0xe8, 0x08, 0x6d, 0xe7, 0x0b, 0x9a, 0xe6, 0x2f, 0x0a, 0x3a,
+	0xf1, 0xfc, 0x25, 0x02, 0x28, 0x9e, 0xfc, 0x62, 0x2a, 0x75, 0x7a, 0x44, 0x92, 0xb3, 0xbf, 0xed,
+	0x5b, 0x79, 0x62, 0xa6, 0xc9, 0x2f, 0x4f, 0x30, 0xd6, 0x26, 0xbf, 0xbc, 0x50, 0xf9, 0x5e, 0xe2,
+	0xef, 0xc8, 0xfc, 0x5e, 0x52, 0x93, 0xa0, 0xed, 0xdb, 0x05, 0x24, 0xcd, 0xdf, 0x4b, 0xfc, 0xe7,
+	0x95, 0xef, 0x25, 0x2c, 0x92, 0xe6, 0xbd, 0xe9, 0xd8, 0x18, 0x32, 0xe3, 0xed, 0x5b, 0x79, 0x62,
+	0xa6, 0x79, 0xaf, 0x86, 0xcd, 0xbe, 0x8c, 0x4d, 0xfc, 0xbd, 0x24, 0x90, 0x49, 0x8f, 0x4f, 0x2a,
+	0x2e, 0x5b, 0xb9, 0x72, 0xa6, 0xef, 0x25, 0x19, 0x93, 0xf8, 0x7b, 0x49, 0xc0, 0xf2, 0x5d, 0x4b,
+	0xdc, 0x60, 0x26, 0xe7, 0x88, 0xef, 0xa4, 0x11, 0x22, 0x99, 0x79, 0x69, 0xdf, 0x29, 0x24, 0x8b,
+	0x9a, 0xdd, 0xbf, 0x18, 0x5c, 0x23, 0xab, 0xc8, 0x20, 0xcc, 0xec, 0x8b, 0x67, 0x82, 0xd7, 0x28,
+	0x8f, 0x96, 0xa9, 0x7a, 0x72, 0x2e, 0x20, 0x5d, 0x8f, 0x5b, 0x33, 0x25, 0x9e, 0x92, 0x7b, 0xe9,
+	0x44, 0x31, 0x64, 0x2a, 0xda, 0xbb, 0x45, 0xc5, 0x51, 0xd9, 0x57, 0xd9, 0x8a, 0x4b, 0x44, 0x2e,
+	0x59, 0x5d, 0x0e, 0xe7, 0x2a, 0x59, 0xd1, 0x95, 0xe5, 0x88, 0x26, 0x52, 0x2e, 0x4d, 0x88, 0xa6,
+	0xe5, 0xb2, 0xda, 0x77, 0x0a, 0xc9, 0x2a, 0x88, 0x22, 0xef, 0x8c, 0x88, 0xee, 0x27, 0x11, 0xa5,
+	0x4b, 0xb1, 0xc9, 0x64, 0x4c, 0x72, 0x27, 0x8d, 0x60, 0x26, 0x34, 0xef, 0x16, 0x13, 0x56, 0x96,
+	0x62, 0x91, 0x92, 0x49, 0x24, 0xe9, 0x52, 0xec, 0x8e, 0x01, 0xcc, 0x38, 0x30, 0x8a, 0xa4, 0xcf,
+	0x54, 0x5f, 0xa5, 0xa6, 0x8d, 0xd9, 0x5b, 0xb9, 0x72, 0xa6, 0xc0, 0x88, 0xc9, 0x4e, 0x5a, 0x60,
+	0xc4, 0x52, 0x25, 0x30, 0xe2, 0x6b, 0x32, 0x03, 0xa3, 0x96, 0x98, 0x65, 0xef, 0x14, 0x11, 0x35,
+	0x07, 0x46, 0x54, 0x41, 0x09, 0x8c, 0xa2, 0x4c, 0x0a, 0x8c, 0x19, 0x28, 0x99, 0x92, 0xeb, 0xec,
+	0xad, 0x5c, 0x39, 0x53, 0x60, 0xd4, 0x51, 0xda, 0x57, 0x50, 0x8a, 0x03, 0x63, 0x84, 0x51, 0xaa,
+	0xe7, 0xd2, 0x11, 0xda, 0xce, 0x17, 0x34, 0x05, 0x46, 0x05, 0x9d, 0x38, 0x30, 0x46, 0x00, 0xc5,
+	0x3b, 0x86, 0x51, 0xfe, 0x5a, 0x2a, 0x3f, 0xb4, 0xcc, 0x11, 0x7b, 0x3b, 0x5f, 0xd0, 0xb4, 0x63,
+	0x28, 0x52, 0x4b, 0xb4, 0x1d, 0x43, 0x51, 0xac, 0xcc, 0xda, 0xc5, 0x9b, 0x32, 0x67, 0xed, 0x7a,
+	0x2a, 0x8d, 0x7d, 0xa7, 0x90, 0xac, 0x79, 0xd6, 0x2e, 0xd4, 0x50, 0x66, 0xed, 0x51, 0xa1, 0xb4,
+	0x63, 0x98, 0x85, 0x97, 0x31, 0x3f, 0xc9, 0xde, 0xce, 0x17, 0x34, 0xed, 0x18, 0x26, 0xf0, 0xda,
+	0x57, 0xf1, 0x8a, 0xa7, 0xee, 0x31, 0x5a, 0xa9, 0x6c, 0x49, 0x60, 0x75, 0xbb, 0x80, 0xa4, 0x69,
+	0xea, 0xae, 0xe2, 0x14, 0x4f, 0xdd, 0x63, 0xa8, 0x7e, 0xdf, 0x82, 0xae, 0x96, 0x70, 0x63, 0xd2,
+	0xcb, 0x9c, 0x96, 0x64, 0xdf, 0x2e, 0x20, 0x19, 0x47, 0x23, 0x42, 0x96, 0xe7, 0xbc, 0x56, 0x85,
+	0x8b, 0x2e, 0xa1, 0x3b, 0x6b, 0xb2, 0x5e, 0x7b, 0x28, 0xa7, 0xb8, 0x2c, 0xb1, 0xed, 0x9e, 0xe5,
+	0xb2, 0xb4, 0x1d, 0x7d, 0x7b, 0xa7, 0x88, 0xa8, 0xd9, 0x65, 0xcd, 0xb0, 0x5e, 0x76, 0x59, 0xa2,
+	0x8c, 0x5c, 0x58, 0xd0, 0x56, 0x52, 0x0f, 0x4c, 0x2e, 0xcb, 0x94, 0xd0, 0x60, 0x6f, 0xe5, 0xca,
+	0xa1, 0x3a, 0x2f, 0x33, 0x97, 0xe5, 0xd3, 0x3a, 0x55, 0x17, 0xba, 0x95, 0xe6, 0x10, 0x59, 0x9d,
+	0x3d, 0x26, 0xc5, 0x6c, 0xa9, 0xa5, 0x28, 0x18, 0x39, 0x66, 0x4c, 0x7e, 0xb0, 0x6f, 0x17, 0x90,
+	0x54, 0x6c, 0x39, 0xe6, 0xb5, 0xaa, 0x72, 0xb1, 0x2d, 0x23, 0xe5, 0x50, 0xee, 0xcb, 0x95, 0x9f,
+	0x29, 0xcd, 0x1f, 0x3f, 0xae, 0xb2, 0x1c, 0x9b, 0xfb, 0xff, 0x33, 0x00, 0xb5, 0xee, 0x55, 0x88,
+	0x1e, 0x75, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6124,6 +8769,30 @@ type AlertManagerClient interface {
 	DescribeActions(ctx context.Context, in *DescribeActionsRequest, opts ...grpc.CallOption) (*DescribeActionsResponse, error)
 	ModifyAction(ctx context.Context, in *ModifyActionRequest, opts ...grpc.CallOption) (*ModifyActionResponse, error)
 	DeleteActions(ctx context.Context, in *DeleteActionsRequest, opts ...grpc.CallOption) (*DeleteActionsResponse, error)
+	//10.InhibitRule
+	//********************************************************************************************************
+	CreateInhibitRule(ctx context.Context, in *CreateInhibitRuleRequest, opts ...grpc.CallOption) (*CreateInhibitRuleResponse, error)
+	DescribeInhibitRules(ctx context.Context, in *DescribeInhibitRulesRequest, opts ...grpc.CallOption) (*DescribeInhibitRulesResponse, error)
+	ModifyInhibitRule(ctx context.Context, in *ModifyInhibitRuleRequest, opts ...grpc.CallOption) (*ModifyInhibitRuleResponse, error)
+	DeleteInhibitRules(ctx context.Context, in *DeleteInhibitRulesRequest, opts ...grpc.CallOption) (*DeleteInhibitRulesResponse, error)
+	//11.Silence
+	//********************************************************************************************************
+	CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*CreateSilenceResponse, error)
+	DescribeSilences(ctx context.Context, in *DescribeSilencesRequest, opts ...grpc.CallOption) (*DescribeSilencesResponse, error)
+	ModifySilence(ctx context.Context, in *ModifySilenceRequest, opts ...grpc.CallOption) (*ModifySilenceResponse, error)
+	DeleteSilences(ctx context.Context, in *DeleteSilencesRequest, opts ...grpc.CallOption) (*DeleteSilencesResponse, error)
+	//12.Template
+	//********************************************************************************************************
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error)
+	DescribeTemplates(ctx context.Context, in *DescribeTemplatesRequest, opts ...grpc.CallOption) (*DescribeTemplatesResponse, error)
+	ModifyTemplate(ctx context.Context, in *ModifyTemplateRequest, opts ...grpc.CallOption) (*ModifyTemplateResponse, error)
+	DeleteTemplates(ctx context.Context, in *DeleteTemplatesRequest, opts ...grpc.CallOption) (*DeleteTemplatesResponse, error)
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error)
+	//13.Outbox
+	//********************************************************************************************************
+	DescribeOutboxes(ctx context.Context, in *DescribeOutboxesRequest, opts ...grpc.CallOption) (*DescribeOutboxesResponse, error)
+	RetryOutboxes(ctx context.Context, in *RetryOutboxesRequest, opts ...grpc.CallOption) (*RetryOutboxesResponse, error)
+	DiscardOutboxes(ctx context.Context, in *DiscardOutboxesRequest, opts ...grpc.CallOption) (*DiscardOutboxesResponse, error)
 }
 
 type alertManagerClient struct {
@@ -6494,6 +9163,150 @@ func (c *alertManagerClient) DeleteActions(ctx context.Context, in *DeleteAction
 	return out, nil
 }
 
+func (c *alertManagerClient) CreateInhibitRule(ctx context.Context, in *CreateInhibitRuleRequest, opts ...grpc.CallOption) (*CreateInhibitRuleResponse, error) {
+	out := new(CreateInhibitRuleResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/CreateInhibitRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeInhibitRules(ctx context.Context, in *DescribeInhibitRulesRequest, opts ...grpc.CallOption) (*DescribeInhibitRulesResponse, error) {
+	out := new(DescribeInhibitRulesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeInhibitRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) ModifyInhibitRule(ctx context.Context, in *ModifyInhibitRuleRequest, opts ...grpc.CallOption) (*ModifyInhibitRuleResponse, error) {
+	out := new(ModifyInhibitRuleResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/ModifyInhibitRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DeleteInhibitRules(ctx context.Context, in *DeleteInhibitRulesRequest, opts ...grpc.CallOption) (*DeleteInhibitRulesResponse, error) {
+	out := new(DeleteInhibitRulesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DeleteInhibitRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) CreateSilence(ctx context.Context, in *CreateSilenceRequest, opts ...grpc.CallOption) (*CreateSilenceResponse, error) {
+	out := new(CreateSilenceResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/CreateSilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeSilences(ctx context.Context, in *DescribeSilencesRequest, opts ...grpc.CallOption) (*DescribeSilencesResponse, error) {
+	out := new(DescribeSilencesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeSilences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) ModifySilence(ctx context.Context, in *ModifySilenceRequest, opts ...grpc.CallOption) (*ModifySilenceResponse, error) {
+	out := new(ModifySilenceResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/ModifySilence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DeleteSilences(ctx context.Context, in *DeleteSilencesRequest, opts ...grpc.CallOption) (*DeleteSilencesResponse, error) {
+	out := new(DeleteSilencesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DeleteSilences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*CreateTemplateResponse, error) {
+	out := new(CreateTemplateResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/CreateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeTemplates(ctx context.Context, in *DescribeTemplatesRequest, opts ...grpc.CallOption) (*DescribeTemplatesResponse, error) {
+	out := new(DescribeTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) ModifyTemplate(ctx context.Context, in *ModifyTemplateRequest, opts ...grpc.CallOption) (*ModifyTemplateResponse, error) {
+	out := new(ModifyTemplateResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/ModifyTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DeleteTemplates(ctx context.Context, in *DeleteTemplatesRequest, opts ...grpc.CallOption) (*DeleteTemplatesResponse, error) {
+	out := new(DeleteTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DeleteTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error) {
+	out := new(PreviewTemplateResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/PreviewTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DescribeOutboxes(ctx context.Context, in *DescribeOutboxesRequest, opts ...grpc.CallOption) (*DescribeOutboxesResponse, error) {
+	out := new(DescribeOutboxesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DescribeOutboxes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) RetryOutboxes(ctx context.Context, in *RetryOutboxesRequest, opts ...grpc.CallOption) (*RetryOutboxesResponse, error) {
+	out := new(RetryOutboxesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/RetryOutboxes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerClient) DiscardOutboxes(ctx context.Context, in *DiscardOutboxesRequest, opts ...grpc.CallOption) (*DiscardOutboxesResponse, error) {
+	out := new(DiscardOutboxesResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManager/DiscardOutboxes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlertManagerServer is the server API for AlertManager service.
 type AlertManagerServer interface {
 	//0.executor
@@ -6556,6 +9369,30 @@ type AlertManagerServer interface {
 	DescribeActions(context.Context, *DescribeActionsRequest) (*DescribeActionsResponse, error)
 	ModifyAction(context.Context, *ModifyActionRequest) (*ModifyActionResponse, error)
 	DeleteActions(context.Context, *DeleteActionsRequest) (*DeleteActionsResponse, error)
+	//10.InhibitRule
+	//********************************************************************************************************
+	CreateInhibitRule(context.Context, *CreateInhibitRuleRequest) (*CreateInhibitRuleResponse, error)
+	DescribeInhibitRules(context.Context, *DescribeInhibitRulesRequest) (*DescribeInhibitRulesResponse, error)
+	ModifyInhibitRule(context.Context, *ModifyInhibitRuleRequest) (*ModifyInhibitRuleResponse, error)
+	DeleteInhibitRules(context.Context, *DeleteInhibitRulesRequest) (*DeleteInhibitRulesResponse, error)
+	//11.Silence
+	//********************************************************************************************************
+	CreateSilence(context.Context, *CreateSilenceRequest) (*CreateSilenceResponse, error)
+	DescribeSilences(context.Context, *DescribeSilencesRequest) (*DescribeSilencesResponse, error)
+	ModifySilence(context.Context, *ModifySilenceRequest) (*ModifySilenceResponse, error)
+	DeleteSilences(context.Context, *DeleteSilencesRequest) (*DeleteSilencesResponse, error)
+	//12.Template
+	//********************************************************************************************************
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*CreateTemplateResponse, error)
+	DescribeTemplates(context.Context, *DescribeTemplatesRequest) (*DescribeTemplatesResponse, error)
+	ModifyTemplate(context.Context, *ModifyTemplateRequest) (*ModifyTemplateResponse, error)
+	DeleteTemplates(context.Context, *DeleteTemplatesRequest) (*DeleteTemplatesResponse, error)
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error)
+	//13.Outbox
+	//********************************************************************************************************
+	DescribeOutboxes(context.Context, *DescribeOutboxesRequest) (*DescribeOutboxesResponse, error)
+	RetryOutboxes(context.Context, *RetryOutboxesRequest) (*RetryOutboxesResponse, error)
+	DiscardOutboxes(context.Context, *DiscardOutboxesRequest) (*DiscardOutboxesResponse, error)
 }
 
 // UnimplementedAlertManagerServer can be embedded to have forward compatible implementations.
@@ -6682,6 +9519,54 @@ func (*UnimplementedAlertManagerServer) ModifyAction(ctx context.Context, req *M
 func (*UnimplementedAlertManagerServer) DeleteActions(ctx context.Context, req *DeleteActionsRequest) (*DeleteActionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteActions not implemented")
 }
+func (*UnimplementedAlertManagerServer) CreateInhibitRule(ctx context.Context, req *CreateInhibitRuleRequest) (*CreateInhibitRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInhibitRule not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeInhibitRules(ctx context.Context, req *DescribeInhibitRulesRequest) (*DescribeInhibitRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeInhibitRules not implemented")
+}
+func (*UnimplementedAlertManagerServer) ModifyInhibitRule(ctx context.Context, req *ModifyInhibitRuleRequest) (*ModifyInhibitRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyInhibitRule not implemented")
+}
+func (*UnimplementedAlertManagerServer) DeleteInhibitRules(ctx context.Context, req *DeleteInhibitRulesRequest) (*DeleteInhibitRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInhibitRules not implemented")
+}
+func (*UnimplementedAlertManagerServer) CreateSilence(ctx context.Context, req *CreateSilenceRequest) (*CreateSilenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSilence not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeSilences(ctx context.Context, req *DescribeSilencesRequest) (*DescribeSilencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSilences not implemented")
+}
+func (*UnimplementedAlertManagerServer) ModifySilence(ctx context.Context, req *ModifySilenceRequest) (*ModifySilenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifySilence not implemented")
+}
+func (*UnimplementedAlertManagerServer) DeleteSilences(ctx context.Context, req *DeleteSilencesRequest) (*DeleteSilencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSilences not implemented")
+}
+func (*UnimplementedAlertManagerServer) CreateTemplate(ctx context.Context, req *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeTemplates(ctx context.Context, req *DescribeTemplatesRequest) (*DescribeTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTemplates not implemented")
+}
+func (*UnimplementedAlertManagerServer) ModifyTemplate(ctx context.Context, req *ModifyTemplateRequest) (*ModifyTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyTemplate not implemented")
+}
+func (*UnimplementedAlertManagerServer) DeleteTemplates(ctx context.Context, req *DeleteTemplatesRequest) (*DeleteTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplates not implemented")
+}
+func (*UnimplementedAlertManagerServer) PreviewTemplate(ctx context.Context, req *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (*UnimplementedAlertManagerServer) DescribeOutboxes(ctx context.Context, req *DescribeOutboxesRequest) (*DescribeOutboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeOutboxes not implemented")
+}
+func (*UnimplementedAlertManagerServer) RetryOutboxes(ctx context.Context, req *RetryOutboxesRequest) (*RetryOutboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOutboxes not implemented")
+}
+func (*UnimplementedAlertManagerServer) DiscardOutboxes(ctx context.Context, req *DiscardOutboxesRequest) (*DiscardOutboxesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardOutboxes not implemented")
+}
 
 func RegisterAlertManagerServer(s *grpc.Server, srv AlertManagerServer) {
 	s.RegisterService(&_AlertManager_serviceDesc, srv)
@@ -7407,6 +10292,294 @@ func _AlertManager_DeleteActions_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertManager_CreateInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).CreateInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/CreateInhibitRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).CreateInhibitRule(ctx, req.(*CreateInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeInhibitRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeInhibitRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeInhibitRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeInhibitRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeInhibitRules(ctx, req.(*DescribeInhibitRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_ModifyInhibitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyInhibitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).ModifyInhibitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/ModifyInhibitRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).ModifyInhibitRule(ctx, req.(*ModifyInhibitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DeleteInhibitRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInhibitRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DeleteInhibitRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DeleteInhibitRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DeleteInhibitRules(ctx, req.(*DeleteInhibitRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_CreateSilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).CreateSilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/CreateSilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).CreateSilence(ctx, req.(*CreateSilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeSilences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeSilences(ctx, req.(*DescribeSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_ModifySilence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifySilenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).ModifySilence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/ModifySilence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).ModifySilence(ctx, req.(*ModifySilenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DeleteSilences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSilencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DeleteSilences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DeleteSilences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DeleteSilences(ctx, req.(*DeleteSilencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/CreateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeTemplates(ctx, req.(*DescribeTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_ModifyTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).ModifyTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/ModifyTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).ModifyTemplate(ctx, req.(*ModifyTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DeleteTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DeleteTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DeleteTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DeleteTemplates(ctx, req.(*DeleteTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/PreviewTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DescribeOutboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeOutboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DescribeOutboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DescribeOutboxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DescribeOutboxes(ctx, req.(*DescribeOutboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_RetryOutboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryOutboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).RetryOutboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/RetryOutboxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).RetryOutboxes(ctx, req.(*RetryOutboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManager_DiscardOutboxes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardOutboxesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerServer).DiscardOutboxes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManager/DiscardOutboxes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerServer).DiscardOutboxes(ctx, req.(*DiscardOutboxesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AlertManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubesphere.alert.AlertManager",
 	HandlerType: (*AlertManagerServer)(nil),
@@ -7571,6 +10744,70 @@ var _AlertManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteActions",
 			Handler:    _AlertManager_DeleteActions_Handler,
 		},
+		{
+			MethodName: "CreateInhibitRule",
+			Handler:    _AlertManager_CreateInhibitRule_Handler,
+		},
+		{
+			MethodName: "DescribeInhibitRules",
+			Handler:    _AlertManager_DescribeInhibitRules_Handler,
+		},
+		{
+			MethodName: "ModifyInhibitRule",
+			Handler:    _AlertManager_ModifyInhibitRule_Handler,
+		},
+		{
+			MethodName: "DeleteInhibitRules",
+			Handler:    _AlertManager_DeleteInhibitRules_Handler,
+		},
+		{
+			MethodName: "CreateSilence",
+			Handler:    _AlertManager_CreateSilence_Handler,
+		},
+		{
+			MethodName: "DescribeSilences",
+			Handler:    _AlertManager_DescribeSilences_Handler,
+		},
+		{
+			MethodName: "ModifySilence",
+			Handler:    _AlertManager_ModifySilence_Handler,
+		},
+		{
+			MethodName: "DeleteSilences",
+			Handler:    _AlertManager_DeleteSilences_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _AlertManager_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "DescribeTemplates",
+			Handler:    _AlertManager_DescribeTemplates_Handler,
+		},
+		{
+			MethodName: "ModifyTemplate",
+			Handler:    _AlertManager_ModifyTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplates",
+			Handler:    _AlertManager_DeleteTemplates_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _AlertManager_PreviewTemplate_Handler,
+		},
+		{
+			MethodName: "DescribeOutboxes",
+			Handler:    _AlertManager_DescribeOutboxes_Handler,
+		},
+		{
+			MethodName: "RetryOutboxes",
+			Handler:    _AlertManager_RetryOutboxes_Handler,
+		},
+		{
+			MethodName: "DiscardOutboxes",
+			Handler:    _AlertManager_DiscardOutboxes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "alert.proto",
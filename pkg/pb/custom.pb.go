@@ -214,32 +214,38 @@ func (m *DescribeAlertsWithResourceResponse) GetAlertSet() []*Alert {
 }
 
 type AlertDetail struct {
-	AlertId              string               `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
-	AlertName            string               `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name"`
-	Disabled             bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
-	CreateTime           *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time"`
-	RunningStatus        string               `protobuf:"bytes,5,opt,name=running_status,json=runningStatus,proto3" json:"running_status"`
-	AlertStatus          string               `protobuf:"bytes,6,opt,name=alert_status,json=alertStatus,proto3" json:"alert_status"`
-	PolicyId             string               `protobuf:"bytes,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
-	RsFilterName         string               `protobuf:"bytes,8,opt,name=rs_filter_name,json=rsFilterName,proto3" json:"rs_filter_name"`
-	RsFilterParam        string               `protobuf:"bytes,9,opt,name=rs_filter_param,json=rsFilterParam,proto3" json:"rs_filter_param"`
-	RsTypeName           string               `protobuf:"bytes,10,opt,name=rs_type_name,json=rsTypeName,proto3" json:"rs_type_name"`
-	ExecutorId           string               `protobuf:"bytes,11,opt,name=executor_id,json=executorId,proto3" json:"executor_id"`
-	PolicyName           string               `protobuf:"bytes,12,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
-	PolicyDescription    string               `protobuf:"bytes,13,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
-	PolicyConfig         string               `protobuf:"bytes,14,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
-	Creator              string               `protobuf:"bytes,15,opt,name=creator,proto3" json:"creator"`
-	AvailableStartTime   string               `protobuf:"bytes,16,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
-	AvailableEndTime     string               `protobuf:"bytes,17,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
-	Language             string               `protobuf:"bytes,18,opt,name=language,proto3" json:"language"`
-	Metrics              []string             `protobuf:"bytes,19,rep,name=metrics,proto3" json:"metrics"`
-	RulesCount           uint32               `protobuf:"varint,20,opt,name=rules_count,json=rulesCount,proto3" json:"rules_count"`
-	PositivesCount       uint32               `protobuf:"varint,21,opt,name=positives_count,json=positivesCount,proto3" json:"positives_count"`
-	MostRecentAlertTime  string               `protobuf:"bytes,22,opt,name=most_recent_alert_time,json=mostRecentAlertTime,proto3" json:"most_recent_alert_time"`
-	NfAddressListId      string               `protobuf:"bytes,23,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	AlertId             string               `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	AlertName           string               `protobuf:"bytes,2,opt,name=alert_name,json=alertName,proto3" json:"alert_name"`
+	Disabled            bool                 `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled"`
+	CreateTime          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time"`
+	RunningStatus       string               `protobuf:"bytes,5,opt,name=running_status,json=runningStatus,proto3" json:"running_status"`
+	AlertStatus         string               `protobuf:"bytes,6,opt,name=alert_status,json=alertStatus,proto3" json:"alert_status"`
+	PolicyId            string               `protobuf:"bytes,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id"`
+	RsFilterName        string               `protobuf:"bytes,8,opt,name=rs_filter_name,json=rsFilterName,proto3" json:"rs_filter_name"`
+	RsFilterParam       string               `protobuf:"bytes,9,opt,name=rs_filter_param,json=rsFilterParam,proto3" json:"rs_filter_param"`
+	RsTypeName          string               `protobuf:"bytes,10,opt,name=rs_type_name,json=rsTypeName,proto3" json:"rs_type_name"`
+	ExecutorId          string               `protobuf:"bytes,11,opt,name=executor_id,json=executorId,proto3" json:"executor_id"`
+	PolicyName          string               `protobuf:"bytes,12,opt,name=policy_name,json=policyName,proto3" json:"policy_name"`
+	PolicyDescription   string               `protobuf:"bytes,13,opt,name=policy_description,json=policyDescription,proto3" json:"policy_description"`
+	PolicyConfig        string               `protobuf:"bytes,14,opt,name=policy_config,json=policyConfig,proto3" json:"policy_config"`
+	Creator             string               `protobuf:"bytes,15,opt,name=creator,proto3" json:"creator"`
+	AvailableStartTime  string               `protobuf:"bytes,16,opt,name=available_start_time,json=availableStartTime,proto3" json:"available_start_time"`
+	AvailableEndTime    string               `protobuf:"bytes,17,opt,name=available_end_time,json=availableEndTime,proto3" json:"available_end_time"`
+	Language            string               `protobuf:"bytes,18,opt,name=language,proto3" json:"language"`
+	Metrics             []string             `protobuf:"bytes,19,rep,name=metrics,proto3" json:"metrics"`
+	RulesCount          uint32               `protobuf:"varint,20,opt,name=rules_count,json=rulesCount,proto3" json:"rules_count"`
+	PositivesCount      uint32               `protobuf:"varint,21,opt,name=positives_count,json=positivesCount,proto3" json:"positives_count"`
+	MostRecentAlertTime string               `protobuf:"bytes,22,opt,name=most_recent_alert_time,json=mostRecentAlertTime,proto3" json:"most_recent_alert_time"`
+	// nf_address_list_id and escalation_config are of the first action of policy, actions lists all of them
+	NfAddressListId      string    `protobuf:"bytes,23,opt,name=nf_address_list_id,json=nfAddressListId,proto3" json:"nf_address_list_id"`
+	AvailableSchedule    string    `protobuf:"bytes,24,opt,name=available_schedule,json=availableSchedule,proto3" json:"available_schedule"`
+	GroupConfig          string    `protobuf:"bytes,25,opt,name=group_config,json=groupConfig,proto3" json:"group_config"`
+	EscalationConfig     string    `protobuf:"bytes,26,opt,name=escalation_config,json=escalationConfig,proto3" json:"escalation_config"`
+	RouteConfig          string    `protobuf:"bytes,27,opt,name=route_config,json=routeConfig,proto3" json:"route_config"`
+	Actions              []*Action `protobuf:"bytes,28,rep,name=actions,proto3" json:"actions"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *AlertDetail) Reset()         { *m = AlertDetail{} }
@@ -428,6 +434,41 @@ func (m *AlertDetail) GetNfAddressListId() string {
 	return ""
 }
 
+func (m *AlertDetail) GetAvailableSchedule() string {
+	if m != nil {
+		return m.AvailableSchedule
+	}
+	return ""
+}
+
+func (m *AlertDetail) GetGroupConfig() string {
+	if m != nil {
+		return m.GroupConfig
+	}
+	return ""
+}
+
+func (m *AlertDetail) GetEscalationConfig() string {
+	if m != nil {
+		return m.EscalationConfig
+	}
+	return ""
+}
+
+func (m *AlertDetail) GetRouteConfig() string {
+	if m != nil {
+		return m.RouteConfig
+	}
+	return ""
+}
+
+func (m *AlertDetail) GetActions() []*Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 type DescribeAlertDetailsRequest struct {
 	SearchWord           string   `protobuf:"bytes,1,opt,name=search_word,json=searchWord,proto3" json:"search_word"`
 	SortKey              string   `protobuf:"bytes,2,opt,name=sort_key,json=sortKey,proto3" json:"sort_key"`
@@ -626,6 +667,9 @@ type ResourceStatus struct {
 	NextResendInterval   uint32   `protobuf:"varint,5,opt,name=next_resend_interval,json=nextResendInterval,proto3" json:"next_resend_interval"`
 	NextSendableTime     string   `protobuf:"bytes,6,opt,name=next_sendable_time,json=nextSendableTime,proto3" json:"next_sendable_time"`
 	AggregatedAlerts     string   `protobuf:"bytes,7,opt,name=aggregated_alerts,json=aggregatedAlerts,proto3" json:"aggregated_alerts"`
+	Acknowledger         string   `protobuf:"bytes,8,opt,name=acknowledger,proto3" json:"acknowledger"`
+	AcknowledgeTime      string   `protobuf:"bytes,9,opt,name=acknowledge_time,json=acknowledgeTime,proto3" json:"acknowledge_time"`
+	SnoozeUntil          string   `protobuf:"bytes,10,opt,name=snooze_until,json=snoozeUntil,proto3" json:"snooze_until"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -705,6 +749,27 @@ func (m *ResourceStatus) GetAggregatedAlerts() string {
 	return ""
 }
 
+func (m *ResourceStatus) GetAcknowledger() string {
+	if m != nil {
+		return m.Acknowledger
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetAcknowledgeTime() string {
+	if m != nil {
+		return m.AcknowledgeTime
+	}
+	return ""
+}
+
+func (m *ResourceStatus) GetSnoozeUntil() string {
+	if m != nil {
+		return m.SnoozeUntil
+	}
+	return ""
+}
+
 type AlertStatus struct {
 	RuleId               string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
 	RuleName             string               `protobuf:"bytes,2,opt,name=rule_name,json=ruleName,proto3" json:"rule_name"`
@@ -1054,6 +1119,219 @@ func (m *DescribeAlertStatusResponse) GetAlertstatusSet() []*AlertStatus {
 	return nil
 }
 
+type AcknowledgeAlertRequest struct {
+	AlertId              string   `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	RuleId               string   `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName         string   `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	Acknowledger         string   `protobuf:"bytes,4,opt,name=acknowledger,proto3" json:"acknowledger"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcknowledgeAlertRequest) Reset()         { *m = AcknowledgeAlertRequest{} }
+func (m *AcknowledgeAlertRequest) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgeAlertRequest) ProtoMessage()    {}
+func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0669528d4dffbbe2, []int{9}
+}
+
+func (m *AcknowledgeAlertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcknowledgeAlertRequest.Unmarshal(m, b)
+}
+func (m *AcknowledgeAlertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcknowledgeAlertRequest.Marshal(b, m, deterministic)
+}
+func (m *AcknowledgeAlertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeAlertRequest.Merge(m, src)
+}
+func (m *AcknowledgeAlertRequest) XXX_Size() int {
+	return xxx_messageInfo_AcknowledgeAlertRequest.Size(m)
+}
+func (m *AcknowledgeAlertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeAlertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgeAlertRequest proto.InternalMessageInfo
+
+func (m *AcknowledgeAlertRequest) GetAlertId() string {
+	if m != nil {
+		return m.AlertId
+	}
+	return ""
+}
+
+func (m *AcknowledgeAlertRequest) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *AcknowledgeAlertRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *AcknowledgeAlertRequest) GetAcknowledger() string {
+	if m != nil {
+		return m.Acknowledger
+	}
+	return ""
+}
+
+type AcknowledgeAlertResponse struct {
+	AlertId              string   `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcknowledgeAlertResponse) Reset()         { *m = AcknowledgeAlertResponse{} }
+func (m *AcknowledgeAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgeAlertResponse) ProtoMessage()    {}
+func (*AcknowledgeAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0669528d4dffbbe2, []int{10}
+}
+
+func (m *AcknowledgeAlertResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcknowledgeAlertResponse.Unmarshal(m, b)
+}
+func (m *AcknowledgeAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcknowledgeAlertResponse.Marshal(b, m, deterministic)
+}
+func (m *AcknowledgeAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeAlertResponse.Merge(m, src)
+}
+func (m *AcknowledgeAlertResponse) XXX_Size() int {
+	return xxx_messageInfo_AcknowledgeAlertResponse.Size(m)
+}
+func (m *AcknowledgeAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeAlertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgeAlertResponse proto.InternalMessageInfo
+
+func (m *AcknowledgeAlertResponse) GetAlertId() string {
+	if m != nil {
+		return m.AlertId
+	}
+	return ""
+}
+
+type SnoozeAlertRequest struct {
+	AlertId      string `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	RuleId       string `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id"`
+	ResourceName string `protobuf:"bytes,3,opt,name=resource_name,json=resourceName,proto3" json:"resource_name"`
+	//snooze minutes from now, 0 clears snooze
+	SnoozeMinutes        uint32   `protobuf:"varint,4,opt,name=snooze_minutes,json=snoozeMinutes,proto3" json:"snooze_minutes"`
+	Operator             string   `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnoozeAlertRequest) Reset()         { *m = SnoozeAlertRequest{} }
+func (m *SnoozeAlertRequest) String() string { return proto.CompactTextString(m) }
+func (*SnoozeAlertRequest) ProtoMessage()    {}
+func (*SnoozeAlertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0669528d4dffbbe2, []int{11}
+}
+
+func (m *SnoozeAlertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnoozeAlertRequest.Unmarshal(m, b)
+}
+func (m *SnoozeAlertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnoozeAlertRequest.Marshal(b, m, deterministic)
+}
+func (m *SnoozeAlertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnoozeAlertRequest.Merge(m, src)
+}
+func (m *SnoozeAlertRequest) XXX_Size() int {
+	return xxx_messageInfo_SnoozeAlertRequest.Size(m)
+}
+func (m *SnoozeAlertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnoozeAlertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnoozeAlertRequest proto.InternalMessageInfo
+
+func (m *SnoozeAlertRequest) GetAlertId() string {
+	if m != nil {
+		return m.AlertId
+	}
+	return ""
+}
+
+func (m *SnoozeAlertRequest) GetRuleId() string {
+	if m != nil {
+		return m.RuleId
+	}
+	return ""
+}
+
+func (m *SnoozeAlertRequest) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *SnoozeAlertRequest) GetSnoozeMinutes() uint32 {
+	if m != nil {
+		return m.SnoozeMinutes
+	}
+	return 0
+}
+
+func (m *SnoozeAlertRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type SnoozeAlertResponse struct {
+	AlertId              string   `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnoozeAlertResponse) Reset()         { *m = SnoozeAlertResponse{} }
+func (m *SnoozeAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*SnoozeAlertResponse) ProtoMessage()    {}
+func (*SnoozeAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0669528d4dffbbe2, []int{12}
+}
+
+func (m *SnoozeAlertResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnoozeAlertResponse.Unmarshal(m, b)
+}
+func (m *SnoozeAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnoozeAlertResponse.Marshal(b, m, deterministic)
+}
+func (m *SnoozeAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnoozeAlertResponse.Merge(m, src)
+}
+func (m *SnoozeAlertResponse) XXX_Size() int {
+	return xxx_messageInfo_SnoozeAlertResponse.Size(m)
+}
+func (m *SnoozeAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnoozeAlertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnoozeAlertResponse proto.InternalMessageInfo
+
+func (m *SnoozeAlertResponse) GetAlertId() string {
+	if m != nil {
+		return m.AlertId
+	}
+	return ""
+}
+
 //1.History
 //********************************************************************************************************
 type HistoryDetail struct {
@@ -1085,7 +1363,7 @@ func (m *HistoryDetail) Reset()         { *m = HistoryDetail{} }
 func (m *HistoryDetail) String() string { return proto.CompactTextString(m) }
 func (*HistoryDetail) ProtoMessage()    {}
 func (*HistoryDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0669528d4dffbbe2, []int{9}
+	return fileDescriptor_0669528d4dffbbe2, []int{13}
 }
 
 func (m *HistoryDetail) XXX_Unmarshal(b []byte) error {
@@ -1263,7 +1541,7 @@ func (m *DescribeHistoryDetailRequest) Reset()         { *m = DescribeHistoryDet
 func (m *DescribeHistoryDetailRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryDetailRequest) ProtoMessage()    {}
 func (*DescribeHistoryDetailRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0669528d4dffbbe2, []int{10}
+	return fileDescriptor_0669528d4dffbbe2, []int{14}
 }
 
 func (m *DescribeHistoryDetailRequest) XXX_Unmarshal(b []byte) error {
@@ -1394,7 +1672,7 @@ func (m *DescribeHistoryDetailResponse) Reset()         { *m = DescribeHistoryDe
 func (m *DescribeHistoryDetailResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryDetailResponse) ProtoMessage()    {}
 func (*DescribeHistoryDetailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0669528d4dffbbe2, []int{11}
+	return fileDescriptor_0669528d4dffbbe2, []int{15}
 }
 
 func (m *DescribeHistoryDetailResponse) XXX_Unmarshal(b []byte) error {
@@ -1439,6 +1717,10 @@ func init() {
 	proto.RegisterType((*AlertStatus)(nil), "kubesphere.alert.AlertStatus")
 	proto.RegisterType((*DescribeAlertStatusRequest)(nil), "kubesphere.alert.DescribeAlertStatusRequest")
 	proto.RegisterType((*DescribeAlertStatusResponse)(nil), "kubesphere.alert.DescribeAlertStatusResponse")
+	proto.RegisterType((*AcknowledgeAlertRequest)(nil), "kubesphere.alert.AcknowledgeAlertRequest")
+	proto.RegisterType((*AcknowledgeAlertResponse)(nil), "kubesphere.alert.AcknowledgeAlertResponse")
+	proto.RegisterType((*SnoozeAlertRequest)(nil), "kubesphere.alert.SnoozeAlertRequest")
+	proto.RegisterType((*SnoozeAlertResponse)(nil), "kubesphere.alert.SnoozeAlertResponse")
 	proto.RegisterType((*HistoryDetail)(nil), "kubesphere.alert.HistoryDetail")
 	proto.RegisterType((*DescribeHistoryDetailRequest)(nil), "kubesphere.alert.DescribeHistoryDetailRequest")
 	proto.RegisterType((*DescribeHistoryDetailResponse)(nil), "kubesphere.alert.DescribeHistoryDetailResponse")
@@ -1447,115 +1729,136 @@ func init() {
 func init() { proto.RegisterFile("custom.proto", fileDescriptor_0669528d4dffbbe2) }
 
 var fileDescriptor_0669528d4dffbbe2 = []byte{
-	// 1717 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6e, 0x23, 0xc7,
-	0x11, 0x06, 0x45, 0xfd, 0x90, 0xc5, 0x5f, 0xb5, 0xb4, 0xd2, 0x98, 0xf6, 0x7a, 0x69, 0xae, 0x63,
-	0x0b, 0xc8, 0x8a, 0x74, 0xb4, 0x0b, 0xe4, 0x60, 0x20, 0x80, 0xb2, 0x1b, 0x23, 0x44, 0x36, 0x81,
-	0x41, 0x19, 0x30, 0x90, 0xcb, 0x60, 0x34, 0xd3, 0x24, 0x1b, 0x1e, 0xce, 0x4c, 0xba, 0x7b, 0xb4,
-	0x16, 0x92, 0x53, 0xf2, 0x06, 0xca, 0x3b, 0xe4, 0x12, 0xe4, 0x90, 0x43, 0x8e, 0x01, 0x12, 0x20,
-	0x4f, 0x90, 0x9c, 0x72, 0xcf, 0x25, 0xf7, 0x3c, 0x40, 0xd0, 0x55, 0x3d, 0xe4, 0xcc, 0x90, 0xfa,
-	0x59, 0x24, 0x17, 0x03, 0x3e, 0x49, 0xfd, 0x55, 0x75, 0x4f, 0x75, 0xd5, 0x57, 0x5f, 0xcd, 0x10,
-	0x9a, 0x7e, 0xaa, 0x74, 0xbc, 0x18, 0x26, 0x32, 0xd6, 0x31, 0xeb, 0x7e, 0x95, 0x5e, 0x72, 0x95,
-	0xcc, 0xb9, 0xe4, 0x43, 0x2f, 0xe4, 0x52, 0xf7, 0xde, 0x9b, 0xc5, 0xf1, 0x2c, 0xe4, 0x23, 0x2f,
-	0x11, 0x23, 0x2f, 0x8a, 0x62, 0xed, 0x69, 0x11, 0x47, 0x8a, 0xfc, 0x7b, 0xef, 0x5b, 0x2b, 0xae,
-	0x2e, 0xd3, 0xe9, 0xe8, 0x8d, 0xf4, 0x92, 0x84, 0xcb, 0xcc, 0xfe, 0x0c, 0xff, 0xf8, 0xa7, 0x33,
-	0x1e, 0x9d, 0xaa, 0x37, 0xde, 0x6c, 0xc6, 0xe5, 0x28, 0x4e, 0xf0, 0x84, 0x0d, 0xa7, 0x3d, 0x29,
-	0x9f, 0xa6, 0xc5, 0x82, 0x2b, 0xed, 0x2d, 0x12, 0xeb, 0xd0, 0xc0, 0x98, 0x68, 0x31, 0xf8, 0x43,
-	0x15, 0x3e, 0x78, 0xc5, 0x95, 0x2f, 0xc5, 0x25, 0x3f, 0x37, 0xb8, 0xfa, 0x52, 0xe8, 0xf9, 0x84,
-	0xab, 0x38, 0x95, 0x3e, 0x9f, 0xf0, 0x5f, 0xa4, 0x5c, 0x69, 0xf6, 0x04, 0x1a, 0x8a, 0x7b, 0xd2,
-	0x9f, 0xbb, 0x6f, 0x62, 0x19, 0x38, 0x95, 0x7e, 0xe5, 0xa4, 0x3e, 0x01, 0x82, 0xbe, 0x8c, 0x65,
-	0xc0, 0xde, 0x81, 0x9a, 0x8a, 0xa5, 0x76, 0xbf, 0xe2, 0xd7, 0xce, 0x16, 0x5a, 0xf7, 0xcc, 0xfa,
-	0x27, 0xfc, 0x9a, 0x39, 0xb0, 0x27, 0xf9, 0x15, 0x97, 0x8a, 0x3b, 0xd5, 0x7e, 0xe5, 0xa4, 0x36,
-	0xc9, 0x96, 0xec, 0x08, 0x76, 0xe3, 0xe9, 0x54, 0x71, 0xed, 0x6c, 0xf7, 0x2b, 0x27, 0xad, 0x89,
-	0x5d, 0xb1, 0x43, 0xd8, 0x09, 0xc5, 0x42, 0x68, 0x67, 0x07, 0x61, 0x5a, 0xb0, 0x8f, 0xa1, 0x23,
-	0x6d, 0x58, 0x2e, 0x3d, 0xd9, 0xd9, 0xc5, 0x27, 0xb5, 0x33, 0xf8, 0x02, 0x51, 0x13, 0x0b, 0xde,
-	0xd0, 0x15, 0x81, 0xb3, 0xd7, 0xaf, 0x9a, 0x58, 0x70, 0x3d, 0x0e, 0xd8, 0x63, 0x00, 0x32, 0x45,
-	0xde, 0x82, 0x3b, 0x35, 0x34, 0xd6, 0x11, 0xf9, 0x99, 0xb7, 0xe0, 0xac, 0x07, 0xb5, 0x40, 0x28,
-	0xef, 0x32, 0xe4, 0x81, 0x53, 0xef, 0x57, 0x4f, 0x6a, 0x93, 0xe5, 0x9a, 0x7d, 0x07, 0xda, 0x32,
-	0x8d, 0x22, 0x11, 0xcd, 0x5c, 0xa5, 0x3d, 0x9d, 0x2a, 0x07, 0x70, 0x7b, 0xcb, 0xa2, 0x17, 0x08,
-	0xb2, 0x77, 0xa1, 0x9e, 0xc4, 0xa1, 0xf0, 0xaf, 0xcd, 0xd3, 0x1b, 0xe8, 0x51, 0x23, 0x60, 0x1c,
-	0xb0, 0x3e, 0x34, 0xa5, 0x72, 0xa7, 0x22, 0xd4, 0x5c, 0x1a, 0x7b, 0x13, 0xed, 0x20, 0xd5, 0x67,
-	0x08, 0x8d, 0x03, 0x93, 0x68, 0xfe, 0x35, 0xf7, 0x53, 0x1d, 0xa3, 0x43, 0x8b, 0x1c, 0x32, 0x68,
-	0x1c, 0x0c, 0x12, 0x18, 0xdc, 0x55, 0x2e, 0x95, 0xc4, 0x91, 0xe2, 0x26, 0x83, 0x3a, 0xd6, 0x5e,
-	0x88, 0x95, 0x6a, 0x4d, 0x68, 0xc1, 0x5e, 0x00, 0xdd, 0xd5, 0x35, 0x29, 0xdf, 0xea, 0x57, 0x4f,
-	0x1a, 0x67, 0xc7, 0xc3, 0x32, 0x57, 0x87, 0x78, 0xec, 0x84, 0x52, 0x78, 0xc1, 0xf5, 0xe0, 0xdf,
-	0xbb, 0xd0, 0x40, 0xec, 0x15, 0xd7, 0x9e, 0x08, 0x0b, 0xe9, 0x25, 0x22, 0xdc, 0x92, 0x5e, 0xe2,
-	0xc1, 0x2d, 0xe9, 0x25, 0x2a, 0xac, 0xd2, 0xfb, 0x29, 0x34, 0x7c, 0xc9, 0x3d, 0xcd, 0x5d, 0x43,
-	0x57, 0x24, 0x44, 0xe3, 0xac, 0x37, 0x24, 0x2e, 0x0f, 0x33, 0x2e, 0x0f, 0xbf, 0xc8, 0xb8, 0x3c,
-	0x01, 0x72, 0x37, 0xc0, 0x86, 0xda, 0xec, 0xe0, 0xb3, 0x4b, 0xb5, 0xf9, 0x00, 0x9a, 0xf6, 0xfe,
-	0xe4, 0x44, 0xf4, 0xa1, 0x76, 0xd8, 0x54, 0xbe, 0x3d, 0xb4, 0xaf, 0xca, 0xf7, 0x21, 0xb4, 0x57,
-	0xe5, 0xb3, 0x0c, 0x32, 0x1e, 0xcd, 0xac, 0x80, 0x78, 0xcb, 0x8f, 0xa0, 0xb3, 0xf2, 0x4a, 0x3c,
-	0xe9, 0x2d, 0x9c, 0xba, 0x8d, 0xc6, 0xba, 0x7d, 0x6e, 0x40, 0x4b, 0x06, 0x7d, 0x9d, 0x70, 0x3a,
-	0x0b, 0xa8, 0xa9, 0xa4, 0xfa, 0xe2, 0x3a, 0xe1, 0x78, 0x52, 0x89, 0x0c, 0x0d, 0x72, 0x58, 0x91,
-	0xc1, 0x38, 0xd8, 0x68, 0xf1, 0x84, 0x26, 0x39, 0x10, 0x84, 0x27, 0x9c, 0x02, 0xb3, 0x0e, 0x01,
-	0x92, 0x06, 0x45, 0xc3, 0x69, 0xa1, 0xdf, 0x3e, 0x59, 0x5e, 0xad, 0x0c, 0xec, 0x29, 0xb4, 0xac,
-	0xbb, 0x1f, 0x47, 0x53, 0x31, 0x73, 0xda, 0x74, 0x3f, 0x02, 0x5f, 0x22, 0x66, 0xfa, 0x19, 0x53,
-	0x1f, 0x4b, 0xa7, 0x43, 0xe5, 0xb7, 0x4b, 0xf6, 0x09, 0x1c, 0x7a, 0x57, 0x9e, 0x08, 0x4d, 0x45,
-	0x4d, 0x8e, 0xa5, 0xa6, 0x62, 0x76, 0xd1, 0x8d, 0x2d, 0x6d, 0x17, 0xc6, 0x84, 0x85, 0x7b, 0x06,
-	0x2b, 0xd4, 0xe5, 0x51, 0x40, 0xfe, 0xfb, 0xe8, 0xdf, 0x5d, 0x5a, 0x7e, 0x14, 0x05, 0xe8, 0xdd,
-	0x83, 0x5a, 0xe8, 0x45, 0xb3, 0xd4, 0x9b, 0x71, 0x87, 0x51, 0x6d, 0xb2, 0xb5, 0x89, 0x6a, 0xc1,
-	0xb5, 0x14, 0xbe, 0x72, 0x0e, 0xa8, 0xe7, 0xed, 0xd2, 0x24, 0x49, 0xa6, 0x21, 0x57, 0xae, 0x1f,
-	0xa7, 0x91, 0x76, 0x0e, 0xb1, 0x23, 0x00, 0xa1, 0x97, 0x06, 0x31, 0xc2, 0x92, 0xc4, 0x4a, 0x68,
-	0x71, 0xb5, 0x74, 0x7a, 0x84, 0x4e, 0xed, 0x25, 0x4c, 0x8e, 0xcf, 0xe1, 0x68, 0x11, 0x2b, 0xed,
-	0x4a, 0xee, 0xf3, 0x48, 0xbb, 0xc4, 0x25, 0x8c, 0xf8, 0x08, 0xa3, 0x39, 0x30, 0xd6, 0x09, 0x1a,
-	0xb1, 0x61, 0x30, 0xe8, 0xef, 0x02, 0x8b, 0xa6, 0xae, 0x17, 0x04, 0x92, 0x2b, 0xe5, 0x86, 0x42,
-	0x61, 0xe3, 0x1c, 0xe3, 0x86, 0x4e, 0x34, 0x3d, 0x27, 0xc3, 0x6b, 0xa1, 0xf4, 0x38, 0x18, 0xfc,
-	0xb5, 0x0a, 0xef, 0x16, 0xda, 0x9b, 0x7a, 0x4e, 0x7d, 0xab, 0xc3, 0xff, 0x57, 0x1d, 0xce, 0x51,
-	0x98, 0x24, 0x78, 0x49, 0xe1, 0xb2, 0x42, 0xb7, 0xee, 0x53, 0xe8, 0xf6, 0x9a, 0x42, 0xff, 0x0a,
-	0xde, 0xdb, 0x5c, 0xc2, 0x3b, 0xb5, 0xf9, 0x33, 0xe8, 0xe0, 0xfd, 0x03, 0xf4, 0xce, 0x29, 0xf4,
-	0xe3, 0x5b, 0x14, 0x9a, 0x8e, 0x9d, 0xb4, 0x73, 0xbb, 0x8c, 0x5a, 0xff, 0x65, 0x0b, 0xda, 0xd9,
-	0x38, 0xb0, 0xa9, 0x78, 0x0a, 0xad, 0x65, 0xc1, 0x30, 0xdf, 0x15, 0xab, 0x5a, 0x16, 0xc4, 0x94,
-	0x3f, 0x85, 0x96, 0x9f, 0x4a, 0x69, 0x78, 0x1d, 0xf2, 0x2b, 0x1e, 0x5a, 0xf6, 0x34, 0x2d, 0xf8,
-	0xda, 0x60, 0x26, 0xf7, 0x59, 0x4b, 0xd8, 0x46, 0xa9, 0xe2, 0x1d, 0x5a, 0x19, 0x4a, 0x7d, 0xf2,
-	0x09, 0x1c, 0xfa, 0xe9, 0x22, 0x0d, 0x3d, 0xcd, 0x03, 0x57, 0x99, 0xb6, 0x26, 0x67, 0x62, 0x17,
-	0x5b, 0xda, 0x2e, 0x78, 0x14, 0x2c, 0x77, 0x44, 0xfc, 0x6b, 0xd3, 0x59, 0xe8, 0x2e, 0x22, 0xcd,
-	0xe5, 0x95, 0x17, 0x5a, 0xe2, 0x31, 0x63, 0x9b, 0xa0, 0x69, 0x6c, 0x2d, 0x46, 0x39, 0x70, 0x87,
-	0x01, 0x51, 0x3d, 0xb0, 0x0f, 0x89, 0x88, 0x5d, 0x63, 0xb9, 0xb0, 0x06, 0xdb, 0x84, 0xfb, 0xde,
-	0x6c, 0x26, 0xf9, 0x0c, 0x43, 0xc2, 0x94, 0x29, 0x2b, 0xef, 0xdd, 0x95, 0x81, 0x06, 0xea, 0xe0,
-	0x6f, 0xdb, 0x76, 0xe0, 0xd9, 0xfc, 0x1d, 0xc3, 0x9e, 0x51, 0x8b, 0xd5, 0xbc, 0xdb, 0x35, 0xcb,
-	0x71, 0x60, 0x38, 0x86, 0x86, 0xdc, 0xb4, 0xab, 0x19, 0xe0, 0xde, 0x61, 0xf7, 0x31, 0x74, 0x16,
-	0x71, 0x24, 0x0c, 0x85, 0x12, 0x2e, 0x45, 0x1c, 0x28, 0x9b, 0x9b, 0xb6, 0x85, 0x3f, 0x27, 0xd4,
-	0x1c, 0xa2, 0x4c, 0x93, 0x0a, 0x7d, 0x6d, 0x47, 0xda, 0x72, 0x6d, 0xa6, 0x99, 0x95, 0x38, 0x1c,
-	0x22, 0xd9, 0x34, 0xb3, 0x98, 0x19, 0x22, 0xa6, 0x5e, 0x7e, 0x1c, 0x05, 0xc2, 0x88, 0x3b, 0x39,
-	0xd1, 0x9d, 0x5b, 0x4b, 0x14, 0xdd, 0xde, 0x07, 0xd0, 0x73, 0xc9, 0xd5, 0x3c, 0x0e, 0x03, 0x65,
-	0x67, 0x5a, 0x0e, 0x61, 0x0c, 0xb6, 0xd3, 0x48, 0x68, 0x3b, 0xc6, 0xf0, 0x7f, 0x93, 0x51, 0xdf,
-	0xd0, 0xd9, 0x4f, 0x73, 0x6c, 0x00, 0xbc, 0x44, 0x37, 0x67, 0xa0, 0xf2, 0x3a, 0xb0, 0x27, 0xa2,
-	0xb9, 0xb8, 0x14, 0x1a, 0x87, 0x58, 0x6d, 0x92, 0x2d, 0x4d, 0x37, 0x51, 0xc0, 0x85, 0x09, 0x46,
-	0x10, 0xa6, 0xf1, 0x07, 0x50, 0xcf, 0x78, 0xaa, 0xb0, 0x1b, 0x1b, 0x67, 0xfd, 0xf5, 0x8e, 0x28,
-	0x32, 0x7e, 0xb2, 0xda, 0x52, 0x7e, 0xaf, 0x68, 0xbf, 0xd5, 0x7b, 0xc5, 0xa7, 0xd0, 0x48, 0x93,
-	0x60, 0xb9, 0xb9, 0x73, 0xff, 0x66, 0x72, 0x37, 0xc0, 0xe0, 0x9f, 0x55, 0xe8, 0x15, 0x84, 0xc0,
-	0x06, 0xf7, 0xad, 0x94, 0x7f, 0x53, 0xa4, 0x3c, 0xdf, 0xf9, 0x1d, 0x34, 0xda, 0xce, 0x1f, 0xfc,
-	0xb2, 0x34, 0xa6, 0xb3, 0xd2, 0x3e, 0x48, 0xe2, 0xe9, 0xae, 0x0f, 0x90, 0x78, 0x7b, 0x6a, 0x3b,
-	0xb7, 0xcb, 0x48, 0xfc, 0x9f, 0x77, 0xa0, 0xf5, 0x63, 0xa1, 0x74, 0x2c, 0xaf, 0xed, 0x2b, 0xf9,
-	0x63, 0x80, 0x39, 0x01, 0x2b, 0x91, 0xaa, 0x5b, 0x64, 0x1c, 0x18, 0xa5, 0xc8, 0xcc, 0x39, 0xa9,
-	0x6a, 0x58, 0x0c, 0xcb, 0x94, 0xbb, 0x69, 0xf5, 0x76, 0x8d, 0xdb, 0x2e, 0x69, 0xdc, 0x21, 0xec,
-	0xf0, 0x2b, 0x1e, 0x69, 0xab, 0x4d, 0xb4, 0x30, 0xac, 0x8a, 0x62, 0x2d, 0xa6, 0xc2, 0xc7, 0xef,
-	0x52, 0x73, 0xa6, 0x65, 0x55, 0x1e, 0x1e, 0x07, 0x6c, 0x04, 0x07, 0x05, 0x47, 0x4b, 0x02, 0xd2,
-	0x28, 0x96, 0x37, 0x59, 0x26, 0xe4, 0xe5, 0xb0, 0x56, 0x92, 0xc3, 0xf2, 0xeb, 0x74, 0x7d, 0xed,
-	0x75, 0x7a, 0xfd, 0xf5, 0x1d, 0x36, 0xbc, 0xbe, 0x97, 0x14, 0xa9, 0xb1, 0xa6, 0x48, 0xeb, 0xa2,
-	0xda, 0xbc, 0x5f, 0x54, 0x5b, 0xb7, 0x8a, 0x6a, 0x3b, 0x27, 0xaa, 0xc5, 0x5e, 0xea, 0x94, 0xbf,
-	0x9f, 0x36, 0x7c, 0x59, 0x74, 0x37, 0x7d, 0x59, 0xac, 0x0d, 0xfc, 0xfd, 0x0d, 0x03, 0xbf, 0x24,
-	0x8c, 0xec, 0x7f, 0x11, 0xc6, 0x83, 0xb7, 0x12, 0xc6, 0x3f, 0x55, 0x57, 0x6f, 0x48, 0x05, 0x1e,
-	0x7f, 0x23, 0xa5, 0xb1, 0xd8, 0x7b, 0x24, 0x8e, 0x77, 0xf4, 0x1e, 0x09, 0x64, 0xa1, 0xf7, 0x8a,
-	0x55, 0xaf, 0x97, 0x15, 0xb4, 0xd0, 0x81, 0x24, 0x90, 0x1b, 0x3a, 0x90, 0x74, 0xd1, 0x76, 0x60,
-	0xae, 0x9b, 0x9b, 0x79, 0xdd, 0x5a, 0x67, 0x06, 0x89, 0x62, 0x91, 0x19, 0x47, 0xb0, 0x4b, 0x5f,
-	0x38, 0xc8, 0xcd, 0xda, 0xc4, 0xae, 0x06, 0xbf, 0xa9, 0xc0, 0xe3, 0x5b, 0xea, 0x76, 0xa7, 0xee,
-	0xbd, 0x86, 0x7d, 0x7b, 0xdd, 0xb5, 0x97, 0xdb, 0x27, 0xeb, 0xca, 0x57, 0x3c, 0xb9, 0x5b, 0xd8,
-	0x79, 0xc1, 0xf5, 0xd9, 0x7f, 0x76, 0x80, 0xa1, 0x3a, 0xfe, 0xd4, 0x8b, 0xbc, 0x19, 0x97, 0x2f,
-	0xf1, 0x97, 0x37, 0xf6, 0xf7, 0x4a, 0x69, 0xda, 0x16, 0x7e, 0x18, 0x61, 0xcf, 0xd7, 0x1f, 0x74,
-	0xef, 0xaf, 0x5e, 0xbd, 0x17, 0x6f, 0xb7, 0x89, 0x92, 0x30, 0x18, 0xdf, 0x9c, 0x7f, 0xc4, 0x3e,
-	0x0c, 0xac, 0x63, 0x9f, 0xc4, 0xbb, 0xff, 0x46, 0xe8, 0x79, 0x3f, 0x4b, 0x73, 0x9f, 0x18, 0xf5,
-	0xeb, 0x7f, 0xfc, 0xeb, 0xb7, 0x5b, 0x3d, 0xe6, 0x8c, 0xae, 0xbe, 0x37, 0x22, 0x37, 0xd7, 0xb8,
-	0xb9, 0x99, 0x1b, 0xfb, 0x7d, 0x05, 0x0e, 0x37, 0x7d, 0x4b, 0xb0, 0xd3, 0x7b, 0x22, 0x2b, 0x7e,
-	0x36, 0xf6, 0x86, 0x0f, 0x75, 0xb7, 0x57, 0x78, 0x71, 0x73, 0xee, 0xb0, 0xa3, 0xe2, 0x15, 0xfa,
-	0x54, 0x02, 0x85, 0x41, 0x1f, 0xb0, 0xfd, 0x65, 0xd0, 0xae, 0x35, 0xb0, 0xdf, 0x55, 0xe0, 0x60,
-	0xc3, 0x54, 0x64, 0xcf, 0xee, 0x79, 0x7a, 0xe1, 0xbd, 0xa8, 0x77, 0xfa, 0x40, 0x6f, 0x1b, 0xea,
-	0xd9, 0xcd, 0xf9, 0x31, 0x7b, 0x54, 0x0a, 0x95, 0x06, 0x09, 0x46, 0xca, 0x58, 0x77, 0x15, 0x29,
-	0xe1, 0xec, 0x8f, 0x15, 0x78, 0xb4, 0x91, 0xc8, 0xec, 0x8e, 0x44, 0x6d, 0x52, 0xaa, 0xde, 0xe8,
-	0xc1, 0xfe, 0x36, 0xdc, 0xef, 0xdf, 0x9c, 0xbf, 0xc3, 0x8e, 0x97, 0xe1, 0x5a, 0x76, 0xdb, 0xdc,
-	0x62, 0xc0, 0x8f, 0xd8, 0x81, 0x09, 0x38, 0x13, 0x0d, 0x9b, 0xdc, 0x1f, 0x6e, 0xff, 0x7c, 0x2b,
-	0xb9, 0xbc, 0xdc, 0x45, 0x69, 0x7d, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xc1, 0x70,
-	0xc4, 0x70, 0x16, 0x00, 0x00,
+	// 2053 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0xd6, 0x78, 0x7c, 0x99, 0x39, 0x73, 0x75, 0xf9, 0xd6, 0x99, 0x24, 0x9b, 0xce, 0x24, 0xd9,
+	0xf5, 0xee, 0xc6, 0x76, 0x70, 0x82, 0x90, 0x58, 0x69, 0x85, 0x49, 0x88, 0xd6, 0x22, 0x8b, 0x56,
+	0xe3, 0x45, 0x2b, 0xf1, 0xc0, 0xa8, 0xdd, 0x5d, 0x33, 0x6e, 0xa5, 0xa7, 0xab, 0xa9, 0xaa, 0xb6,
+	0xd7, 0xc0, 0x13, 0xbc, 0xf1, 0x68, 0x24, 0x7e, 0x02, 0x2f, 0x08, 0x09, 0x90, 0x78, 0x41, 0x42,
+	0x08, 0x89, 0x5f, 0x00, 0x12, 0x12, 0xef, 0xfc, 0x10, 0x54, 0xe7, 0x54, 0xcf, 0x74, 0xcf, 0x8c,
+	0x2f, 0x11, 0x08, 0x69, 0xa5, 0x3c, 0xd9, 0x75, 0xce, 0x57, 0xd5, 0xe7, 0xfe, 0x55, 0x0d, 0xd4,
+	0xfd, 0x54, 0x69, 0x31, 0xda, 0x4d, 0xa4, 0xd0, 0x82, 0xb5, 0x5f, 0xa7, 0xc7, 0x5c, 0x25, 0x27,
+	0x5c, 0xf2, 0x5d, 0x2f, 0xe2, 0x52, 0x77, 0xee, 0x0c, 0x85, 0x18, 0x46, 0x7c, 0xcf, 0x4b, 0xc2,
+	0x3d, 0x2f, 0x8e, 0x85, 0xf6, 0x74, 0x28, 0x62, 0x45, 0xf8, 0xce, 0x3b, 0x56, 0x8b, 0xab, 0xe3,
+	0x74, 0xb0, 0x77, 0x26, 0xbd, 0x24, 0xe1, 0x32, 0xd3, 0x3f, 0xc6, 0x3f, 0xfe, 0xce, 0x90, 0xc7,
+	0x3b, 0xea, 0xcc, 0x1b, 0x0e, 0xb9, 0xdc, 0x13, 0x09, 0x9e, 0x30, 0xe7, 0xb4, 0x7b, 0xd3, 0xa7,
+	0xe9, 0x70, 0xc4, 0x95, 0xf6, 0x46, 0x89, 0x05, 0xd4, 0xd0, 0x26, 0x5a, 0x74, 0x7f, 0x5b, 0x86,
+	0xfb, 0x2f, 0xb8, 0xf2, 0x65, 0x78, 0xcc, 0x0f, 0x8c, 0x5c, 0x7d, 0x11, 0xea, 0x93, 0x1e, 0x57,
+	0x22, 0x95, 0x3e, 0xef, 0xf1, 0x1f, 0xa5, 0x5c, 0x69, 0x76, 0x0f, 0x6a, 0x8a, 0x7b, 0xd2, 0x3f,
+	0xe9, 0x9f, 0x09, 0x19, 0x38, 0x25, 0xb7, 0xb4, 0x5d, 0xed, 0x01, 0x89, 0xbe, 0x10, 0x32, 0x60,
+	0xb7, 0xa0, 0xa2, 0x84, 0xd4, 0xfd, 0xd7, 0xfc, 0xdc, 0x59, 0x40, 0xed, 0x8a, 0x59, 0x7f, 0x97,
+	0x9f, 0x33, 0x07, 0x56, 0x24, 0x3f, 0xe5, 0x52, 0x71, 0xa7, 0xec, 0x96, 0xb6, 0x2b, 0xbd, 0x6c,
+	0xc9, 0x36, 0x61, 0x59, 0x0c, 0x06, 0x8a, 0x6b, 0x67, 0xd1, 0x2d, 0x6d, 0x37, 0x7a, 0x76, 0xc5,
+	0xd6, 0x61, 0x29, 0x0a, 0x47, 0xa1, 0x76, 0x96, 0x50, 0x4c, 0x0b, 0xf6, 0x1e, 0xb4, 0xa4, 0x35,
+	0xab, 0x4f, 0x5f, 0x76, 0x96, 0xf1, 0x4b, 0xcd, 0x4c, 0x7c, 0x84, 0x52, 0x63, 0x0b, 0x7a, 0xd8,
+	0x0f, 0x03, 0x67, 0xc5, 0x2d, 0x1b, 0x5b, 0x70, 0x7d, 0x18, 0xb0, 0xbb, 0x00, 0xa4, 0x8a, 0xbd,
+	0x11, 0x77, 0x2a, 0xa8, 0xac, 0xa2, 0xe4, 0x7b, 0xde, 0x88, 0xb3, 0x0e, 0x54, 0x82, 0x50, 0x79,
+	0xc7, 0x11, 0x0f, 0x9c, 0xaa, 0x5b, 0xde, 0xae, 0xf4, 0xc6, 0x6b, 0xf6, 0x08, 0x9a, 0x32, 0x8d,
+	0xe3, 0x30, 0x1e, 0xf6, 0x95, 0xf6, 0x74, 0xaa, 0x1c, 0xc0, 0xed, 0x0d, 0x2b, 0x3d, 0x42, 0x21,
+	0xbb, 0x0d, 0xd5, 0x44, 0x44, 0xa1, 0x7f, 0x6e, 0xbe, 0x5e, 0x43, 0x44, 0x85, 0x04, 0x87, 0x01,
+	0x73, 0xa1, 0x2e, 0x55, 0x7f, 0x10, 0x46, 0x9a, 0x4b, 0xa3, 0xaf, 0xa3, 0x1e, 0xa4, 0x7a, 0x89,
+	0xa2, 0xc3, 0xc0, 0x04, 0x9a, 0x7f, 0xc9, 0xfd, 0x54, 0x0b, 0x04, 0x34, 0x08, 0x90, 0x89, 0x0e,
+	0x83, 0x6e, 0x02, 0xdd, 0xab, 0xd2, 0xa5, 0x12, 0x11, 0x2b, 0x6e, 0x22, 0xa8, 0x85, 0xf6, 0x22,
+	0xcc, 0x54, 0xa3, 0x47, 0x0b, 0xf6, 0x0c, 0xc8, 0xd7, 0xbe, 0x09, 0xf9, 0x82, 0x5b, 0xde, 0xae,
+	0xed, 0x6f, 0xed, 0x4e, 0xd7, 0xea, 0x2e, 0x1e, 0xdb, 0xa3, 0x10, 0x1e, 0x71, 0xdd, 0xfd, 0x4b,
+	0x05, 0x6a, 0x28, 0x7b, 0xc1, 0xb5, 0x17, 0x46, 0x85, 0xf0, 0x52, 0x21, 0x5c, 0x12, 0x5e, 0xaa,
+	0x83, 0x4b, 0xc2, 0x4b, 0xa5, 0x30, 0x09, 0xef, 0x47, 0x50, 0xf3, 0x25, 0xf7, 0x34, 0xef, 0x9b,
+	0x72, 0xc5, 0x82, 0xa8, 0xed, 0x77, 0x76, 0xa9, 0x96, 0x77, 0xb3, 0x5a, 0xde, 0xfd, 0x3c, 0xab,
+	0xe5, 0x1e, 0x10, 0xdc, 0x08, 0xe6, 0xe4, 0x66, 0xc9, 0x2d, 0xcd, 0xe6, 0xe6, 0x3e, 0xd4, 0xad,
+	0xff, 0x04, 0xa2, 0xf2, 0xa1, 0x76, 0x98, 0x97, 0xbe, 0x15, 0xb7, 0x54, 0x48, 0xdf, 0x43, 0x68,
+	0x4e, 0xd2, 0x67, 0x2b, 0xc8, 0x20, 0xea, 0x59, 0x02, 0xd1, 0xcb, 0x77, 0xa1, 0x35, 0x41, 0x25,
+	0x9e, 0xf4, 0x46, 0x4e, 0xd5, 0x5a, 0x63, 0x61, 0x9f, 0x19, 0xa1, 0x2d, 0x06, 0x7d, 0x9e, 0x70,
+	0x3a, 0x0b, 0xa8, 0xa9, 0xa4, 0xfa, 0xfc, 0x3c, 0xe1, 0x78, 0xd2, 0x54, 0x31, 0xd4, 0x08, 0x30,
+	0x29, 0x06, 0x03, 0xb0, 0xd6, 0xe2, 0x09, 0x75, 0x02, 0x90, 0x08, 0x4f, 0xd8, 0x01, 0x66, 0x01,
+	0x01, 0x16, 0x0d, 0x0e, 0x0d, 0xa7, 0x81, 0xb8, 0x55, 0xd2, 0xbc, 0x98, 0x28, 0xd8, 0x03, 0x68,
+	0x58, 0xb8, 0x2f, 0xe2, 0x41, 0x38, 0x74, 0x9a, 0xe4, 0x1f, 0x09, 0x9f, 0xa3, 0xcc, 0xf4, 0x33,
+	0x86, 0x5e, 0x48, 0xa7, 0x45, 0xe9, 0xb7, 0x4b, 0xf6, 0x04, 0xd6, 0xbd, 0x53, 0x2f, 0x8c, 0x4c,
+	0x46, 0x4d, 0x8c, 0xa5, 0xa6, 0x64, 0xb6, 0x11, 0xc6, 0xc6, 0xba, 0x23, 0xa3, 0xc2, 0xc4, 0x3d,
+	0x86, 0x89, 0xb4, 0xcf, 0xe3, 0x80, 0xf0, 0xab, 0x88, 0x6f, 0x8f, 0x35, 0xdf, 0x89, 0x03, 0x44,
+	0x77, 0xa0, 0x12, 0x79, 0xf1, 0x30, 0xf5, 0x86, 0xdc, 0x61, 0x94, 0x9b, 0x6c, 0x6d, 0xac, 0x1a,
+	0x71, 0x2d, 0x43, 0x5f, 0x39, 0x6b, 0xd4, 0xf3, 0x76, 0x69, 0x82, 0x24, 0xd3, 0x88, 0xab, 0xbe,
+	0x2f, 0xd2, 0x58, 0x3b, 0xeb, 0xd8, 0x11, 0x80, 0xa2, 0xe7, 0x46, 0x62, 0x06, 0x4b, 0x22, 0x54,
+	0xa8, 0xc3, 0xd3, 0x31, 0x68, 0x03, 0x41, 0xcd, 0xb1, 0x98, 0x80, 0x4f, 0x61, 0x73, 0x24, 0x94,
+	0xee, 0x4b, 0xee, 0xf3, 0x58, 0xf7, 0xa9, 0x96, 0xd0, 0xe2, 0x4d, 0xb4, 0x66, 0xcd, 0x68, 0x7b,
+	0xa8, 0xc4, 0x86, 0x41, 0xa3, 0x3f, 0x04, 0x16, 0x0f, 0xfa, 0x5e, 0x10, 0x48, 0xae, 0x54, 0x3f,
+	0x0a, 0x15, 0x36, 0xce, 0x16, 0x6e, 0x68, 0xc5, 0x83, 0x03, 0x52, 0xbc, 0x0a, 0x95, 0x69, 0xa0,
+	0x9d, 0x7c, 0x3c, 0x94, 0x7f, 0xc2, 0x83, 0x34, 0xe2, 0x8e, 0x43, 0xf9, 0x9a, 0xc4, 0xcf, 0x2a,
+	0x4c, 0x41, 0x0f, 0xa5, 0x48, 0x93, 0x2c, 0x5d, 0xb7, 0xa8, 0xa0, 0x51, 0x66, 0xb3, 0xf5, 0x21,
+	0xac, 0x72, 0xe5, 0x7b, 0x11, 0x52, 0x44, 0x86, 0xeb, 0x50, 0x80, 0x27, 0x0a, 0x0b, 0xbe, 0x0f,
+	0x75, 0x29, 0x52, 0xcd, 0x33, 0xdc, 0x6d, 0x3a, 0x0f, 0x65, 0x16, 0xb2, 0x0f, 0x2b, 0x9e, 0x6f,
+	0xb6, 0x28, 0xe7, 0x0e, 0x4e, 0x10, 0x67, 0xce, 0x04, 0x41, 0x40, 0x2f, 0x03, 0x76, 0xff, 0x5a,
+	0x86, 0xdb, 0x85, 0xa1, 0x45, 0x93, 0x44, 0xbd, 0x65, 0x97, 0xff, 0x29, 0xbb, 0xe4, 0x1a, 0x93,
+	0x88, 0x25, 0x5b, 0xce, 0xf0, 0x4e, 0xe3, 0x3a, 0xde, 0x69, 0xce, 0xf0, 0xce, 0x4f, 0xe1, 0xce,
+	0xfc, 0x14, 0x5e, 0xc9, 0x38, 0x2f, 0xa1, 0x85, 0xfe, 0x07, 0x88, 0xce, 0xf1, 0xce, 0xdd, 0x4b,
+	0x78, 0x87, 0x8e, 0xed, 0x35, 0x73, 0xbb, 0x0c, 0x07, 0xfd, 0xa1, 0x0c, 0xcd, 0x8c, 0xe4, 0x6c,
+	0x28, 0x1e, 0x40, 0x63, 0x9c, 0x30, 0x8c, 0x77, 0xc9, 0xce, 0x62, 0x2b, 0xc4, 0x90, 0x3f, 0x80,
+	0x86, 0x9f, 0x4a, 0x69, 0xba, 0x35, 0xe2, 0xa7, 0x3c, 0xb2, 0xd5, 0x53, 0xb7, 0xc2, 0x57, 0x46,
+	0x66, 0x62, 0x9f, 0x35, 0xba, 0x6d, 0xff, 0x32, 0xfa, 0xd0, 0xc8, 0xa4, 0xd4, 0xfd, 0x4f, 0x60,
+	0xdd, 0x4f, 0x47, 0x69, 0xe4, 0x69, 0x1e, 0xf4, 0x95, 0x19, 0x56, 0x04, 0xa6, 0xea, 0x62, 0x63,
+	0xdd, 0x11, 0x8f, 0x83, 0xf1, 0x8e, 0x98, 0x7f, 0x69, 0xe6, 0x05, 0xc2, 0xc3, 0x58, 0x73, 0x79,
+	0xea, 0x45, 0xb6, 0xf0, 0x98, 0xd1, 0xf5, 0x50, 0x75, 0x68, 0x35, 0x66, 0x1e, 0xe2, 0x0e, 0x23,
+	0xc4, 0x19, 0x80, 0xd3, 0x85, 0x0a, 0xb1, 0x6d, 0x34, 0x47, 0x56, 0x61, 0x47, 0xcb, 0xaa, 0x37,
+	0x1c, 0x4a, 0x3e, 0x44, 0x93, 0x30, 0x64, 0xca, 0x92, 0x56, 0x7b, 0xa2, 0xa0, 0x6b, 0x02, 0xeb,
+	0x42, 0xdd, 0xf3, 0x5f, 0xc7, 0xe2, 0x2c, 0xe2, 0xc1, 0x90, 0xcb, 0x8c, 0xba, 0xf2, 0x32, 0xf6,
+	0x3e, 0xb4, 0x73, 0x6b, 0xfa, 0x38, 0x71, 0x57, 0x2b, 0x27, 0xc7, 0x6f, 0xdf, 0x87, 0xba, 0x8a,
+	0x85, 0xf8, 0x31, 0xef, 0xa7, 0xb1, 0x0e, 0x23, 0xcb, 0x5e, 0x35, 0x92, 0x7d, 0xdf, 0x88, 0xba,
+	0x7f, 0x5b, 0xb4, 0x17, 0x07, 0x9b, 0xb1, 0x2d, 0x58, 0x31, 0x53, 0x77, 0x72, 0x6f, 0x58, 0x36,
+	0xcb, 0xc3, 0xc0, 0x54, 0x35, 0x2a, 0x72, 0xb7, 0x86, 0x8a, 0x11, 0x5c, 0x7b, 0x69, 0x78, 0x0f,
+	0x5a, 0x23, 0x11, 0x87, 0xa6, 0x68, 0x13, 0x2e, 0x43, 0x11, 0x28, 0x9b, 0x8d, 0xa6, 0x15, 0x7f,
+	0x46, 0x52, 0x73, 0x88, 0x32, 0x63, 0x21, 0xd4, 0xe7, 0xf6, 0x6a, 0x30, 0x5e, 0x1b, 0x4f, 0x2c,
+	0x55, 0x20, 0x19, 0x67, 0xb7, 0x02, 0x2b, 0x33, 0x64, 0x6c, 0x2a, 0xc4, 0x17, 0x71, 0x10, 0xe2,
+	0x0c, 0x45, 0x10, 0x45, 0xb9, 0x31, 0x96, 0x22, 0xec, 0x1d, 0x00, 0x7d, 0x22, 0xb9, 0x3a, 0x11,
+	0x51, 0xa0, 0x6c, 0x80, 0x73, 0x12, 0xc6, 0x60, 0x31, 0x8d, 0x43, 0x6d, 0x43, 0x8a, 0xff, 0x9b,
+	0x1c, 0xfa, 0xa6, 0x81, 0xfc, 0x34, 0x57, 0x7f, 0x80, 0x4e, 0xb4, 0x73, 0x0a, 0x2a, 0x28, 0x07,
+	0x56, 0xc2, 0xf8, 0x24, 0x3c, 0x0e, 0x35, 0x5e, 0x06, 0x2a, 0xbd, 0x6c, 0x69, 0xfa, 0x97, 0x0c,
+	0x2e, 0xdc, 0x04, 0x48, 0x84, 0x61, 0xfc, 0x18, 0xaa, 0x59, 0x67, 0x28, 0xec, 0xff, 0xda, 0xbe,
+	0x3b, 0xdb, 0x83, 0xc5, 0x1e, 0xeb, 0x4d, 0xb6, 0x4c, 0xdf, 0xcf, 0x9a, 0x6f, 0x74, 0x3f, 0xfb,
+	0x08, 0x6a, 0x69, 0x12, 0x8c, 0x37, 0xb7, 0xae, 0xdf, 0x4c, 0x70, 0x23, 0xe8, 0xfe, 0xab, 0x0c,
+	0x9d, 0xc2, 0xe8, 0xb1, 0xc6, 0xbd, 0x25, 0x8f, 0xaf, 0x0a, 0x79, 0xe4, 0x3b, 0xbf, 0xe5, 0x96,
+	0x27, 0x9d, 0xdf, 0xfd, 0xc9, 0xd4, 0xc5, 0x20, 0x4b, 0xed, 0x8d, 0x48, 0x85, 0x7c, 0xbd, 0x01,
+	0xa9, 0xd8, 0x53, 0x9b, 0xb9, 0x5d, 0x86, 0x54, 0x7e, 0x55, 0x82, 0xad, 0x83, 0xc9, 0x58, 0x43,
+	0x68, 0x56, 0x55, 0x57, 0x3c, 0x72, 0x72, 0xce, 0x2c, 0x14, 0xc6, 0xd8, 0x0c, 0x23, 0x95, 0xe7,
+	0x30, 0xd2, 0xf4, 0x18, 0x5e, 0x9c, 0x1d, 0xc3, 0xdd, 0xaf, 0x83, 0x33, 0x6b, 0x97, 0x0d, 0xc9,
+	0xe5, 0x86, 0x75, 0x7f, 0x57, 0x02, 0x76, 0x84, 0xf3, 0xf7, 0xff, 0xe3, 0xca, 0x23, 0x68, 0x5a,
+	0x0a, 0x18, 0x85, 0x71, 0xaa, 0x79, 0x36, 0x7c, 0x1b, 0x24, 0xfd, 0x94, 0x84, 0xa6, 0x72, 0x45,
+	0xc2, 0x25, 0x96, 0x96, 0x9d, 0xbd, 0xd9, 0xba, 0xfb, 0x04, 0xd6, 0x0a, 0x16, 0x5f, 0xef, 0xe4,
+	0x9f, 0x97, 0xa0, 0xf1, 0x49, 0xa8, 0xb4, 0x90, 0xe7, 0xf6, 0x3d, 0x7a, 0x17, 0xe0, 0x84, 0x04,
+	0x13, 0x78, 0xd5, 0x4a, 0x0e, 0x03, 0x33, 0xde, 0x33, 0x75, 0x8e, 0x5f, 0x6a, 0x56, 0x86, 0x8e,
+	0xe4, 0xc2, 0x50, 0xbe, 0x9c, 0x98, 0x16, 0xa7, 0x88, 0x69, 0x1d, 0x96, 0xf8, 0x29, 0x8f, 0xb5,
+	0x75, 0x8a, 0x16, 0x66, 0x14, 0xc4, 0x42, 0x87, 0x83, 0xd0, 0xa7, 0x1b, 0x77, 0x18, 0x64, 0xa3,
+	0x20, 0x2f, 0x3e, 0x0c, 0xd8, 0x1e, 0xac, 0x15, 0x80, 0xb6, 0x73, 0x89, 0x58, 0x58, 0x5e, 0x65,
+	0xdb, 0x37, 0xcf, 0x61, 0x95, 0x29, 0x0e, 0x9b, 0x7e, 0x4b, 0x56, 0x67, 0xde, 0x92, 0xb3, 0x6f,
+	0x57, 0x98, 0xf3, 0x76, 0x9d, 0xa2, 0x91, 0xda, 0x0c, 0x8d, 0xcc, 0x32, 0x61, 0xfd, 0x7a, 0x26,
+	0x6c, 0x5c, 0xca, 0x84, 0xcd, 0x1c, 0x13, 0x16, 0x07, 0x60, 0x6b, 0xfa, 0xc7, 0x83, 0x39, 0xcf,
+	0xea, 0xf6, 0xbc, 0x67, 0xf5, 0x4c, 0xe9, 0xae, 0xce, 0x29, 0xdd, 0x29, 0x36, 0x63, 0xff, 0x0d,
+	0x9b, 0xad, 0xbd, 0x11, 0x9b, 0xfd, 0xb1, 0x3c, 0xb9, 0x48, 0x17, 0xea, 0xf8, 0x2b, 0xc9, 0x67,
+	0xc5, 0xde, 0x23, 0x46, 0xbb, 0xa2, 0xf7, 0x88, 0xd5, 0x0a, 0xbd, 0x57, 0xcc, 0x7a, 0x75, 0x9a,
+	0xf6, 0x0a, 0x1d, 0x48, 0xac, 0x36, 0xa7, 0x03, 0x89, 0xcc, 0x68, 0x91, 0xef, 0xe6, 0x7a, 0x9e,
+	0x6c, 0x66, 0x2b, 0x83, 0x98, 0xac, 0x58, 0x19, 0x9b, 0xb0, 0x4c, 0xcf, 0x7b, 0xac, 0xcd, 0x4a,
+	0xcf, 0xae, 0xba, 0x3f, 0x2f, 0xc1, 0xdd, 0x4b, 0xf2, 0x76, 0x25, 0x59, 0xbd, 0x82, 0x55, 0xeb,
+	0xee, 0xcc, 0x1b, 0xe8, 0xde, 0x2c, 0x5d, 0x15, 0x4f, 0x6e, 0x17, 0x76, 0x1e, 0x71, 0xbd, 0xff,
+	0x8b, 0x2a, 0x30, 0x1c, 0x95, 0x9f, 0x7a, 0xb1, 0x37, 0xe4, 0xf2, 0x39, 0xfe, 0xec, 0xcc, 0xfe,
+	0x5e, 0x82, 0xce, 0xe5, 0xbf, 0x0a, 0xb2, 0xa7, 0xb3, 0x1f, 0xba, 0xf6, 0x27, 0xdf, 0xce, 0xb3,
+	0x37, 0xdb, 0x44, 0x41, 0xe8, 0x1e, 0x5e, 0x1c, 0xbc, 0xcb, 0x1e, 0x06, 0x16, 0xe8, 0xe2, 0x3e,
+	0xe5, 0x9e, 0x85, 0xfa, 0xc4, 0xcd, 0xc2, 0xec, 0x52, 0x45, 0xfd, 0xec, 0x1f, 0xff, 0xfe, 0xe5,
+	0x42, 0x87, 0x39, 0x7b, 0xa7, 0x5f, 0xdb, 0x23, 0x58, 0xdf, 0xc0, 0xfa, 0x19, 0x8c, 0xfd, 0xa6,
+	0x04, 0xeb, 0xf3, 0x9e, 0x9c, 0x6c, 0xe7, 0x1a, 0xcb, 0x8a, 0xbf, 0x2e, 0x74, 0x76, 0x6f, 0x0a,
+	0xb7, 0x2e, 0x3c, 0xbb, 0x38, 0x70, 0xd8, 0x66, 0xd1, 0x05, 0x97, 0x52, 0xa0, 0xd0, 0xe8, 0x35,
+	0xb6, 0x3a, 0x36, 0xba, 0x6f, 0x15, 0xec, 0xd7, 0x25, 0x58, 0x9b, 0x73, 0x95, 0x61, 0x8f, 0xaf,
+	0xf9, 0x7a, 0xe1, 0x32, 0xdb, 0xd9, 0xb9, 0x21, 0xda, 0x9a, 0xba, 0x7f, 0x71, 0xb0, 0xc5, 0x36,
+	0xa6, 0x4c, 0x25, 0x22, 0x41, 0x4b, 0x19, 0x6b, 0x4f, 0x2c, 0x25, 0x39, 0xfb, 0x67, 0x09, 0xda,
+	0xd3, 0xb7, 0x0b, 0xf6, 0xfe, 0xbc, 0x1f, 0x71, 0xe6, 0xde, 0x8c, 0x3a, 0x1f, 0xdc, 0x04, 0x6a,
+	0xed, 0x7b, 0x7d, 0x71, 0xf0, 0x09, 0x7b, 0x99, 0xbb, 0xdb, 0xb8, 0x83, 0x50, 0x86, 0xf1, 0x70,
+	0x52, 0x0b, 0x62, 0x60, 0xad, 0x36, 0x3d, 0xea, 0x6a, 0xe1, 0x2a, 0x2d, 0x12, 0x57, 0xf2, 0x84,
+	0x7b, 0xda, 0xcd, 0x93, 0x20, 0x39, 0xd4, 0xf9, 0x66, 0xe9, 0x83, 0xee, 0xc6, 0xc4, 0xa7, 0xdc,
+	0xd1, 0xec, 0x4f, 0x25, 0xa8, 0xe5, 0x2e, 0x13, 0xec, 0xe1, 0xac, 0xa1, 0xb3, 0xb7, 0xa3, 0xce,
+	0xa3, 0x6b, 0x50, 0xd6, 0x93, 0x1f, 0x5e, 0x1c, 0x7c, 0x8b, 0x7d, 0x4c, 0x17, 0x1b, 0x57, 0x48,
+	0x37, 0x8d, 0xed, 0xff, 0x05, 0x1b, 0x8d, 0x3b, 0x22, 0xe6, 0x97, 0xb8, 0x87, 0x1e, 0x6c, 0x18,
+	0x0f, 0xf2, 0x59, 0xc1, 0x63, 0xd8, 0xef, 0x4b, 0xb0, 0x31, 0x77, 0xbc, 0xb0, 0x2b, 0xca, 0x77,
+	0x1e, 0x7f, 0x74, 0xf6, 0x6e, 0x8c, 0xb7, 0xae, 0x7d, 0xe3, 0xe2, 0xe0, 0x16, 0xdb, 0x1a, 0x17,
+	0x91, 0x9d, 0x39, 0xb6, 0xe2, 0xc9, 0x66, 0xb6, 0x66, 0x0c, 0xb6, 0x9a, 0xac, 0xe4, 0xbf, 0xbd,
+	0xf8, 0x83, 0x85, 0xe4, 0xf8, 0x78, 0x19, 0x09, 0xef, 0xe9, 0x7f, 0x06, 0x00, 0x5a, 0xcd, 0x49,
+	0xe1, 0x03, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1575,6 +1878,8 @@ type AlertManagerCustomClient interface {
 	DescribeAlertsWithResource(ctx context.Context, in *DescribeAlertsWithResourceRequest, opts ...grpc.CallOption) (*DescribeAlertsWithResourceResponse, error)
 	DescribeAlertDetails(ctx context.Context, in *DescribeAlertDetailsRequest, opts ...grpc.CallOption) (*DescribeAlertDetailsResponse, error)
 	DescribeAlertStatus(ctx context.Context, in *DescribeAlertStatusRequest, opts ...grpc.CallOption) (*DescribeAlertStatusResponse, error)
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error)
 	//1.History
 	//********************************************************************************************************
 	DescribeHistoryDetail(ctx context.Context, in *DescribeHistoryDetailRequest, opts ...grpc.CallOption) (*DescribeHistoryDetailResponse, error)
@@ -1615,6 +1920,24 @@ func (c *alertManagerCustomClient) DescribeAlertStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *alertManagerCustomClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManagerCustom/AcknowledgeAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertManagerCustomClient) SnoozeAlert(ctx context.Context, in *SnoozeAlertRequest, opts ...grpc.CallOption) (*SnoozeAlertResponse, error) {
+	out := new(SnoozeAlertResponse)
+	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManagerCustom/SnoozeAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertManagerCustomClient) DescribeHistoryDetail(ctx context.Context, in *DescribeHistoryDetailRequest, opts ...grpc.CallOption) (*DescribeHistoryDetailResponse, error) {
 	out := new(DescribeHistoryDetailResponse)
 	err := c.cc.Invoke(ctx, "/kubesphere.alert.AlertManagerCustom/DescribeHistoryDetail", in, out, opts...)
@@ -1631,6 +1954,8 @@ type AlertManagerCustomServer interface {
 	DescribeAlertsWithResource(context.Context, *DescribeAlertsWithResourceRequest) (*DescribeAlertsWithResourceResponse, error)
 	DescribeAlertDetails(context.Context, *DescribeAlertDetailsRequest) (*DescribeAlertDetailsResponse, error)
 	DescribeAlertStatus(context.Context, *DescribeAlertStatusRequest) (*DescribeAlertStatusResponse, error)
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	SnoozeAlert(context.Context, *SnoozeAlertRequest) (*SnoozeAlertResponse, error)
 	//1.History
 	//********************************************************************************************************
 	DescribeHistoryDetail(context.Context, *DescribeHistoryDetailRequest) (*DescribeHistoryDetailResponse, error)
@@ -1649,6 +1974,12 @@ func (*UnimplementedAlertManagerCustomServer) DescribeAlertDetails(ctx context.C
 func (*UnimplementedAlertManagerCustomServer) DescribeAlertStatus(ctx context.Context, req *DescribeAlertStatusRequest) (*DescribeAlertStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeAlertStatus not implemented")
 }
+func (*UnimplementedAlertManagerCustomServer) AcknowledgeAlert(ctx context.Context, req *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (*UnimplementedAlertManagerCustomServer) SnoozeAlert(ctx context.Context, req *SnoozeAlertRequest) (*SnoozeAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeAlert not implemented")
+}
 func (*UnimplementedAlertManagerCustomServer) DescribeHistoryDetail(ctx context.Context, req *DescribeHistoryDetailRequest) (*DescribeHistoryDetailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeHistoryDetail not implemented")
 }
@@ -1711,6 +2042,42 @@ func _AlertManagerCustom_DescribeAlertStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertManagerCustom_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerCustomServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManagerCustom/AcknowledgeAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerCustomServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertManagerCustom_SnoozeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertManagerCustomServer).SnoozeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubesphere.alert.AlertManagerCustom/SnoozeAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertManagerCustomServer).SnoozeAlert(ctx, req.(*SnoozeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertManagerCustom_DescribeHistoryDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeHistoryDetailRequest)
 	if err := dec(in); err != nil {
@@ -1745,6 +2112,14 @@ var _AlertManagerCustom_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeAlertStatus",
 			Handler:    _AlertManagerCustom_DescribeAlertStatus_Handler,
 		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _AlertManagerCustom_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "SnoozeAlert",
+			Handler:    _AlertManagerCustom_SnoozeAlert_Handler,
+		},
 		{
 			MethodName: "DescribeHistoryDetail",
 			Handler:    _AlertManagerCustom_DescribeHistoryDetail_Handler,
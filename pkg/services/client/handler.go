@@ -17,6 +17,7 @@ import (
 	"kubesphere.io/alert/pkg/logger"
 	"kubesphere.io/alert/pkg/models"
 	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
 	"kubesphere.io/alert/pkg/util/stringutil"
 )
 
@@ -558,6 +559,9 @@ func CreatePolicy(request *restful.Request, response *restful.Response) {
 		PolicyConfig:       policy.PolicyConfig,
 		AvailableStartTime: policy.AvailableStartTime,
 		AvailableEndTime:   policy.AvailableEndTime,
+		AvailableSchedule:  policy.AvailableSchedule,
+		GroupConfig:        policy.GroupConfig,
+		RouteConfig:        policy.RouteConfig,
 		RsTypeId:           policy.RsTypeId,
 		Language:           policy.Language,
 	}
@@ -646,8 +650,15 @@ func ModifyPolicy(request *restful.Request, response *restful.Response) {
 		Creator:            policy.Creator,
 		AvailableStartTime: policy.AvailableStartTime,
 		AvailableEndTime:   policy.AvailableEndTime,
+		AvailableSchedule:  policy.AvailableSchedule,
+		GroupConfig:        policy.GroupConfig,
+		RouteConfig:        policy.RouteConfig,
 		RsTypeId:           policy.RsTypeId,
 		Language:           policy.Language,
+
+		ClearAvailableSchedule: parseBool(request.QueryParameter("clear_available_schedule")),
+		ClearGroupConfig:       parseBool(request.QueryParameter("clear_group_config")),
+		ClearRouteConfig:       parseBool(request.QueryParameter("clear_route_config")),
 	}
 
 	resp, err := client.ModifyPolicy(ctx, req)
@@ -699,10 +710,17 @@ type PolicyByAlert struct {
 	Creator            string    `json:"creator"`
 	AvailableStartTime string    `json:"available_start_time"`
 	AvailableEndTime   string    `json:"available_end_time"`
+	AvailableSchedule  string    `json:"available_schedule"`
+	GroupConfig        string    `json:"group_config"`
+	RouteConfig        string    `json:"route_config"`
 	CreateTime         time.Time `json:"create_time"`
 	UpdateTime         time.Time `json:"update_time"`
 	RsTypeId           string    `json:"rs_type_id"`
 	Language           string    `json:"language"`
+
+	ClearAvailableSchedule bool `json:"clear_available_schedule"`
+	ClearGroupConfig       bool `json:"clear_group_config"`
+	ClearRouteConfig       bool `json:"clear_route_config"`
 }
 
 type ModifyPolicyByAlertResponse struct {
@@ -767,8 +785,15 @@ func modifyPolicyByAlert(resourceMap map[string]string, request *restful.Request
 		Creator:            policyByAlert.Creator,
 		AvailableStartTime: policyByAlert.AvailableStartTime,
 		AvailableEndTime:   policyByAlert.AvailableEndTime,
+		AvailableSchedule:  policyByAlert.AvailableSchedule,
+		GroupConfig:        policyByAlert.GroupConfig,
+		RouteConfig:        policyByAlert.RouteConfig,
 		RsTypeId:           policyByAlert.RsTypeId,
 		Language:           policyByAlert.Language,
+
+		ClearAvailableSchedule: policyByAlert.ClearAvailableSchedule,
+		ClearGroupConfig:       policyByAlert.ClearGroupConfig,
+		ClearRouteConfig:       policyByAlert.ClearRouteConfig,
 	}
 
 	respModify, err := client.ModifyPolicy(ctx, req)
@@ -868,18 +893,23 @@ func CreateRule(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.CreateRuleRequest{
-		RuleName:         rule.RuleName,
-		Disabled:         rule.Disabled,
-		MonitorPeriods:   rule.MonitorPeriods,
-		Severity:         rule.Severity,
-		MetricsType:      rule.MetricsType,
-		ConditionType:    rule.ConditionType,
-		Thresholds:       rule.Thresholds,
-		Unit:             rule.Unit,
-		ConsecutiveCount: rule.ConsecutiveCount,
-		Inhibit:          rule.Inhibit,
-		PolicyId:         rule.PolicyId,
-		MetricId:         rule.MetricId,
+		RuleName:              rule.RuleName,
+		Disabled:              rule.Disabled,
+		MonitorPeriods:        rule.MonitorPeriods,
+		Severity:              rule.Severity,
+		MetricsType:           rule.MetricsType,
+		ConditionType:         rule.ConditionType,
+		Thresholds:            rule.Thresholds,
+		Unit:                  rule.Unit,
+		ConsecutiveCount:      rule.ConsecutiveCount,
+		Inhibit:               rule.Inhibit,
+		PolicyId:              rule.PolicyId,
+		MetricId:              rule.MetricId,
+		PredictHorizon:        rule.PredictHorizon,
+		NodataState:           rule.NodataState,
+		RecoveryThresholds:    rule.RecoveryThresholds,
+		ConsecutiveClearCount: rule.ConsecutiveClearCount,
+		Levels:                rule.Levels,
 	}
 
 	resp, err := client.CreateRule(ctx, req)
@@ -977,17 +1007,22 @@ func ModifyRule(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.ModifyRuleRequest{
-		RuleId:           rule.RuleId,
-		RuleName:         rule.RuleName,
-		Disabled:         rule.Disabled,
-		MonitorPeriods:   rule.MonitorPeriods,
-		Severity:         rule.Severity,
-		MetricsType:      rule.MetricsType,
-		ConditionType:    rule.ConditionType,
-		Thresholds:       rule.Thresholds,
-		Unit:             rule.Unit,
-		ConsecutiveCount: rule.ConsecutiveCount,
-		Inhibit:          rule.Inhibit,
+		RuleId:                rule.RuleId,
+		RuleName:              rule.RuleName,
+		Disabled:              rule.Disabled,
+		MonitorPeriods:        rule.MonitorPeriods,
+		Severity:              rule.Severity,
+		MetricsType:           rule.MetricsType,
+		ConditionType:         rule.ConditionType,
+		Thresholds:            rule.Thresholds,
+		Unit:                  rule.Unit,
+		ConsecutiveCount:      rule.ConsecutiveCount,
+		Inhibit:               rule.Inhibit,
+		PredictHorizon:        rule.PredictHorizon,
+		NodataState:           rule.NodataState,
+		RecoveryThresholds:    rule.RecoveryThresholds,
+		ConsecutiveClearCount: rule.ConsecutiveClearCount,
+		Levels:                rule.Levels,
 	}
 
 	resp, err := client.ModifyRule(ctx, req)
@@ -1354,6 +1389,9 @@ func createAlertInfo(resourceMap map[string]string, request *restful.Request, re
 		Creator:            alertInfo.Policy.Creator,
 		AvailableStartTime: alertInfo.Policy.AvailableStartTime,
 		AvailableEndTime:   alertInfo.Policy.AvailableEndTime,
+		AvailableSchedule:  alertInfo.Policy.AvailableSchedule,
+		GroupConfig:        alertInfo.Policy.GroupConfig,
+		RouteConfig:        alertInfo.Policy.RouteConfig,
 		Language:           alertInfo.Policy.Language,
 		RsTypeId:           alertInfo.RsFilter.RsTypeId,
 	}
@@ -1373,9 +1411,10 @@ func createAlertInfo(resourceMap map[string]string, request *restful.Request, re
 
 	//7. Create Action
 	var reqAction = &pb.CreateActionRequest{
-		ActionName:      alertInfo.Action.ActionName,
-		PolicyId:        policyId,
-		NfAddressListId: alertInfo.Action.NfAddressListId,
+		ActionName:       alertInfo.Action.ActionName,
+		PolicyId:         policyId,
+		NfAddressListId:  alertInfo.Action.NfAddressListId,
+		EscalationConfig: alertInfo.Action.EscalationConfig,
 	}
 
 	respAction, err := client.CreateAction(ctx, reqAction)
@@ -1395,18 +1434,23 @@ func createAlertInfo(resourceMap map[string]string, request *restful.Request, re
 	createRulesSuccess := true
 	for _, rule := range alertInfo.Rules {
 		var reqRule = &pb.CreateRuleRequest{
-			RuleName:         rule.RuleName,
-			Disabled:         rule.Disabled,
-			MonitorPeriods:   rule.MonitorPeriods,
-			Severity:         rule.Severity,
-			MetricsType:      rule.MetricsType,
-			ConditionType:    rule.ConditionType,
-			Thresholds:       rule.Thresholds,
-			Unit:             rule.Unit,
-			ConsecutiveCount: rule.ConsecutiveCount,
-			Inhibit:          rule.Inhibit,
-			PolicyId:         policyId,
-			MetricId:         rule.MetricId,
+			RuleName:              rule.RuleName,
+			Disabled:              rule.Disabled,
+			MonitorPeriods:        rule.MonitorPeriods,
+			Severity:              rule.Severity,
+			MetricsType:           rule.MetricsType,
+			ConditionType:         rule.ConditionType,
+			Thresholds:            rule.Thresholds,
+			Unit:                  rule.Unit,
+			ConsecutiveCount:      rule.ConsecutiveCount,
+			Inhibit:               rule.Inhibit,
+			PolicyId:              policyId,
+			MetricId:              rule.MetricId,
+			PredictHorizon:        rule.PredictHorizon,
+			NodataState:           rule.NodataState,
+			RecoveryThresholds:    rule.RecoveryThresholds,
+			ConsecutiveClearCount: rule.ConsecutiveClearCount,
+			Levels:                rule.Levels,
 		}
 
 		_, err := client.CreateRule(ctx, reqRule)
@@ -2277,11 +2321,12 @@ func CreateAction(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.CreateActionRequest{
-		ActionName:      action.ActionName,
-		TriggerStatus:   action.TriggerStatus,
-		TriggerAction:   action.TriggerAction,
-		PolicyId:        action.PolicyId,
-		NfAddressListId: action.NfAddressListId,
+		ActionName:       action.ActionName,
+		TriggerStatus:    action.TriggerStatus,
+		TriggerAction:    action.TriggerAction,
+		PolicyId:         action.PolicyId,
+		NfAddressListId:  action.NfAddressListId,
+		EscalationConfig: action.EscalationConfig,
 	}
 
 	resp, err := client.CreateAction(ctx, req)
@@ -2365,12 +2410,15 @@ func ModifyAction(request *restful.Request, response *restful.Response) {
 	defer cancel()
 
 	var req = &pb.ModifyActionRequest{
-		ActionId:        action.ActionId,
-		ActionName:      action.ActionName,
-		TriggerStatus:   action.TriggerStatus,
-		TriggerAction:   action.TriggerAction,
-		PolicyId:        action.PolicyId,
-		NfAddressListId: action.NfAddressListId,
+		ActionId:         action.ActionId,
+		ActionName:       action.ActionName,
+		TriggerStatus:    action.TriggerStatus,
+		TriggerAction:    action.TriggerAction,
+		PolicyId:         action.PolicyId,
+		NfAddressListId:  action.NfAddressListId,
+		EscalationConfig: action.EscalationConfig,
+
+		ClearEscalationConfig: parseBool(request.QueryParameter("clear_escalation_config")),
 	}
 
 	resp, err := client.ModifyAction(ctx, req)
@@ -2503,3 +2551,437 @@ func DescribeResourcesPod(request *restful.Request, response *restful.Response)
 
 func DescribeResourcesContainer(request *restful.Request, response *restful.Response) {
 }
+
+func CreateSilence(request *restful.Request, response *restful.Response) {
+	silence := new(models.Silence)
+
+	err := request.ReadEntity(&silence)
+	if err != nil {
+		logger.Debug(nil, "CreateSilence request data error %+v.", err)
+		response.WriteAsJson(&pb.CreateSilenceResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.CreateSilenceResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.CreateSilenceRequest{
+		Creator:   silence.Creator,
+		Comment:   silence.Comment,
+		Matchers:  silence.Matchers,
+		StartTime: pbutil.ToProtoTimestamp(silence.StartTime),
+		EndTime:   pbutil.ToProtoTimestamp(silence.EndTime),
+	}
+
+	resp, err := client.CreateSilence(ctx, req)
+	if err != nil {
+		logger.Error(nil, "CreateSilence failed: %+v", err)
+		response.WriteAsJson(&pb.CreateSilenceResponse{})
+		return
+	}
+
+	logger.Debug(nil, "CreateSilence success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DescribeSilences(request *restful.Request, response *restful.Response) {
+	silenceIds := strings.Split(request.QueryParameter("silence_ids"), ",")
+	creators := strings.Split(request.QueryParameter("creators"), ",")
+	active := parseBool(request.QueryParameter("active"))
+
+	sortKey := request.QueryParameter("sort_key")
+	reverse := parseBool(request.QueryParameter("reverse"))
+	offset, _ := parseUint32(request.QueryParameter("offset"))
+	limit, _ := parseUint32(request.QueryParameter("limit"))
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DescribeSilencesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DescribeSilencesRequest{
+		SilenceId: silenceIds,
+		Creator:   creators,
+		Active:    active,
+		SortKey:   sortKey,
+		Reverse:   reverse,
+		Offset:    offset,
+		Limit:     limit,
+	}
+
+	resp, err := client.DescribeSilences(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DescribeSilences failed: %+v", err)
+		response.WriteAsJson(&pb.DescribeSilencesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DescribeSilences success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func ModifySilence(request *restful.Request, response *restful.Response) {
+	silence := new(models.Silence)
+
+	err := request.ReadEntity(&silence)
+	if err != nil {
+		logger.Debug(nil, "ModifySilence request data error %+v.", err)
+		response.WriteAsJson(&pb.ModifySilenceResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.ModifySilenceResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.ModifySilenceRequest{
+		SilenceId: silence.SilenceId,
+		Creator:   silence.Creator,
+		Comment:   silence.Comment,
+		Matchers:  silence.Matchers,
+		StartTime: pbutil.ToProtoTimestamp(silence.StartTime),
+		EndTime:   pbutil.ToProtoTimestamp(silence.EndTime),
+	}
+
+	resp, err := client.ModifySilence(ctx, req)
+	if err != nil {
+		logger.Error(nil, "ModifySilence failed: %+v", err)
+		response.WriteAsJson(&pb.ModifySilenceResponse{})
+		return
+	}
+
+	logger.Debug(nil, "ModifySilence success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DeleteSilences(request *restful.Request, response *restful.Response) {
+	silenceIds := strings.Split(request.QueryParameter("silence_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DeleteSilencesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DeleteSilencesRequest{
+		SilenceId: silenceIds,
+	}
+
+	resp, err := client.DeleteSilences(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DeleteSilences failed: %+v", err)
+		response.WriteAsJson(&pb.DeleteSilencesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DeleteSilences success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func CreateTemplate(request *restful.Request, response *restful.Response) {
+	template := new(models.Template)
+
+	err := request.ReadEntity(&template)
+	if err != nil {
+		logger.Debug(nil, "CreateTemplate request data error %+v.", err)
+		response.WriteAsJson(&pb.CreateTemplateResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.CreateTemplateResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.CreateTemplateRequest{
+		PolicyId: template.PolicyId,
+		Language: template.Language,
+		Format:   template.Format,
+		Title:    template.Title,
+		Content:  template.Content,
+	}
+
+	resp, err := client.CreateTemplate(ctx, req)
+	if err != nil {
+		logger.Error(nil, "CreateTemplate failed: %+v", err)
+		response.WriteAsJson(&pb.CreateTemplateResponse{})
+		return
+	}
+
+	logger.Debug(nil, "CreateTemplate success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DescribeTemplates(request *restful.Request, response *restful.Response) {
+	templateIds := strings.Split(request.QueryParameter("template_ids"), ",")
+	policyIds := strings.Split(request.QueryParameter("policy_ids"), ",")
+	languages := strings.Split(request.QueryParameter("languages"), ",")
+
+	sortKey := request.QueryParameter("sort_key")
+	reverse := parseBool(request.QueryParameter("reverse"))
+	offset, _ := parseUint32(request.QueryParameter("offset"))
+	limit, _ := parseUint32(request.QueryParameter("limit"))
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DescribeTemplatesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DescribeTemplatesRequest{
+		TemplateId: templateIds,
+		PolicyId:   policyIds,
+		Language:   languages,
+		SortKey:    sortKey,
+		Reverse:    reverse,
+		Offset:     offset,
+		Limit:      limit,
+	}
+
+	resp, err := client.DescribeTemplates(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DescribeTemplates failed: %+v", err)
+		response.WriteAsJson(&pb.DescribeTemplatesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DescribeTemplates success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func ModifyTemplate(request *restful.Request, response *restful.Response) {
+	template := new(models.Template)
+
+	err := request.ReadEntity(&template)
+	if err != nil {
+		logger.Debug(nil, "ModifyTemplate request data error %+v.", err)
+		response.WriteAsJson(&pb.ModifyTemplateResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.ModifyTemplateResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.ModifyTemplateRequest{
+		TemplateId: template.TemplateId,
+		Format:     template.Format,
+		Title:      template.Title,
+		Content:    template.Content,
+	}
+
+	resp, err := client.ModifyTemplate(ctx, req)
+	if err != nil {
+		logger.Error(nil, "ModifyTemplate failed: %+v", err)
+		response.WriteAsJson(&pb.ModifyTemplateResponse{})
+		return
+	}
+
+	logger.Debug(nil, "ModifyTemplate success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DeleteTemplates(request *restful.Request, response *restful.Response) {
+	templateIds := strings.Split(request.QueryParameter("template_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DeleteTemplatesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DeleteTemplatesRequest{
+		TemplateId: templateIds,
+	}
+
+	resp, err := client.DeleteTemplates(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DeleteTemplates failed: %+v", err)
+		response.WriteAsJson(&pb.DeleteTemplatesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DeleteTemplates success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func PreviewTemplate(request *restful.Request, response *restful.Response) {
+	req := new(pb.PreviewTemplateRequest)
+
+	err := request.ReadEntity(&req)
+	if err != nil {
+		logger.Debug(nil, "PreviewTemplate request data error %+v.", err)
+		response.WriteAsJson(&pb.PreviewTemplateResponse{})
+		return
+	}
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.PreviewTemplateResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	resp, err := client.PreviewTemplate(ctx, req)
+	if err != nil {
+		logger.Error(nil, "PreviewTemplate failed: %+v", err)
+		response.WriteAsJson(&pb.PreviewTemplateResponse{})
+		return
+	}
+
+	logger.Debug(nil, "PreviewTemplate success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DescribeOutboxes(request *restful.Request, response *restful.Response) {
+	outboxIds := strings.Split(request.QueryParameter("outbox_ids"), ",")
+	alertIds := strings.Split(request.QueryParameter("alert_ids"), ",")
+	status := strings.Split(request.QueryParameter("status"), ",")
+
+	sortKey := request.QueryParameter("sort_key")
+	reverse := parseBool(request.QueryParameter("reverse"))
+	offset, _ := parseUint32(request.QueryParameter("offset"))
+	limit, _ := parseUint32(request.QueryParameter("limit"))
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DescribeOutboxesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DescribeOutboxesRequest{
+		OutboxId: outboxIds,
+		AlertId:  alertIds,
+		Status:   status,
+		SortKey:  sortKey,
+		Reverse:  reverse,
+		Offset:   offset,
+		Limit:    limit,
+	}
+
+	resp, err := client.DescribeOutboxes(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DescribeOutboxes failed: %+v", err)
+		response.WriteAsJson(&pb.DescribeOutboxesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DescribeOutboxes success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func RetryOutboxes(request *restful.Request, response *restful.Response) {
+	outboxIds := strings.Split(request.QueryParameter("outbox_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.RetryOutboxesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.RetryOutboxesRequest{
+		OutboxId: outboxIds,
+	}
+
+	resp, err := client.RetryOutboxes(ctx, req)
+	if err != nil {
+		logger.Error(nil, "RetryOutboxes failed: %+v", err)
+		response.WriteAsJson(&pb.RetryOutboxesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "RetryOutboxes success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
+
+func DiscardOutboxes(request *restful.Request, response *restful.Response) {
+	outboxIds := strings.Split(request.QueryParameter("outbox_ids"), ",")
+
+	client, err := alclient.NewClient()
+	if err != nil {
+		logger.Error(nil, "Failed to create alert grpc client %+v.", err)
+		response.WriteAsJson(&pb.DiscardOutboxesResponse{})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	var req = &pb.DiscardOutboxesRequest{
+		OutboxId: outboxIds,
+	}
+
+	resp, err := client.DiscardOutboxes(ctx, req)
+	if err != nil {
+		logger.Error(nil, "DiscardOutboxes failed: %+v", err)
+		response.WriteAsJson(&pb.DiscardOutboxesResponse{})
+		return
+	}
+
+	logger.Debug(nil, "DiscardOutboxes success: %+v", resp)
+
+	response.WriteAsJson(resp)
+}
@@ -1356,6 +1356,138 @@ func WebService() *restful.WebService {
 		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
 		Produces(restful.MIME_JSON)
 
+	tags = []string{"Silence"}
+
+	ws.Route(ws.POST("/silence").To(CreateSilence).
+		Doc("Create Silence").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Silence{}).
+		Writes(pb.CreateSilenceResponse{}).
+		Returns(http.StatusOK, RespOK, pb.CreateSilenceResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/silence").To(DescribeSilences).
+		Doc("Describe Silences").
+		Param(ws.QueryParameter("silence_ids", "Specify silence ids to query, comma-separated, eg. sl-RWXXoJkyJKEm,sl-vnAjqwNP5OPJ.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("creators", "Specify silence creators to query, comma-separated, eg. admin.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("active", "Only query silences in effect now.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("sort_key", "Sort key. One of silence_id, creator, start_time, end_time, create_time, update_time.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("reverse", "Sort order, true-desc, false-asc.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("offset", "Beginning index of result to return. Use this option together with limit.").DataType("uint32").Required(false)).
+		Param(ws.QueryParameter("limit", "Size of result to return.").DataType("uint32").Required(false)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DescribeSilencesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DescribeSilencesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.PATCH("/silence").To(ModifySilence).
+		Doc("Modify Silence").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Silence{}).
+		Writes(pb.ModifySilenceResponse{}).
+		Returns(http.StatusOK, RespOK, pb.ModifySilenceResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.DELETE("/silence").To(DeleteSilences).
+		Doc("Delete Silences").
+		Param(ws.QueryParameter("silence_ids", "Specify silence ids to delete, comma-separated, eg. sl-RWXXoJkyJKEm,sl-vnAjqwNP5OPJ.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DeleteSilencesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DeleteSilencesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	tags = []string{"Template"}
+
+	ws.Route(ws.POST("/template").To(CreateTemplate).
+		Doc("Create Template").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Template{}).
+		Writes(pb.CreateTemplateResponse{}).
+		Returns(http.StatusOK, RespOK, pb.CreateTemplateResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.GET("/template").To(DescribeTemplates).
+		Doc("Describe Templates").
+		Param(ws.QueryParameter("template_ids", "Specify template ids to query, comma-separated, eg. tp-RWXXoJkyJKEm,tp-vnAjqwNP5OPJ.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("policy_ids", "Specify policy ids of templates to query, comma-separated, eg. pl-RWXXoJkyJKEm.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("languages", "Specify languages of templates to query, comma-separated, eg. en,zh.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("sort_key", "Sort key. One of template_id, policy_id, language, create_time, update_time.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("reverse", "Sort order, true-desc, false-asc.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("offset", "Beginning index of result to return. Use this option together with limit.").DataType("uint32").Required(false)).
+		Param(ws.QueryParameter("limit", "Size of result to return.").DataType("uint32").Required(false)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DescribeTemplatesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DescribeTemplatesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.PATCH("/template").To(ModifyTemplate).
+		Doc("Modify Template").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(models.Template{}).
+		Writes(pb.ModifyTemplateResponse{}).
+		Returns(http.StatusOK, RespOK, pb.ModifyTemplateResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.DELETE("/template").To(DeleteTemplates).
+		Doc("Delete Templates").
+		Param(ws.QueryParameter("template_ids", "Specify template ids to delete, comma-separated, eg. tp-RWXXoJkyJKEm,tp-vnAjqwNP5OPJ.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DeleteTemplatesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DeleteTemplatesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.POST("/template/preview").To(PreviewTemplate).
+		Doc("Preview Template, render title and content with notification param or a sample").
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Reads(pb.PreviewTemplateRequest{}).
+		Writes(pb.PreviewTemplateResponse{}).
+		Returns(http.StatusOK, RespOK, pb.PreviewTemplateResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	tags = []string{"Outbox"}
+
+	ws.Route(ws.GET("/outbox").To(DescribeOutboxes).
+		Doc("Describe Outboxes, notifications queued for delivery").
+		Param(ws.QueryParameter("outbox_ids", "Specify outbox ids to query, comma-separated, eg. ob-RWXXoJkyJKEm,ob-vnAjqwNP5OPJ.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("alert_ids", "Specify alert ids of outboxes to query, comma-separated, eg. al-RWXXoJkyJKEm.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("status", "Specify status of outboxes to query, comma-separated. One of pending, sending, sent, dead, discarded.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("sort_key", "Sort key. One of outbox_id, alert_id, status, attempts, next_attempt_time, create_time, update_time.").DataType("string").Required(false)).
+		Param(ws.QueryParameter("reverse", "Sort order, true-desc, false-asc.").DataType("bool").DefaultValue("false").Required(false)).
+		Param(ws.QueryParameter("offset", "Beginning index of result to return. Use this option together with limit.").DataType("uint32").Required(false)).
+		Param(ws.QueryParameter("limit", "Size of result to return.").DataType("uint32").Required(false)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DescribeOutboxesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DescribeOutboxesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.POST("/outbox/retry").To(RetryOutboxes).
+		Doc("Retry Outboxes, deliver pending, dead or discarded outboxes again from the first attempt").
+		Param(ws.QueryParameter("outbox_ids", "Specify outbox ids to retry, comma-separated, eg. ob-RWXXoJkyJKEm,ob-vnAjqwNP5OPJ.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.RetryOutboxesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.RetryOutboxesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
+	ws.Route(ws.POST("/outbox/discard").To(DiscardOutboxes).
+		Doc("Discard Outboxes, stop delivery of pending or dead outboxes").
+		Param(ws.QueryParameter("outbox_ids", "Specify outbox ids to discard, comma-separated, eg. ob-RWXXoJkyJKEm,ob-vnAjqwNP5OPJ.").DataType("string").Required(true)).
+		Metadata(restfulspec.KeyOpenAPITags, tags).
+		Writes(pb.DiscardOutboxesResponse{}).
+		Returns(http.StatusOK, RespOK, pb.DiscardOutboxesResponse{})).
+		Consumes(restful.MIME_JSON, constants.MIME_MERGEPATCH).
+		Produces(restful.MIME_JSON)
+
 	return ws
 }
 
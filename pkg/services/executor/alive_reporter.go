@@ -17,6 +17,7 @@ import (
 type ExecutorInfo struct {
 	Name      string
 	TaskCount int
+	Dispatch  DispatchStats
 }
 
 type AliveReporter struct {
@@ -58,6 +59,7 @@ func (ar *AliveReporter) putKey(expireTime int64) error {
 	info := &ExecutorInfo{
 		Name:      ar.executor.GetName(),
 		TaskCount: ar.executor.GetTaskCount(),
+		Dispatch:  ar.executor.GetDispatchStats(),
 	}
 
 	value, _ := json.Marshal(info)
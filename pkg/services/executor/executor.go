@@ -7,8 +7,12 @@ package executor
 import (
 	"strings"
 	"sync"
+	"time"
 
+	"kubesphere.io/alert/pkg/config"
 	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
 	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
 )
 
@@ -19,6 +23,8 @@ type Executor struct {
 	aliveReporter     *AliveReporter
 	broadcastReceiver *BroadcastReceiver
 	healthChecker     *HealthChecker
+	stormDetector     *StormDetector
+	outboxDispatcher  *OutboxDispatcher
 }
 
 type Runner struct {
@@ -26,7 +32,7 @@ type Runner struct {
 	Map map[string]*AlertRunner
 }
 
-func NewExecutor(name string, alertReceiver *AlertReceiver, aliveReporter *AliveReporter, broadcastReceiver *BroadcastReceiver, healthChecker *HealthChecker) *Executor {
+func NewExecutor(name string, alertReceiver *AlertReceiver, aliveReporter *AliveReporter, broadcastReceiver *BroadcastReceiver, healthChecker *HealthChecker, stormDetector *StormDetector, outboxDispatcher *OutboxDispatcher) *Executor {
 	e := &Executor{
 		name:              name,
 		alertReceiver:     alertReceiver,
@@ -34,6 +40,8 @@ func NewExecutor(name string, alertReceiver *AlertReceiver, aliveReporter *Alive
 		aliveReporter:     aliveReporter,
 		broadcastReceiver: broadcastReceiver,
 		healthChecker:     healthChecker,
+		stormDetector:     stormDetector,
+		outboxDispatcher:  outboxDispatcher,
 	}
 	return e
 }
@@ -64,7 +72,7 @@ func (e *Executor) startRunner(alertId string) bool {
 		return false
 	}
 
-	var runner = NewAlertRunner(alertId, e.healthChecker.UpdateCh)
+	var runner = NewAlertRunner(alertId, e.healthChecker.UpdateCh, e.stormDetector)
 
 	e.runner.Lock()
 	e.runner.Map[alertId] = runner
@@ -143,29 +151,42 @@ func (e *Executor) updateRunner(alertId string) bool {
 	return true
 }
 
-func (e *Executor) commentRunner(alertId string, historyId string) bool {
+//signalRunner passes operation on alert to its runner, only if the alert is running on this executor.
+func (e *Executor) signalRunner(alertId string, signal string, param string) bool {
 	e.runner.Lock()
 	runner, ok := e.runner.Map[alertId]
 	e.runner.Unlock()
 	if !ok {
-		logger.Error(nil, "Executor commentRunner error: runner does not exist")
+		logger.Error(nil, "Executor signalRunner %s error: runner does not exist", signal)
 		return false
 	}
 
 	//Query DB, check if this alert is in running state
 	alert := rs.GetAlertInfo(alertId)
 	if !(alert.RunningStatus == "running" && alert.ExecutorId == e.name) {
-		logger.Error(nil, "Executor commentRunner error: runner alert %s should not be commented", alertId)
+		logger.Error(nil, "Executor signalRunner %s error: runner alert %s is not running here", signal, alertId)
 		return false
 	}
 
-	runner.SignalCh <- "Comment " + historyId
+	runner.SignalCh <- signal + " " + param
 
-	logger.Debug(nil, "Executor commentRunner "+alertId+" "+historyId+" success")
+	logger.Debug(nil, "Executor signalRunner %s %s %s success", signal, alertId, param)
 
 	return true
 }
 
+func (e *Executor) commentRunner(alertId string, historyId string) bool {
+	return e.signalRunner(alertId, "Comment", historyId)
+}
+
+func (e *Executor) acknowledgeRunner(alertId string, ackInfo string) bool {
+	return e.signalRunner(alertId, "Acknowledge", ackInfo)
+}
+
+func (e *Executor) snoozeRunner(alertId string, snoozeInfo string) bool {
+	return e.signalRunner(alertId, "Snooze", snoozeInfo)
+}
+
 func (e *Executor) stopAllRunners() {
 	e.runner.Lock()
 	for alertId, _ := range e.runner.Map {
@@ -187,6 +208,10 @@ func (e *Executor) GetTaskCount() int {
 	return count
 }
 
+func (e *Executor) GetDispatchStats() DispatchStats {
+	return e.outboxDispatcher.GetStats()
+}
+
 func (e *Executor) getRunnerInfo(alertId string) rs.RunnerInfo {
 	runnerInfo := rs.RunnerInfo{}
 
@@ -220,13 +245,17 @@ func (e *Executor) ProcessAlert(alertId string, operation string) {
 	case "updating":
 		e.updateRunner(alertId)
 	default:
-		param := strings.Split(operation, " ")
+		param := strings.SplitN(operation, " ", 2)
 		if len(param) != 2 {
 			break
 		}
 		switch param[0] {
 		case "commenting":
 			e.commentRunner(alertId, param[1])
+		case "acknowledging":
+			e.acknowledgeRunner(alertId, param[1])
+		case "snoozing":
+			e.snoozeRunner(alertId, param[1])
 		}
 	}
 }
@@ -258,6 +287,8 @@ func (e *Executor) Serve() {
 	go e.broadcastReceiver.WatchBroadcast()
 	go e.healthChecker.HealthCheck()
 	go e.healthChecker.UpdateLoop()
+	go e.stormDetector.Serve()
+	go e.outboxDispatcher.Serve()
 	e.aliveReporter.HeartBeat()
 }
 
@@ -269,17 +300,36 @@ func (e *Executor) HeartBoot() {
 	e.aliveReporter.HeartBoot()
 }
 
+//registerNotifiers registers built-in notifiers by trigger action of action.
+func registerNotifiers() {
+	cfg := config.GetInstance().Executor
+	webhookTimeout := time.Duration(cfg.WebhookTimeout) * time.Second
+
+	notification.RegisterNotifier(models.TriggerActionNotification, notification.NewServiceNotifier())
+	notification.RegisterNotifier(models.TriggerActionWebhook, notification.NewWebhookNotifier(notification.WebhookFormatJson, webhookTimeout))
+	notification.RegisterNotifier(models.TriggerActionSlack, notification.NewWebhookNotifier(notification.WebhookFormatSlack, webhookTimeout))
+	notification.RegisterNotifier(models.TriggerActionDingTalk, notification.NewWebhookNotifier(notification.WebhookFormatDingTalk, webhookTimeout))
+	notification.RegisterNotifier(models.TriggerActionWeChat, notification.NewWebhookNotifier(notification.WebhookFormatWeChat, webhookTimeout))
+	notification.RegisterNotifier(models.TriggerActionSmtp, notification.NewSmtpNotifier(cfg.SmtpHost, cfg.SmtpPort, cfg.SmtpUsername, cfg.SmtpPassword, cfg.SmtpFrom, time.Duration(cfg.SmtpTimeout)*time.Second))
+}
+
 func Init(name string) *Executor {
+	registerNotifiers()
+
 	alertReceiver := NewAlertReceiver()
 	aliveReporter := NewAliveReporter()
 	broadcastReceiver := NewBroadcastReceiver()
 	healthChecker := NewHealthChecker()
-	executor := NewExecutor(name, alertReceiver, aliveReporter, broadcastReceiver, healthChecker)
+	stormDetector := NewStormDetector()
+	outboxDispatcher := NewOutboxDispatcher()
+	executor := NewExecutor(name, alertReceiver, aliveReporter, broadcastReceiver, healthChecker, stormDetector, outboxDispatcher)
 
 	alertReceiver.SetExecutor(executor)
 	aliveReporter.SetExecutor(executor)
 	broadcastReceiver.SetExecutor(executor)
 	healthChecker.SetExecutor(executor)
+	stormDetector.SetExecutor(executor)
+	outboxDispatcher.SetExecutor(executor)
 
 	return executor
 }
@@ -0,0 +1,156 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/coreos/etcd/clientv3"
+
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+)
+
+//Firing resources are shared by all executors in etcd so that alerts running on other executors could be inhibited,
+//key is alert-firing/<rs_type_name>/<resource_name>/<alert_id>/<rule_id> and value is the current severity.
+//Resource name is the one without type as processResourceName returns, path escaped so that it never contains "/".
+const firingPrefix = "alert-firing/"
+
+type FiringState struct {
+	AlertId  string
+	RuleId   string
+	Severity string
+}
+
+func formatFiringPrefix(rsTypeName string, resourceName string) string {
+	return fmt.Sprintf("%s%s/%s/", firingPrefix, url.PathEscape(rsTypeName), url.PathEscape(processResourceName(resourceName)))
+}
+
+func formatFiringKey(rsTypeName string, resourceName string, alertId string, ruleId string) string {
+	return fmt.Sprintf("%s%s/%s", formatFiringPrefix(rsTypeName, resourceName), alertId, ruleId)
+}
+
+//parseFiringState returns the firing state of key under prefix, ok is false if key is not one of the resource.
+func parseFiringState(prefix string, key string, value string) (FiringState, bool) {
+	if !strings.HasPrefix(key, prefix) {
+		return FiringState{}, false
+	}
+	ids := strings.Split(strings.TrimPrefix(key, prefix), "/")
+	if len(ids) != 2 {
+		return FiringState{}, false
+	}
+	return FiringState{AlertId: ids[0], RuleId: ids[1], Severity: value}, true
+}
+
+//FiringLease is the etcd lease shared by firing states of a runner, it is kept alive at most once per evaluation,
+//so that firing states expire together after the runner is gone.
+type FiringLease struct {
+	id        clientv3.LeaseID
+	refreshed bool
+}
+
+//Expire lets the next Refresh keep the lease alive again.
+func (fl *FiringLease) Expire() {
+	fl.refreshed = false
+}
+
+//Refresh keeps the lease alive for ttl seconds, a new lease is granted if it is lost, eg. after etcd is unreachable for ttl.
+func (fl *FiringLease) Refresh(ttl int64) (clientv3.LeaseID, error) {
+	if fl.refreshed && fl.id != clientv3.NoLease {
+		return fl.id, nil
+	}
+
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	if fl.id != clientv3.NoLease {
+		_, err := e.KeepAliveOnce(ctx, fl.id)
+		if err == nil {
+			fl.refreshed = true
+			return fl.id, nil
+		}
+		logger.Warn(nil, "Keep alive firing lease [%x] failed, grant a new one: %+v", fl.id, err)
+	}
+
+	resp, err := e.Grant(ctx, ttl)
+	if err != nil {
+		logger.Error(nil, "Grant TTL from etcd failed: %+v", err)
+		fl.id = clientv3.NoLease
+		return clientv3.NoLease, err
+	}
+	fl.id = resp.ID
+	fl.refreshed = true
+	return fl.id, nil
+}
+
+//Revoke deletes all firing states of the lease.
+func (fl *FiringLease) Revoke() {
+	if fl.id == clientv3.NoLease {
+		return
+	}
+
+	e := global.GetInstance().GetEtcd()
+	_, err := e.Revoke(context.Background(), fl.id)
+	if err != nil {
+		logger.Error(nil, "Revoke firing lease [%x] failed: %+v", fl.id, err)
+	}
+	fl.id = clientv3.NoLease
+	fl.refreshed = false
+}
+
+//PutFiringState marks resource firing with lease, the key expires with the lease unless it is deleted on resume.
+func PutFiringState(lease clientv3.LeaseID, rsTypeName string, resourceName string, alertId string, ruleId string, severity string) error {
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	key := formatFiringKey(rsTypeName, resourceName, alertId, ruleId)
+
+	_, err := e.Put(ctx, key, severity, clientv3.WithLease(lease))
+	if err != nil {
+		logger.Error(nil, "PutFiringState [%s] [%s] to etcd failed: %+v", key, severity, err)
+		return err
+	}
+
+	return nil
+}
+
+func DeleteFiringState(rsTypeName string, resourceName string, alertId string, ruleId string) error {
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	key := formatFiringKey(rsTypeName, resourceName, alertId, ruleId)
+
+	_, err := e.Delete(ctx, key)
+	if err != nil {
+		logger.Error(nil, "DeleteFiringState [%s] from etcd failed: %+v", key, err)
+		return err
+	}
+
+	return nil
+}
+
+//GetFiringStates returns all alerts firing for the resource.
+func GetFiringStates(rsTypeName string, resourceName string) ([]FiringState, error) {
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	prefix := formatFiringPrefix(rsTypeName, resourceName)
+
+	resp, err := e.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		logger.Error(nil, "GetFiringStates [%s] from etcd failed: %+v", prefix, err)
+		return nil, err
+	}
+
+	states := []FiringState{}
+	for _, kv := range resp.Kvs {
+		state, ok := parseFiringState(prefix, string(kv.Key), string(kv.Value))
+		if !ok {
+			continue
+		}
+		states = append(states, state)
+	}
+
+	return states, nil
+}
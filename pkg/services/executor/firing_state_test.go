@@ -0,0 +1,37 @@
+package executor
+
+import (
+	"testing"
+)
+
+func TestFiringKey(t *testing.T) {
+	testCase := []struct {
+		rsTypeName   string
+		resourceName string
+		key          string
+	}{
+		{"node", "node1", "alert-firing/node/node1/alert-1/rule-1"},
+		{"node", "node:node1", "alert-firing/node/node1/alert-1/rule-1"},
+		{"workload", "deployment/web", "alert-firing/workload/deployment%2Fweb/alert-1/rule-1"},
+		{"workload", "app:a/b/c", "alert-firing/workload/a%2Fb%2Fc/alert-1/rule-1"},
+	}
+
+	for _, tc := range testCase {
+		key := formatFiringKey(tc.rsTypeName, tc.resourceName, "alert-1", "rule-1")
+		if key != tc.key {
+			t.Fatalf("formatFiringKey [%s] [%s] expect [%s] but get [%s]", tc.rsTypeName, tc.resourceName, tc.key, key)
+		}
+
+		prefix := formatFiringPrefix(tc.rsTypeName, tc.resourceName)
+		state, ok := parseFiringState(prefix, key, "major")
+		if !ok || state != (FiringState{AlertId: "alert-1", RuleId: "rule-1", Severity: "major"}) {
+			t.Fatalf("parseFiringState [%s] expect [alert-1 rule-1 major] but get [%+v] [%v]", key, state, ok)
+		}
+	}
+
+	//Resource whose name has the other as prefix
+	prefix := formatFiringPrefix("workload", "deployment")
+	if state, ok := parseFiringState(prefix, formatFiringKey("workload", "deployment/web", "alert-1", "rule-1"), "major"); ok {
+		t.Fatalf("parseFiringState of other resource expect none but get [%+v]", state)
+	}
+}
@@ -0,0 +1,231 @@
+package executor
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+//Dispatcher claims at most this number of outboxes in a tick.
+const outboxBatchSize = 100
+
+//Outbox claimed by a dispatcher is claimed again by others after lease, eg. when the executor is gone while sending.
+const outboxLease = 5 * time.Minute
+
+//Result of delivery is saved to outbox at most this number of times, otherwise the outbox is delivered again after lease.
+const outboxUpdateAttempts = 3
+
+//DispatchStats shows backpressure of outbox dispatcher, it is reported to etcd with executor info.
+type DispatchStats struct {
+	QueueLength       int    `json:"queue_length"`
+	QueueCapacity     int    `json:"queue_capacity"`
+	Workers           int    `json:"workers"`
+	BusyWorkers       int32  `json:"busy_workers"`
+	Delivered         uint64 `json:"delivered"`
+	Failed            uint64 `json:"failed"`
+	Throttled         uint64 `json:"throttled"`
+	LastDeliverMillis int64  `json:"last_deliver_millis"`
+}
+
+//OutboxDispatcher delivers notifications queued in outbox by runners of all executors,
+//outboxes claimed by the executor wait in a bounded queue for a pool of workers, so that slow notifiers hold back claiming instead of runners.
+type OutboxDispatcher struct {
+	executor          *Executor
+	queue             chan models.Outbox
+	workers           int
+	busyWorkers       int32
+	delivered         uint64
+	failed            uint64
+	throttled         uint64
+	lastDeliverMillis int64
+	lastPurgeTime     time.Time
+}
+
+func NewOutboxDispatcher() *OutboxDispatcher {
+	cfg := config.GetInstance().Executor
+	queueSize := int(cfg.DispatchQueueSize)
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	workers := int(cfg.DispatchWorkers)
+	if workers < 1 {
+		workers = 1
+	}
+
+	od := &OutboxDispatcher{
+		queue:   make(chan models.Outbox, queueSize),
+		workers: workers,
+	}
+
+	return od
+}
+
+func (od *OutboxDispatcher) SetExecutor(executor *Executor) {
+	od.executor = executor
+}
+
+//writeHistory records delivery of outbox in history of its alert, storm summaries have no alert.
+func (od *OutboxDispatcher) writeHistory(outbox *models.Outbox, event string, content string, notificationId string) {
+	if outbox.AlertId == "" {
+		return
+	}
+
+	history := models.NewHistory("", event, content, notificationId, outbox.AlertId, outbox.RuleId, outbox.ResourceName)
+	err := rs.CreateHistory(nil, history)
+	if err != nil {
+		logger.Error(nil, "OutboxDispatcher write history of Outbox[%s] %s error, [%+v].", outbox.OutboxId, event, err)
+	}
+}
+
+func (od *OutboxDispatcher) GetStats() DispatchStats {
+	return DispatchStats{
+		QueueLength:       len(od.queue),
+		QueueCapacity:     cap(od.queue),
+		Workers:           od.workers,
+		BusyWorkers:       atomic.LoadInt32(&od.busyWorkers),
+		Delivered:         atomic.LoadUint64(&od.delivered),
+		Failed:            atomic.LoadUint64(&od.failed),
+		Throttled:         atomic.LoadUint64(&od.throttled),
+		LastDeliverMillis: atomic.LoadInt64(&od.lastDeliverMillis),
+	}
+}
+
+//updateOutbox saves result of delivery to outbox, and returns content of history noting the result when it is not saved.
+func (od *OutboxDispatcher) updateOutbox(outbox *models.Outbox, attributes map[string]interface{}, content string) string {
+	var err error
+	for i := 0; i < outboxUpdateAttempts; i++ {
+		var updated bool
+		updated, err = rs.UpdateOutbox(outbox, attributes)
+		if err == nil {
+			if !updated {
+				logger.Warn(nil, "OutboxDispatcher lost lease of Outbox[%s], result is not saved", outbox.OutboxId)
+				return content + ", result not saved: lease lost"
+			}
+			return content
+		}
+		time.Sleep(time.Second)
+	}
+
+	logger.Error(nil, "OutboxDispatcher save result of Outbox[%s] failed, it is delivered again after lease: %+v", outbox.OutboxId, err)
+	return fmt.Sprintf("%s, result not saved: %v", content, err)
+}
+
+func (od *OutboxDispatcher) deliver(outbox *models.Outbox) {
+	cfg := config.GetInstance().Executor
+
+	email := &notification.Email{
+		Title:          outbox.Title,
+		Content:        outbox.Content,
+		Html:           outbox.Html,
+		IdempotencyKey: outbox.IdempotencyKey,
+	}
+
+	notificationId := ""
+	var err error
+	startTime := time.Now()
+	n := notification.GetNotifier(outbox.Notifier)
+	if n == nil {
+		err = fmt.Errorf("unsupported notifier [%s]", outbox.Notifier)
+	} else {
+		notificationId, err = n.Notify(outbox.GetAddresses(), email)
+	}
+	atomic.StoreInt64(&od.lastDeliverMillis, int64(time.Since(startTime)/time.Millisecond))
+
+	attempts := outbox.Attempts + 1
+	content := fmt.Sprintf("outbox %s attempt %d", outbox.OutboxId, attempts)
+
+	if err == nil {
+		atomic.AddUint64(&od.delivered, 1)
+		content = od.updateOutbox(outbox, map[string]interface{}{
+			models.ObColStatus:         models.OutboxStatusSent,
+			models.ObColAttempts:       attempts,
+			models.ObColNotificationId: notificationId,
+		}, content)
+		od.writeHistory(outbox, "sent_success", content, notificationId)
+		return
+	}
+
+	atomic.AddUint64(&od.failed, 1)
+	logger.Error(nil, "OutboxDispatcher deliver Outbox[%s] attempt %d failed: %v", outbox.OutboxId, attempts, err)
+
+	attributes := map[string]interface{}{
+		models.ObColAttempts:  attempts,
+		models.ObColLastError: err.Error(),
+	}
+	if attempts >= cfg.OutboxMaxAttempts {
+		attributes[models.ObColStatus] = models.OutboxStatusDead
+		content = od.updateOutbox(outbox, attributes, fmt.Sprintf("%s: %v", content, err))
+		od.writeHistory(outbox, "dead_lettered", content, "")
+		return
+	}
+
+	delay := models.OutboxRetryDelay(attempts, time.Duration(cfg.OutboxRetryInterval)*time.Second, time.Duration(cfg.OutboxMaxRetryInterval)*time.Second)
+	attributes[models.ObColStatus] = models.OutboxStatusPending
+	attributes[models.ObColNextAttemptTime] = time.Now().Add(delay)
+	content = od.updateOutbox(outbox, attributes, fmt.Sprintf("%s: %v, retry in %v", content, err, delay))
+	od.writeHistory(outbox, "sent_failed", content, "")
+}
+
+//work renews lease of outbox before delivering, since it may have waited in queue until the lease is nearly expired,
+//outbox whose lease is lost has been claimed again by another dispatcher or changed by operator, so it is dropped.
+func (od *OutboxDispatcher) work() {
+	for outbox := range od.queue {
+		atomic.AddInt32(&od.busyWorkers, 1)
+		renewed, err := rs.RenewOutbox(&outbox, outboxLease)
+		if err == nil && renewed {
+			od.deliver(&outbox)
+		} else {
+			logger.Warn(nil, "OutboxDispatcher drop Outbox[%s] without lease, renewed [%v], error [%+v]", outbox.OutboxId, renewed, err)
+		}
+		atomic.AddInt32(&od.busyWorkers, -1)
+	}
+}
+
+//dispatch claims no more outboxes than free slots of queue, the others are left to dispatchers of other executors.
+func (od *OutboxDispatcher) dispatch() {
+	free := cap(od.queue) - len(od.queue)
+	if free <= 0 {
+		atomic.AddUint64(&od.throttled, 1)
+		logger.Debug(nil, "OutboxDispatcher queue full, %+v", od.GetStats())
+	} else {
+		if free > outboxBatchSize {
+			free = outboxBatchSize
+		}
+
+		outboxes, err := rs.ClaimOutboxes(free, outboxLease)
+		if err == nil {
+			//Only dispatch goroutine writes to queue, so it never blocks here
+			for _, outbox := range outboxes {
+				od.queue <- outbox
+			}
+		}
+	}
+
+	if time.Since(od.lastPurgeTime) >= time.Hour {
+		od.lastPurgeTime = time.Now()
+		retentionDays := config.GetInstance().Executor.OutboxRetentionDays
+		rs.PurgeOutboxes(time.Now().AddDate(0, 0, -int(retentionDays)))
+	}
+}
+
+func (od *OutboxDispatcher) Serve() {
+	for i := 0; i < od.workers; i++ {
+		go od.work()
+	}
+
+	timer := time.NewTicker(time.Second * TickPeriodSecond)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			od.dispatch()
+		}
+	}
+}
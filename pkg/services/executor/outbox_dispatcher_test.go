@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"kubesphere.io/alert/pkg/models"
+)
+
+func TestDispatchStats(t *testing.T) {
+	od := &OutboxDispatcher{
+		queue:   make(chan models.Outbox, 2),
+		workers: 4,
+	}
+	od.queue <- models.Outbox{OutboxId: "ob-1"}
+	od.throttled = 1
+
+	stats := od.GetStats()
+	if stats.QueueLength != 1 || stats.QueueCapacity != 2 || stats.Workers != 4 || stats.Throttled != 1 {
+		t.Fatalf("GetStats get wrong stats %+v", stats)
+	}
+
+	value, err := json.Marshal(ExecutorInfo{Name: "executor-1", TaskCount: 3, Dispatch: stats})
+	if err != nil {
+		t.Fatalf("Marshal ExecutorInfo failed: %+v", err)
+	}
+	for _, key := range []string{`"queue_length":1`, `"queue_capacity":2`, `"throttled":1`} {
+		if !strings.Contains(string(value), key) {
+			t.Fatalf("ExecutorInfo [%s] reported without [%s]", string(value), key)
+		}
+	}
+}
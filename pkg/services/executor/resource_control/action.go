@@ -0,0 +1,28 @@
+package resource_control
+
+import (
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func QueryActions(alertId string) ([]models.Action, error) {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("action t1").
+		Select("t1.*").
+		Joins("join alert t2 on t2.policy_id=t1.policy_id"))
+
+	dbChain.DB = dbChain.DB.Where("t2.alert_id = ?", alertId).Order("t1." + models.AcColCreateTime)
+
+	var acs []models.Action
+
+	err := dbChain.
+		Scan(&acs).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryActions [%s], error: %+v.", alertId, err)
+		return nil, err
+	}
+
+	return acs, nil
+}
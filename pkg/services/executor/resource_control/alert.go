@@ -16,6 +16,7 @@ type AlertDetail struct {
 	AlertName          string `gorm:"column:alert_name" json:"alert_name"`
 	Disabled           bool   `gorm:"column:disabled" json:"disabled"`
 	AlertStatus        string `gorm:"column:alert_status" json:"alert_status"`
+	PolicyId           string `gorm:"column:policy_id" json:"policy_id"`
 	RsTypeName         string `gorm:"column:rs_type_name" json:"rs_type_name"`
 	RsTypeParam        string `gorm:"column:rs_type_param" json:"rs_type_param"`
 	RsFilterName       string `gorm:"column:rs_filter_name" json:"rs_filter_name"`
@@ -23,8 +24,10 @@ type AlertDetail struct {
 	PolicyConfig       string `gorm:"column:policy_config" json:"policy_config"`
 	AvailableStartTime string `gorm:"column:available_start_time" json:"available_start_time"`
 	AvailableEndTime   string `gorm:"column:available_end_time" json:"available_end_time"`
+	AvailableSchedule  string `gorm:"column:available_schedule" json:"available_schedule"`
+	GroupConfig        string `gorm:"column:group_config" json:"group_config"`
+	RouteConfig        string `gorm:"column:route_config" json:"route_config"`
 	Language           string `gorm:"column:language" json:"language"`
-	NfAddressListId    string `gorm:"column:nf_address_list_id" json:"nf_address_list_id"`
 }
 
 type RunnerInfo struct {
@@ -35,11 +38,10 @@ type RunnerInfo struct {
 
 func QueryAlertDetail(alertId string) (AlertDetail, error) {
 	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("alert t1").
-		Select("t1.alert_id, t1.alert_name, t1.disabled, t1.alert_status, t3.rs_type_name, t3.rs_type_param, t2.rs_filter_name, t2.rs_filter_param, t4.policy_config, t4.available_start_time, t4.available_end_time, t4.language, t5.nf_address_list_id").
+		Select("t1.alert_id, t1.alert_name, t1.disabled, t1.alert_status, t1.policy_id, t3.rs_type_name, t3.rs_type_param, t2.rs_filter_name, t2.rs_filter_param, t4.policy_config, t4.available_start_time, t4.available_end_time, t4.available_schedule, t4.group_config, t4.route_config, t4.language").
 		Joins("left join resource_filter t2 on t2.rs_filter_id=t1.rs_filter_id").
 		Joins("left join resource_type t3 on t3.rs_type_id=t2.rs_type_id").
-		Joins("left join policy t4 on t4.policy_id=t1.policy_id").
-		Joins("left join action t5 on t5.policy_id=t1.policy_id"))
+		Joins("left join policy t4 on t4.policy_id=t1.policy_id"))
 
 	dbChain.DB = dbChain.DB.Where("t1.alert_id in (?)", alertId)
 
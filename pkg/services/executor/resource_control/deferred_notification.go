@@ -0,0 +1,89 @@
+package resource_control
+
+import (
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+//SaveDeferredNotification keeps one deferred notification for each resource of alert, the latest one wins.
+func SaveDeferredNotification(deferredNotification *models.DeferredNotification) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var existings []models.DeferredNotification
+	err := tx.Table(models.TableDeferredNotification).
+		Where(models.DnColAlertId+" = ? and "+models.DnColRuleId+" = ? and "+models.DnColResourceName+" = ?",
+			deferredNotification.AlertId, deferredNotification.RuleId, deferredNotification.ResourceName).
+		Scan(&existings).
+		Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(nil, "Query DeferredNotification failed, [%+v]", err)
+		return err
+	}
+
+	if len(existings) == 0 {
+		err = tx.Create(deferredNotification).Error
+	} else {
+		existing := existings[0]
+		attributes := map[string]interface{}{
+			models.DnColStatus:         deferredNotification.Status,
+			models.DnColCumulatedCount: deferredNotification.CumulatedCount,
+			models.DnColLastAlertTime:  deferredNotification.LastAlertTime,
+			models.DnColLastValue:      deferredNotification.LastValue,
+			models.DnColUpdateTime:     time.Now(),
+		}
+		//Keep the first alert time of the firing resource
+		if existing.FirstAlertTime == "" {
+			attributes[models.DnColFirstAlertTime] = deferredNotification.FirstAlertTime
+		}
+		err = tx.Table(models.TableDeferredNotification).
+			Where(models.DnColId+" = ?", existing.DeferredId).
+			Updates(attributes).
+			Error
+	}
+	if err != nil {
+		tx.Rollback()
+		logger.Error(nil, "Save DeferredNotification failed, [%+v]", err)
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+func QueryDeferredNotifications(alertId string) ([]models.DeferredNotification, error) {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableDeferredNotification))
+
+	dbChain.DB = dbChain.DB.Where(models.DnColAlertId+" = ?", alertId).Order(models.DnColCreateTime)
+
+	var dns []models.DeferredNotification
+
+	err := dbChain.
+		Scan(&dns).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryDeferredNotifications [%s], error: %+v.", alertId, err)
+		return nil, err
+	}
+
+	return dns, nil
+}
+
+func DeleteDeferredNotifications(deferredIds []string) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var deferredNotification models.DeferredNotification
+	err := tx.Model(&deferredNotification).Where(models.DnColId+" in (?)", deferredIds).Delete(models.DeferredNotification{}).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(nil, "Delete DeferredNotifications failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
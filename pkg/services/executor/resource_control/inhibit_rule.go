@@ -0,0 +1,26 @@
+package resource_control
+
+import (
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func QueryInhibitRules(targetRsTypeName string) []models.InhibitRule {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableInhibitRule))
+
+	dbChain.DB = dbChain.DB.Where(models.IrColTargetRsTypeName+" = ? and "+models.IrColDisabled+" = ?", targetRsTypeName, false)
+
+	var irs []models.InhibitRule
+
+	err := dbChain.
+		Scan(&irs).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryInhibitRules [%v], error: %+v.", targetRsTypeName, err)
+		return nil
+	}
+
+	return irs
+}
@@ -0,0 +1,135 @@
+package resource_control
+
+import (
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func queryOutboxIdByKey(idempotencyKey string) (string, error) {
+	var obs []models.Outbox
+	err := global.GetInstance().GetDB().Table(models.TableOutbox).
+		Select(models.ObColId).
+		Where(models.ObColIdempotencyKey+" = ?", idempotencyKey).
+		Scan(&obs).
+		Error
+	if err != nil || len(obs) == 0 {
+		return "", err
+	}
+	return obs[0].OutboxId, nil
+}
+
+//EnqueueOutbox returns id of the outbox queued before with the same idempotency key if there is one.
+func EnqueueOutbox(outbox *models.Outbox) (string, error) {
+	outboxId, err := queryOutboxIdByKey(outbox.IdempotencyKey)
+	if err != nil {
+		logger.Error(nil, "Query Outbox [%s] failed, [%+v]", outbox.IdempotencyKey, err)
+		return "", err
+	}
+	if outboxId != "" {
+		return outboxId, nil
+	}
+
+	err = global.GetInstance().GetDB().Create(outbox).Error
+	if err != nil {
+		//Lost the race to another executor queuing the same notification
+		outboxId, _ = queryOutboxIdByKey(outbox.IdempotencyKey)
+		if outboxId != "" {
+			return outboxId, nil
+		}
+		logger.Error(nil, "Insert Outbox failed, [%+v]", err)
+		return "", err
+	}
+
+	return outbox.OutboxId, nil
+}
+
+//ClaimOutboxes marks at most limit due outboxes as sending until lease expires, so that no other dispatcher delivers them meanwhile.
+func ClaimOutboxes(limit int, lease time.Duration) ([]models.Outbox, error) {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableOutbox))
+
+	now := time.Now()
+	//Next attempt time is kept in milliseconds, so that the lease written is matched exactly afterwards
+	leaseTime := now.Add(lease).Truncate(time.Millisecond)
+	dbChain.DB = dbChain.DB.Where(models.ObColStatus+" in (?) and "+models.ObColNextAttemptTime+" <= ?",
+		[]string{models.OutboxStatusPending, models.OutboxStatusSending}, now).
+		Order(models.ObColNextAttemptTime).
+		Limit(limit)
+
+	var obs []models.Outbox
+
+	err := dbChain.
+		Scan(&obs).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to ClaimOutboxes, error: %+v.", err)
+		return nil, err
+	}
+
+	claimed := []models.Outbox{}
+	for _, ob := range obs {
+		attributes := map[string]interface{}{
+			models.ObColStatus:          models.OutboxStatusSending,
+			models.ObColNextAttemptTime: leaseTime,
+			models.ObColUpdateTime:      now,
+		}
+		//Outbox changed since queried is claimed by another dispatcher or operator
+		result := global.GetInstance().GetDB().Table(models.TableOutbox).
+			Where(models.ObColId+" = ? and "+models.ObColStatus+" = ? and "+models.ObColNextAttemptTime+" = ?", ob.OutboxId, ob.Status, ob.NextAttemptTime).
+			Updates(attributes)
+		if result.Error != nil {
+			logger.Error(nil, "Failed to claim Outbox [%s], error: %+v.", ob.OutboxId, result.Error)
+			continue
+		}
+		if result.RowsAffected == 1 {
+			ob.Status = models.OutboxStatusSending
+			ob.NextAttemptTime = leaseTime
+			claimed = append(claimed, ob)
+		}
+	}
+
+	return claimed, nil
+}
+
+//UpdateOutbox updates outbox only when it is still claimed by the lease of caller, false means the lease is lost,
+//outbox claimed again by another dispatcher, retried or discarded by operator meanwhile is left untouched.
+func UpdateOutbox(outbox *models.Outbox, attributes map[string]interface{}) (bool, error) {
+	attributes[models.ObColUpdateTime] = time.Now()
+
+	result := global.GetInstance().GetDB().Table(models.TableOutbox).
+		Where(models.ObColId+" = ? and "+models.ObColStatus+" = ? and "+models.ObColNextAttemptTime+" = ?", outbox.OutboxId, models.OutboxStatusSending, outbox.NextAttemptTime).
+		Updates(attributes)
+	if result.Error != nil {
+		logger.Error(nil, "Update Outbox [%s] failed: %+v", outbox.OutboxId, result.Error)
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+//RenewOutbox extends lease of claimed outbox before it is delivered, false means the lease is lost.
+func RenewOutbox(outbox *models.Outbox, lease time.Duration) (bool, error) {
+	leaseTime := time.Now().Add(lease).Truncate(time.Millisecond)
+	renewed, err := UpdateOutbox(outbox, map[string]interface{}{
+		models.ObColNextAttemptTime: leaseTime,
+	})
+	if renewed {
+		outbox.NextAttemptTime = leaseTime
+	}
+	return renewed, err
+}
+
+//PurgeOutboxes deletes sent and discarded outboxes updated before time.
+func PurgeOutboxes(before time.Time) error {
+	err := global.GetInstance().GetDB().
+		Where(models.ObColStatus+" in (?) and "+models.ObColUpdateTime+" < ?", []string{models.OutboxStatusSent, models.OutboxStatusDiscarded}, before).
+		Delete(models.Outbox{}).
+		Error
+	if err != nil {
+		logger.Error(nil, "Purge Outboxes before [%v] failed: %+v", before, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package resource_control
+
+import (
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+func QueryActiveSilences() []models.Silence {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableSilence))
+
+	now := time.Now()
+	dbChain.DB = dbChain.DB.Where(models.SlColStartTime+" <= ? and "+models.SlColEndTime+" > ?", now, now)
+
+	var sls []models.Silence
+
+	err := dbChain.
+		Scan(&sls).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryActiveSilences, error: %+v.", err)
+		return nil
+	}
+
+	return sls
+}
@@ -0,0 +1,32 @@
+package resource_control
+
+import (
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+)
+
+//QueryTemplate returns template of policy in language, or template of all policies if policy has none, nil if neither exists.
+func QueryTemplate(policyId string, language string) (*models.Template, error) {
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableTemplate))
+
+	dbChain.DB = dbChain.DB.Where(models.TpColPolicyId+" in (?, '') and "+models.TpColLanguage+" = ?", policyId, language).
+		Order(models.TpColPolicyId + " desc").
+		Limit(1)
+
+	var tps []models.Template
+
+	err := dbChain.
+		Scan(&tps).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to QueryTemplate of Policy [%s] Language [%s], error: %+v.", policyId, language, err)
+		return nil, err
+	}
+
+	if len(tps) == 0 {
+		return nil, nil
+	}
+	return &tps[0], nil
+}
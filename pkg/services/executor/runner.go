@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -11,22 +12,38 @@ import (
 
 	"kubesphere.io/alert/pkg/client/adapter"
 	nf "kubesphere.io/alert/pkg/client/notification"
+	"kubesphere.io/alert/pkg/config"
 	"kubesphere.io/alert/pkg/logger"
 	"kubesphere.io/alert/pkg/metric"
 	"kubesphere.io/alert/pkg/models"
 	"kubesphere.io/alert/pkg/notification"
 	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+	"kubesphere.io/alert/pkg/util/exprutil"
 )
 
 type AlertRunner struct {
-	AlertConfig ConfigAlert
-	AlertStatus StatusAlert
-	SignalCh    chan string
-	UpdateCh    chan string
+	AlertConfig   ConfigAlert
+	AlertStatus   StatusAlert
+	SignalCh      chan string
+	UpdateCh      chan string
+	HasDeferred   bool
+	Deferred      map[string]string
+	StormDetector *StormDetector
+	FiringLease   FiringLease
+	Tick          TickCache
+}
+
+//TickCache keeps data shared by all resources in one evaluation tick, each is loaded on first use.
+type TickCache struct {
+	InhibitorLoaded bool
+	Inhibitor       string
+	SilencesLoaded  bool
+	Silences        []ActiveSilence
 }
 
 type ConfigAlert struct {
 	AlertId            string
+	AlertName          string
 	LoadSuccess        bool
 	Disabled           bool
 	RsTypeName         string
@@ -34,48 +51,93 @@ type ConfigAlert struct {
 	RsFilterName       string
 	RsFilterParam      string
 	PolicyConfig       map[string]ConfigPolicy `json:"policy_config"`
+	PolicyConfigError  string
 	AvailableStartTime string
 	AvailableEndTime   string
+	AvailableSchedule  *models.PolicySchedule
+	GroupConfig        *models.PolicyGroupConfig
+	Route              *models.PolicyRoute
 	Language           string
+	Template           *notification.Template
 	Rules              map[string]RuleInfo
 	Requests           MonitoringRequest
-	NfAddressListId    string
+	Actions            []ConfigAction
 }
 
 type ConfigPolicy struct {
-	RepeatType              string `json:"repeat_type"`
-	RepeatIntervalInitvalue uint32 `json:"repeat_interval_initvalue"`
-	MaxSendCount            uint32 `json:"max_send_count"`
+	models.RepeatConfig
+	Strategy models.RepeatStrategy `json:"-"`
+}
+
+//ConfigAction notifies its address list through its notifier on transitions of TriggerStatus.
+type ConfigAction struct {
+	ActionId        string
+	TriggerStatus   []string
+	TriggerAction   string
+	NfAddressListId string
+	Escalation      *models.EscalationConfig
+}
+
+//Receiver is an address list notified through a notifier.
+type Receiver struct {
+	Notifier        string
+	NfAddressListId string
 }
 
 type RuleInfo struct {
-	RuleName         string
-	Disabled         bool
-	MonitorPeriods   uint32
-	Severity         string
-	MetricsType      string
-	ConditionType    string
-	Thresholds       float64
-	Scale            float64
-	Unit             string
-	ConsecutiveCount uint32
-	Inhibit          bool
-	MetricName       string
+	RuleName              string
+	Disabled              bool
+	MonitorPeriods        uint32
+	Severity              string
+	MetricsType           string
+	ConditionType         string
+	Condition             *exprutil.Expr
+	Thresholds            float64
+	Scale                 float64
+	Unit                  string
+	ConsecutiveCount      uint32
+	Inhibit               bool
+	MetricName            string
+	PredictHorizon        uint32
+	NodataState           string
+	RecoveryEnabled       bool
+	RecoveryThresholds    float64
+	ConsecutiveClearCount uint32
+	Levels                []LevelInfo
+}
+
+type LevelInfo struct {
+	Thresholds float64
+	Severity   string
 }
 
 type StatusAlert struct {
 	sync.RWMutex
 	ResourceStatus map[string]StatusResource `json:resource_status`
-	UpdateTime     time.Time
+	RuleStatus     map[string]StatusResource `json:"rule_status"`
+	//Groups pending are kept with status, so that they are sent by the executor running alert after migration or restart
+	Groups     map[string]*NotificationGroup `json:"groups"`
+	UpdateTime time.Time
 }
 
 type StatusResource struct {
-	CurrentLevel       string          `json:current_level`
-	PositiveCount      uint32          `json:positive_count`
-	CumulatedSendCount uint32          `json:cumulated_send_count`
-	NextResendInterval uint32          `json:next_resend_interval`
-	NextSendableTime   time.Time       `json:next_sendable_time`
-	AggregatedAlerts   AggregatedAlert `json:aggregated_alerts`
+	CurrentLevel       string            `json:current_level`
+	PositiveCount      uint32            `json:positive_count`
+	NegativeCount      uint32            `json:"negative_count"`
+	CumulatedSendCount uint32            `json:cumulated_send_count`
+	NextResendInterval uint32            `json:next_resend_interval`
+	NextSendableTime   time.Time         `json:next_sendable_time`
+	AggregatedAlerts   AggregatedAlert   `json:aggregated_alerts`
+	Flapping           bool              `json:"flapping"`
+	Transitions        []time.Time       `json:"transitions"`
+	Inhibited          bool              `json:"inhibited"`
+	Silenced           bool              `json:"silenced"`
+	Acknowledger       string            `json:"acknowledger"`
+	AcknowledgeTime    time.Time         `json:"acknowledge_time"`
+	SnoozeUntil        time.Time         `json:"snooze_until"`
+	FiringTime         time.Time         `json:"firing_time"`
+	EscalationSteps    map[string]uint32 `json:"escalation_steps"`
+	Transition         string            `json:"transition"`
 }
 
 type AggregatedAlert struct {
@@ -85,6 +147,23 @@ type AggregatedAlert struct {
 	LastAlertValues []RecordedMetric `json:"last_alert_values"`
 }
 
+//NotificationGroup collects notifications of resources sharing a group key until the group is flushed.
+type NotificationGroup struct {
+	Labels           string                `json:"labels"`
+	Receivers        []Receiver            `json:"receivers"`
+	Entries          map[string]GroupEntry `json:"entries"`
+	EntryKeys        []string              `json:"entry_keys"`
+	FirstPendingTime time.Time             `json:"first_pending_time"`
+	LastSentTime     time.Time             `json:"last_sent_time"`
+}
+
+//GroupEntry is the latest notification of a resource in group, resume replaces firing of the same resource.
+type GroupEntry struct {
+	RuleId       string                         `json:"rule_id"`
+	ResourceName string                         `json:"resource_name"`
+	Param        notification.NotificationParam `json:"param"`
+}
+
 type MonitoringRequest struct {
 	RulesSamePeriod map[uint32][]string
 	TickCount       map[uint32]uint32
@@ -94,6 +173,11 @@ type MonitoringRequest struct {
 type RecordedMetric struct {
 	RuleName     string
 	ResourceName string
+	Value        float64
+	ForecastTime int64
+	NoData       bool
+	Pending      bool
+	Severity     string
 	tvs          []metric.TV
 }
 
@@ -101,35 +185,152 @@ const (
 	TickPeriodSecond = 10
 )
 
-func NewAlertRunner(alertId string, updateCh chan string) *AlertRunner {
+//Rank of severities, a firing rule inhibits inhibit-enabled rules of lower rank on the same resource.
+var severityRank = map[string]int{
+	"minor":    1,
+	"major":    2,
+	"critical": 3,
+}
+
+func NewAlertRunner(alertId string, updateCh chan string, stormDetector *StormDetector) *AlertRunner {
 	runner := &AlertRunner{}
 
 	runner.AlertConfig.AlertId = alertId
 	runner.AlertStatus.UpdateTime = time.Now()
 	runner.SignalCh = make(chan string, 10)
 	runner.UpdateCh = updateCh
+	runner.StormDetector = stormDetector
+	//Deferred notifications may be left by the executor running alert before migration
+	runner.HasDeferred = true
+	runner.Deferred = make(map[string]string)
 
 	return runner
 }
 
-func (ar *AlertRunner) parseNotification(nfAddressListId string) {
-	ar.AlertConfig.NfAddressListId = nfAddressListId
+func (ar *AlertRunner) parseActions(actions []models.Action) {
+	ar.AlertConfig.Actions = []ConfigAction{}
+	for _, action := range actions {
+		triggerStatus, err := models.ParseTriggerStatus(action.TriggerStatus)
+		if err != nil {
+			logger.Error(nil, "Parse Alert[%s] Action[%s] trigger status error: %v", ar.AlertConfig.AlertId, action.ActionId, err)
+			continue
+		}
+		if !models.IsTriggerActionSupported(action.TriggerAction) {
+			logger.Error(nil, "Parse Alert[%s] Action[%s] unsupported trigger action [%s]", ar.AlertConfig.AlertId, action.ActionId, action.TriggerAction)
+			continue
+		}
+
+		configAction := ConfigAction{
+			ActionId:        action.ActionId,
+			TriggerStatus:   triggerStatus,
+			TriggerAction:   action.TriggerAction,
+			NfAddressListId: action.NfAddressListId,
+		}
+		if configAction.TriggerAction == "" {
+			configAction.TriggerAction = models.TriggerActionNotification
+		}
+		if action.EscalationConfig != "" {
+			configAction.Escalation, err = models.ParseEscalationConfig(action.EscalationConfig)
+			if err != nil {
+				logger.Error(nil, "Parse Alert[%s] Action[%s] escalation config [%s] error: %v", ar.AlertConfig.AlertId, action.ActionId, action.EscalationConfig, err)
+			}
+		}
+
+		ar.AlertConfig.Actions = append(ar.AlertConfig.Actions, configAction)
+	}
 }
 
-func (ar *AlertRunner) parsePolicyConfig(alertDetail rs.AlertDetail) {
-	err := json.Unmarshal([]byte(alertDetail.PolicyConfig), &ar.AlertConfig.PolicyConfig)
-	if err != nil {
-		//Fill a default config for policy
-		ar.AlertConfig.PolicyConfig = make(map[string]ConfigPolicy)
+//isTriggeredBy reports whether action is triggered by any of transitions.
+func (ca *ConfigAction) isTriggeredBy(transitions ...string) bool {
+	for _, transition := range transitions {
+		for _, triggerStatus := range ca.TriggerStatus {
+			if triggerStatus == transition {
+				return true
+			}
+		}
+	}
+	return false
+}
 
-		ar.AlertConfig.PolicyConfig["minor"] = ConfigPolicy{"not-repeat", 3, 3}
-		ar.AlertConfig.PolicyConfig["major"] = ConfigPolicy{"exp-minutes", 2, 5}
-		ar.AlertConfig.PolicyConfig["critical"] = ConfigPolicy{"fixed-minutes", 1, 8}
+func defaultRepeatConfigs() map[string]models.RepeatConfig {
+	repeatConfigs := make(map[string]models.RepeatConfig)
+	repeatConfigs["minor"] = models.RepeatConfig{RepeatType: models.RepeatTypeNotRepeat, RepeatIntervalInitvalue: 3, MaxSendCount: 3}
+	repeatConfigs["major"] = models.RepeatConfig{RepeatType: models.RepeatTypeExpMinutes, RepeatIntervalInitvalue: 2, MaxSendCount: 5}
+	repeatConfigs["critical"] = models.RepeatConfig{RepeatType: models.RepeatTypeFixedMinutes, RepeatIntervalInitvalue: 1, MaxSendCount: 8}
+	return repeatConfigs
+}
+
+//parsePolicyConfig falls back to the default policy config when it is empty or invalid, the error is returned as a warning.
+//Severity with invalid repeat config is left out of policy config, so that it is sent only once.
+func (ar *AlertRunner) parsePolicyConfig(alertDetail rs.AlertDetail) error {
+	repeatConfigs := defaultRepeatConfigs()
+	var configErr error
+	if alertDetail.PolicyConfig != "" {
+		parsedConfigs, err := models.ParsePolicyConfig(alertDetail.PolicyConfig)
+		if err != nil {
+			configErr = err
+		} else {
+			repeatConfigs = parsedConfigs
+		}
+	}
+
+	ar.AlertConfig.PolicyConfig = make(map[string]ConfigPolicy)
+	for severity, repeatConfig := range repeatConfigs {
+		strategy, err := models.NewRepeatStrategy(repeatConfig)
+		if err != nil {
+			if configErr == nil {
+				configErr = fmt.Errorf("repeat config of [%s] error: %v", severity, err)
+			}
+			continue
+		}
+		ar.AlertConfig.PolicyConfig[severity] = ConfigPolicy{repeatConfig, strategy}
 	}
 
+	var err error
+
 	ar.AlertConfig.AvailableStartTime = alertDetail.AvailableStartTime
 	ar.AlertConfig.AvailableEndTime = alertDetail.AvailableEndTime
+	ar.AlertConfig.AvailableSchedule = nil
+	if alertDetail.AvailableSchedule != "" {
+		ar.AlertConfig.AvailableSchedule, err = models.ParsePolicySchedule(alertDetail.AvailableSchedule)
+		if err != nil {
+			logger.Error(nil, "Parse Alert[%s] schedule [%s] error: %v", ar.AlertConfig.AlertId, alertDetail.AvailableSchedule, err)
+		}
+	}
+	ar.AlertConfig.GroupConfig = nil
+	if alertDetail.GroupConfig != "" {
+		ar.AlertConfig.GroupConfig, err = models.ParsePolicyGroupConfig(alertDetail.GroupConfig)
+		if err != nil {
+			logger.Error(nil, "Parse Alert[%s] group config [%s] error: %v", ar.AlertConfig.AlertId, alertDetail.GroupConfig, err)
+		}
+	}
+	ar.AlertConfig.Route = nil
+	if alertDetail.RouteConfig != "" {
+		ar.AlertConfig.Route, err = models.ParsePolicyRoute(alertDetail.RouteConfig)
+		if err != nil {
+			logger.Error(nil, "Parse Alert[%s] route config [%s] error: %v", ar.AlertConfig.AlertId, alertDetail.RouteConfig, err)
+		}
+	}
 	ar.AlertConfig.Language = alertDetail.Language
+
+	return configErr
+}
+
+//parseTemplate loads template of policy in its language, built-in template of the language is used if none is stored or it is broken.
+func (ar *AlertRunner) parseTemplate(alertDetail rs.AlertDetail) {
+	ar.AlertConfig.Template = notification.DefaultTemplate(alertDetail.Language)
+
+	template, err := rs.QueryTemplate(alertDetail.PolicyId, alertDetail.Language)
+	if err != nil || template == nil {
+		return
+	}
+
+	t, err := notification.NewTemplate(template.Format, template.Title, template.Content)
+	if err != nil {
+		logger.Error(nil, "Parse Alert[%s] Template[%s] error: %v", ar.AlertConfig.AlertId, template.TemplateId, err)
+		return
+	}
+	ar.AlertConfig.Template = t
 }
 
 func (ar *AlertRunner) parseRules() {
@@ -140,7 +341,12 @@ func (ar *AlertRunner) parseRules() {
 
 	for _, ruleDetail := range ruleDetails {
 		threshold, _ := strconv.ParseFloat(ruleDetail.Thresholds, 64)
+		recoveryThreshold, recoveryErr := strconv.ParseFloat(ruleDetail.RecoveryThresholds, 64)
 		scale, _ := strconv.ParseFloat(ruleDetail.MetricParam, 64)
+		condition, err := models.ParseRuleCondition(ruleDetail.ConditionType)
+		if err != nil {
+			logger.Error(nil, "Parse Rule[%s] condition [%s] error: %v", ruleDetail.RuleId, ruleDetail.ConditionType, err)
+		}
 		ruleInfo := RuleInfo{
 			RuleName:         ruleDetail.RuleName,
 			Disabled:         ruleDetail.Disabled,
@@ -148,11 +354,33 @@ func (ar *AlertRunner) parseRules() {
 			Severity:         ruleDetail.Severity,
 			MetricsType:      ruleDetail.MetricsType,
 			ConditionType:    ruleDetail.ConditionType,
+			Condition:        condition,
 			Thresholds:       threshold,
 			Scale:            scale,
 			Unit:             ruleDetail.Unit,
 			ConsecutiveCount: ruleDetail.ConsecutiveCount,
 			Inhibit:          ruleDetail.Inhibit,
+			PredictHorizon:   ruleDetail.PredictHorizon,
+			NodataState:      ruleDetail.NodataState,
+		}
+		ruleInfo.RecoveryEnabled = recoveryErr == nil
+		ruleInfo.RecoveryThresholds = recoveryThreshold
+		ruleInfo.ConsecutiveClearCount = ruleDetail.ConsecutiveClearCount
+
+		//Rule thresholds and severity is the first level, followed by more severe levels
+		ruleInfo.Levels = []LevelInfo{{threshold, ruleDetail.Severity}}
+		ruleLevels, err := models.ParseRuleLevels(ruleDetail.Levels)
+		if err == nil {
+			//Levels saved before they were checked could be unordered, the rule then fires with its own severity only
+			err = models.CheckRuleLevels(ruleDetail.ConditionType, ruleDetail.Severity, ruleDetail.Thresholds, ruleLevels)
+		}
+		if err != nil {
+			logger.Error(nil, "Parse Rule[%s] levels [%s] error: %v", ruleDetail.RuleId, ruleDetail.Levels, err)
+			ruleLevels = nil
+		}
+		for _, ruleLevel := range ruleLevels {
+			levelThreshold, _ := strconv.ParseFloat(ruleLevel.Thresholds, 64)
+			ruleInfo.Levels = append(ruleInfo.Levels, LevelInfo{levelThreshold, ruleLevel.Severity})
 		}
 
 		ruleInfo.MetricName = ruleDetail.MetricName
@@ -191,8 +419,27 @@ func (ar *AlertRunner) getResetResourceStatus(ruleId string) StatusResource {
 	return resourceStatus
 }
 
+//getKeptResourceStatus resets evaluation of resource but keeps flap detection and snooze which outlive a single alert.
+func (ar *AlertRunner) getKeptResourceStatus(ruleId string, oldStatus StatusResource) StatusResource {
+	resourceStatus := ar.getResetResourceStatus(ruleId)
+	resourceStatus.Flapping = oldStatus.Flapping
+	resourceStatus.Transitions = oldStatus.Transitions
+	resourceStatus.SnoozeUntil = oldStatus.SnoozeUntil
+
+	return resourceStatus
+}
+
+func (ar *AlertRunner) isSnoozed(resourceStatus *StatusResource) bool {
+	return resourceStatus.SnoozeUntil.After(time.Now())
+}
+
+//resetAlertStatus keeps pending groups, which are notifications already decided to send.
 func (ar *AlertRunner) resetAlertStatus() {
 	ar.AlertStatus.ResourceStatus = make(map[string]StatusResource)
+	ar.AlertStatus.RuleStatus = make(map[string]StatusResource)
+	if ar.AlertStatus.Groups == nil {
+		ar.AlertStatus.Groups = make(map[string]*NotificationGroup)
+	}
 }
 
 func (ar *AlertRunner) parseAlertConfigStatus(alertDetail rs.AlertDetail) {
@@ -204,6 +451,25 @@ func (ar *AlertRunner) parseAlertConfigStatus(alertDetail rs.AlertDetail) {
 		logger.Debug(nil, "Parse Alert Status error: %v", err)
 		ar.resetAlertStatus()
 	}
+	if ar.AlertStatus.ResourceStatus == nil {
+		ar.AlertStatus.ResourceStatus = make(map[string]StatusResource)
+	}
+	if ar.AlertStatus.RuleStatus == nil {
+		ar.AlertStatus.RuleStatus = make(map[string]StatusResource)
+	}
+	if ar.AlertStatus.Groups == nil {
+		ar.AlertStatus.Groups = make(map[string]*NotificationGroup)
+	}
+	//Rule without data was kept as resource with empty name before
+	for k, v := range ar.AlertStatus.ResourceStatus {
+		param := strings.SplitN(k, " ", 2)
+		if len(param) != 2 || param[1] == "" {
+			delete(ar.AlertStatus.ResourceStatus, k)
+			if v.CurrentLevel == "nodata" {
+				ar.AlertStatus.RuleStatus[param[0]] = v
+			}
+		}
+	}
 	ar.AlertStatus.Unlock()
 }
 
@@ -218,22 +484,41 @@ func (ar *AlertRunner) loadAlertInfo() {
 
 	ar.AlertConfig.LoadSuccess = true
 
+	ar.AlertConfig.AlertName = alertDetail.AlertName
+
 	//1. Parse Resource
 	ar.AlertConfig.RsTypeName = alertDetail.RsTypeName
 	ar.AlertConfig.RsTypeParam = alertDetail.RsTypeParam
 	ar.AlertConfig.RsFilterName = alertDetail.RsFilterName
 	ar.AlertConfig.RsFilterParam = alertDetail.RsFilterParam
 
-	//2. Parse Notification
-	ar.parseNotification(alertDetail.NfAddressListId)
+	//2. Parse Actions
+	actions, err := rs.QueryActions(ar.AlertConfig.AlertId)
+	if err != nil {
+		logger.Error(nil, "loadAlertInfo error: %v", err)
+		ar.AlertConfig.LoadSuccess = false
+		return
+	}
+	ar.parseActions(actions)
 
 	//3. Parse policy config
-	ar.parsePolicyConfig(alertDetail)
+	err = ar.parsePolicyConfig(alertDetail)
+	if err == nil {
+		ar.AlertConfig.PolicyConfigError = ""
+	} else if err.Error() != ar.AlertConfig.PolicyConfigError {
+		//Alert keeps running with the default policy config, the error is recorded in history once so that it is not silent
+		logger.Warn(nil, "loadAlertInfo Alert[%s] policy config [%s] error, fall back to default: %v", ar.AlertConfig.AlertId, alertDetail.PolicyConfig, err)
+		ar.writeHistory("", "config_error", fmt.Sprintf("policy config error, fall back to default: %v", err), "", "", "")
+		ar.AlertConfig.PolicyConfigError = err.Error()
+	}
 
-	//4. Parse Rules config
+	//4. Parse notification template
+	ar.parseTemplate(alertDetail)
+
+	//5. Parse Rules config
 	ar.parseRules()
 
-	//5. Parse Alert status
+	//6. Parse Alert status
 	ar.parseAlertConfigStatus(alertDetail)
 
 	logger.Debug(nil, "loadAlertInfo alert: %v", ar)
@@ -278,10 +563,19 @@ func (ar *AlertRunner) getOneMetric(period uint32, ch chan metric.ResourceMetric
 		return
 	}
 
+	returnedRules := make(map[string]bool)
 	for _, rm := range resourceMetrics {
 		logger.Debug(nil, "getOneMetric %v", rm)
+		returnedRules[rm.RuleId] = true
 		ch <- rm
 	}
+
+	//Rules without any returned metric are checked with empty resource metric to find out nodata
+	for _, ruleId := range ar.AlertConfig.Requests.RulesSamePeriod[period] {
+		if !returnedRules[ruleId] {
+			ch <- metric.ResourceMetrics{RuleId: ruleId, MetricName: ar.AlertConfig.Rules[ruleId].MetricName, ResourceMetric: map[string][]metric.TV{}}
+		}
+	}
 }
 
 func (ar *AlertRunner) getResourceMetrics(ch chan metric.ResourceMetrics) {
@@ -314,43 +608,248 @@ func (ar *AlertRunner) getResourceMetrics(ch chan metric.ResourceMetrics) {
 	wg.Wait()
 }
 
-func (ar *AlertRunner) readRuleResourceMetric(resourceMetrics metric.ResourceMetrics, triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric) string {
+//readRuleResourceMetric sorts resources of rule by evaluation result, resources with data that fails to evaluate are skipped,
+//they are neither nodata nor resumed and keep their status until the next evaluation.
+//It returns false if the rule could not be evaluated at all, eg. its condition failed to parse.
+func (ar *AlertRunner) readRuleResourceMetric(resourceMetrics metric.ResourceMetrics, triggeredMetrics *[]RecordedMetric, resumedMetrics *[]RecordedMetric, noDataMetrics *[]RecordedMetric, skippedResources *[]string) bool {
 	rule := ar.AlertConfig.Rules[resourceMetrics.RuleId]
-	condition := rule.ConditionType
+	condition := rule.Condition
 	threshold := rule.Thresholds
 	scale := rule.Scale
 
+	if condition == nil {
+		logger.Error(nil, "readRuleResourceMetric Rule[%s] has no valid condition, rule will be skipped!", resourceMetrics.RuleId)
+		return false
+	}
+
 	for resourceName, timeValue := range resourceMetrics.ResourceMetric {
 		logger.Debug(nil, "ResourceMetric %v, %v", resourceName, timeValue)
 		if len(timeValue) < int(1) {
+			*noDataMetrics = append(*noDataMetrics, RecordedMetric{RuleName: rule.RuleName, ResourceName: resourceName, NoData: true})
 			continue
 		}
-		//Fetch last time value
-		v, err := strconv.ParseFloat(timeValue[(len(timeValue)-int(1))].V, 64)
+		var v float64
+		var forecastTime int64
+		var err error
+		if rule.MetricsType == metric.AggregatePredictLinear {
+			//Value is predicted after horizon of rule, forecast time is when the fitted line reaches thresholds
+			v, forecastTime, err = metric.PredictLinear(timeValue, float64(rule.PredictHorizon*60), threshold/scale)
+		} else {
+			//Aggregate time values by metrics type, default to last time value
+			v, err = metric.Aggregate(rule.MetricsType, timeValue)
+		}
+		v = v * scale
 		if err != nil {
-			logger.Error(nil, "readRuleResourceMetric error %v, value will be ignored!", err)
+			logger.Error(nil, "readRuleResourceMetric Rule[%s] Resource[%s] aggregate [%s] error %v, resource will be skipped!", resourceMetrics.RuleId, resourceName, rule.MetricsType, err)
+			*skippedResources = append(*skippedResources, resourceName)
 			continue
 		}
-		resourceSet := false
-		switch condition {
-		case ">=":
-			resourceSet = (v*scale >= threshold)
-		case ">":
-			resourceSet = (v*scale > threshold)
-		case "<=":
-			resourceSet = (v*scale <= threshold)
-		case "<":
-			resourceSet = (v*scale < threshold)
+		resourceSet, err := condition.Eval(map[string]float64{
+			models.RuleConditionValue:     v,
+			models.RuleConditionThreshold: threshold,
+		})
+		if err != nil {
+			logger.Error(nil, "readRuleResourceMetric eval condition [%s] error %v, resource will be skipped!", condition, err)
+			*skippedResources = append(*skippedResources, resourceName)
+			continue
 		}
 
+		recordedMetric := RecordedMetric{RuleName: rule.RuleName, ResourceName: resourceName, Value: v, ForecastTime: forecastTime, tvs: timeValue}
 		if resourceSet {
-			*triggeredMetrics = append(*triggeredMetrics, RecordedMetric{rule.RuleName, resourceName, timeValue})
-		} else {
-			*resumedMetrics = append(*resumedMetrics, RecordedMetric{rule.RuleName, resourceName, timeValue})
+			//Find the most severe level met
+			recordedMetric.Severity = rule.Severity
+			for _, level := range rule.Levels[1:] {
+				levelSet, err := condition.Eval(map[string]float64{
+					models.RuleConditionValue:     v,
+					models.RuleConditionThreshold: level.Thresholds,
+				})
+				if err == nil && levelSet {
+					recordedMetric.Severity = level.Severity
+				}
+			}
+			*triggeredMetrics = append(*triggeredMetrics, recordedMetric)
+			continue
+		}
+
+		//Value between thresholds and recovery thresholds is still pending for resume
+		if rule.RecoveryEnabled {
+			recordedMetric.Pending, err = condition.Eval(map[string]float64{
+				models.RuleConditionValue:     v,
+				models.RuleConditionThreshold: rule.RecoveryThresholds,
+			})
+			if err != nil {
+				logger.Error(nil, "readRuleResourceMetric eval recovery condition [%s] error %v", condition, err)
+			}
+		}
+		*resumedMetrics = append(*resumedMetrics, recordedMetric)
+	}
+
+	return true
+}
+
+//getLevelIndex returns position of severity in rule levels, -1 if not found.
+func (ar *AlertRunner) getLevelIndex(ruleId string, severity string) int {
+	for i, level := range ar.AlertConfig.Rules[ruleId].Levels {
+		if level.Severity == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+//getResourceSeverity returns the current severity of resource, or severity of rule if resource is not firing.
+func (ar *AlertRunner) getResourceSeverity(newStatus *StatusResource, ruleId string) string {
+	if ar.getLevelIndex(ruleId, newStatus.CurrentLevel) >= 0 {
+		return newStatus.CurrentLevel
+	}
+	return ar.AlertConfig.Rules[ruleId].Severity
+}
+
+//getResourceLabels returns resource filter param of alert as labels of its resources.
+func (ar *AlertRunner) getResourceLabels() map[string]string {
+	filterParam := make(map[string]interface{})
+	json.Unmarshal([]byte(ar.AlertConfig.RsFilterParam), &filterParam)
+
+	labels := make(map[string]string)
+	for k, v := range filterParam {
+		labels[k] = fmt.Sprintf("%v", v)
+	}
+	return labels
+}
+
+//resolveRoutes routes notification of resource through routing tree of policy,
+//empty address list of route receiver means address list of action.
+func (ar *AlertRunner) resolveRoutes(newStatus *StatusResource, ruleId string, resourceName string) []models.RouteReceiver {
+	var routeReceivers []models.RouteReceiver
+	if ar.AlertConfig.Route != nil {
+		severity := ar.getResourceSeverity(newStatus, ruleId)
+		routeReceivers = ar.AlertConfig.Route.Resolve(severity, ar.AlertConfig.Rules[ruleId].RuleName, processResourceName(resourceName), ar.getResourceLabels())
+	}
+	if len(routeReceivers) == 0 {
+		routeReceivers = []models.RouteReceiver{{}}
+	}
+	return routeReceivers
+}
+
+func appendReceiver(receivers []Receiver, receiver Receiver) []Receiver {
+	for _, r := range receivers {
+		if r == receiver {
+			return receivers
 		}
 	}
+	return append(receivers, receiver)
+}
+
+//getActionReceivers returns address lists of actions triggered by any of transitions, regardless of routing.
+func (ar *AlertRunner) getActionReceivers(transitions ...string) []Receiver {
+	receivers := []Receiver{}
+	for _, action := range ar.AlertConfig.Actions {
+		if action.isTriggeredBy(transitions...) {
+			receivers = appendReceiver(receivers, Receiver{action.TriggerAction, action.NfAddressListId})
+		}
+	}
+	return receivers
+}
+
+//getResourceReceivers returns receivers of notification of resource on transition,
+//every action triggered by the transition notifies address lists routed for resource through its own notifier.
+func (ar *AlertRunner) getResourceReceivers(newStatus *StatusResource, ruleId string, resourceName string, transition string) []Receiver {
+	routeReceivers := ar.resolveRoutes(newStatus, ruleId, resourceName)
+
+	receivers := []Receiver{}
+	for _, action := range ar.AlertConfig.Actions {
+		if !action.isTriggeredBy(transition) {
+			continue
+		}
+		for _, routeReceiver := range routeReceivers {
+			nfAddressListId := routeReceiver.NfAddressListId
+			if nfAddressListId == "" {
+				nfAddressListId = action.NfAddressListId
+			}
+			receivers = appendReceiver(receivers, Receiver{action.TriggerAction, nfAddressListId})
+		}
+	}
+	return receivers
+}
+
+//getActiveTransition returns transition of firing resource to notify.
+func getActiveTransition(newStatus *StatusResource) string {
+	if newStatus.CumulatedSendCount > 0 {
+		return models.TriggerStatusRepeated
+	}
+	if newStatus.Transition == models.TriggerStatusEscalated {
+		return models.TriggerStatusEscalated
+	}
+	return models.TriggerStatusTriggered
+}
+
+//queueToReceivers queues notification to address lists of each notifier in outbox, which is delivered by outbox dispatcher,
+//key identifies the notification so that it is queued only once for the same address lists, ids of outboxes are joined by comma.
+func (ar *AlertRunner) queueToReceivers(receivers []Receiver, email *notification.Email, key string, ruleId string, resourceName string) (bool, string) {
+	notifiers := []string{}
+	nfAddressListIds := make(map[string][]string)
+	for _, receiver := range receivers {
+		if _, ok := nfAddressListIds[receiver.Notifier]; !ok {
+			notifiers = append(notifiers, receiver.Notifier)
+		}
+		nfAddressListIds[receiver.Notifier] = append(nfAddressListIds[receiver.Notifier], receiver.NfAddressListId)
+	}
+
+	queuedSuccess := len(notifiers) > 0
+	outboxIds := []string{}
+	for _, notifier := range notifiers {
+		n := notification.GetNotifier(notifier)
+		if n == nil {
+			logger.Error(nil, "queueToReceivers Alert[%s] unsupported notifier [%s]", ar.AlertConfig.AlertId, notifier)
+			queuedSuccess = false
+			continue
+		}
+
+		addressGroups := [][]string{nfAddressListIds[notifier]}
+		if notification.IsPerAddress(n) {
+			addressGroups = [][]string{}
+			for _, address := range nfAddressListIds[notifier] {
+				addressGroups = append(addressGroups, []string{address})
+			}
+		}
+
+		for _, addresses := range addressGroups {
+			idempotencyKey := models.NewOutboxIdempotencyKey(ar.AlertConfig.AlertId, key, notifier, addresses)
+			outbox := models.NewOutbox(idempotencyKey, ar.AlertConfig.AlertId, ruleId, resourceName, notifier, addresses, email.Title, email.Content, email.Html)
+			outboxId, err := rs.EnqueueOutbox(outbox)
+			if err != nil {
+				logger.Error(nil, "queueToReceivers Alert[%s] notifier [%s] addresses %v failed: %v", ar.AlertConfig.AlertId, notifier, addresses, err)
+				queuedSuccess = false
+				continue
+			}
+			outboxIds = append(outboxIds, outboxId)
+		}
+	}
+
+	return queuedSuccess, strings.Join(outboxIds, ",")
+}
 
-	return resourceMetrics.RuleId
+//getResourcePolicyConfig returns repeat config of the first route of resource, or policy config of the current severity of resource,
+//severity missing in policy config is sent only once.
+func (ar *AlertRunner) getResourcePolicyConfig(newStatus *StatusResource, ruleId string, resourceName string) ConfigPolicy {
+	if ar.AlertConfig.Route != nil {
+		repeatConfig := ar.resolveRoutes(newStatus, ruleId, resourceName)[0].RepeatConfig
+		if repeatConfig != nil {
+			strategy, err := models.NewRepeatStrategy(*repeatConfig)
+			if err == nil {
+				return ConfigPolicy{*repeatConfig, strategy}
+			}
+		}
+	}
+
+	severity := ar.getResourceSeverity(newStatus, ruleId)
+	policyConfig, ok := ar.AlertConfig.PolicyConfig[severity]
+	if !ok || policyConfig.Strategy == nil {
+		repeatConfig := models.RepeatConfig{RepeatType: models.RepeatTypeNotRepeat}
+		strategy, _ := models.NewRepeatStrategy(repeatConfig)
+		return ConfigPolicy{repeatConfig, strategy}
+	}
+	return policyConfig
 }
 
 func getRuleResourceKey(ruleId string, resourceName string) string {
@@ -364,13 +863,32 @@ func (ar *AlertRunner) signalUpdate() {
 func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bool {
 	triggeredMetrics := []RecordedMetric{}
 	resumedMetrics := []RecordedMetric{}
+	noDataMetrics := []RecordedMetric{}
+	skippedResources := []string{}
 
-	ruleId := ar.readRuleResourceMetric(resourceMetrics, &triggeredMetrics, &resumedMetrics)
+	ruleId := resourceMetrics.RuleId
+	if !ar.readRuleResourceMetric(resourceMetrics, &triggeredMetrics, &resumedMetrics, &noDataMetrics, &skippedResources) {
+		return false
+	}
 
 	oldResourceStatus := ar.AlertStatus.ResourceStatus
 	newResourceStatus := make(map[string]StatusResource)
 
-	needUpdate := false
+	absent := ar.collectNodataMetrics(ruleId, oldResourceStatus, triggeredMetrics, resumedMetrics, skippedResources, &noDataMetrics)
+
+	needUpdate := ar.checkRuleNodata(ruleId, absent)
+
+	if ar.checkNodataMetrics(ruleId, noDataMetrics, oldResourceStatus, newResourceStatus) {
+		needUpdate = true
+	}
+
+	//Resource skipped keeps its status
+	for _, resourceName := range skippedResources {
+		ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+		if oldStatus, ok := oldResourceStatus[ruleResourceKey]; ok {
+			newResourceStatus[ruleResourceKey] = oldStatus
+		}
+	}
 
 	for _, triggeredMetric := range triggeredMetrics {
 		logger.Debug(nil, "triggeredMetric %v", triggeredMetric)
@@ -383,26 +901,38 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			newStatus = ar.getResetResourceStatus(ruleId)
 		}
 
-		operation := ""
-		resourceIsAlert := false
-		newStatus.PositiveCount = newStatus.PositiveCount + 1
-		if newStatus.PositiveCount >= ar.AlertConfig.Rules[ruleId].ConsecutiveCount {
-			resourceIsAlert = true
-			if newStatus.CurrentLevel == "cleared" {
-				newStatus.CurrentLevel = ar.AlertConfig.Rules[ruleId].Severity
-				newStatus.NextSendableTime = time.Now()
-				operation = "trigger"
-			}
+		//Data comes back, evaluate from a clean status
+		if newStatus.CurrentLevel == "nodata" {
+			newStatus = ar.getKeptResourceStatus(ruleId, newStatus)
 		}
 
+		resourceIsAlert, operation := ar.transitTriggered(&newStatus, ruleId, triggeredMetric.Severity)
+
 		if operation == "trigger" {
 			logger.Debug(nil, "Rule[%v] Resource[%v] %v triggered, write to message", ruleId, resourceName, triggeredMetric)
 			ar.writeHistory("", "triggered", fmt.Sprintf("%v", triggeredMetric), "", ruleId, resourceName)
 			needUpdate = true
 		}
 
+		if operation == "escalate" || operation == "deescalate" {
+			logger.Debug(nil, "Rule[%v] Resource[%v] %v %sd to %s, write to message", ruleId, resourceName, triggeredMetric, operation, newStatus.CurrentLevel)
+			ar.writeHistory("", operation+"d", fmt.Sprintf("%v", triggeredMetric), "", ruleId, resourceName)
+			needUpdate = true
+		}
+
+		if ar.checkFlapping(&newStatus, ruleId, resourceName, operation == "trigger", triggeredMetric) {
+			needUpdate = true
+		}
+
 		if resourceIsAlert {
+			ar.putFiringState(ruleId, resourceName, newStatus.CurrentLevel)
+		}
+
+		if resourceIsAlert && !newStatus.Flapping {
 			ar.sendActiveNotification(&newStatus, ruleId, resourceName, triggeredMetrics)
+			if ar.escalateNotification(&newStatus, ruleId, resourceName) {
+				needUpdate = true
+			}
 		}
 
 		newResourceStatus[ruleResourceKey] = newStatus
@@ -419,16 +949,16 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			newStatus = ar.getResetResourceStatus(ruleId)
 		}
 
-		operation := ""
-		newStatus.PositiveCount = 0
-		if newStatus.CurrentLevel != "cleared" {
-			newStatus = ar.getResetResourceStatus(ruleId)
-			operation = "resume"
+		operation := ar.transitResumed(&newStatus, ruleId, resumedMetric.Pending)
+
+		if ar.checkFlapping(&newStatus, ruleId, resourceName, operation == "resume", resumedMetric) {
+			needUpdate = true
 		}
 
 		if operation == "resume" {
 			logger.Debug(nil, "Rule[%v] Resource[%v] %v resumed, write to message", ruleId, resourceName, resumedMetric)
 			ar.writeHistory("", "resumed", fmt.Sprintf("%v", resumedMetric), "", ruleId, resourceName)
+			DeleteFiringState(ar.AlertConfig.RsTypeName, resourceName, ar.AlertConfig.AlertId, ruleId)
 
 			resumeStatus := StatusResource{}
 			if _, ok := oldResourceStatus[ruleResourceKey]; ok {
@@ -436,7 +966,10 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 			} else {
 				resumeStatus = ar.getResetResourceStatus(ruleId)
 			}
-			ar.sendResumeNotification(&resumeStatus, ruleId, resourceName, resumedMetric, resumedMetrics)
+			//Resource inhibited has not been notified as active
+			if !newStatus.Flapping && !resumeStatus.Inhibited && !resumeStatus.Silenced {
+				ar.sendResumeNotification(&resumeStatus, ruleId, resourceName, resumedMetric, resumedMetrics)
+			}
 			needUpdate = true
 		}
 
@@ -456,96 +989,393 @@ func (ar *AlertRunner) checkOneMetric(resourceMetrics metric.ResourceMetrics) bo
 	return needUpdate
 }
 
-func (ar *AlertRunner) checkMetrics(ch chan metric.ResourceMetrics) {
-	needUpdate := false
+//transitTriggered counts evaluation of resource meeting severity, it returns whether resource is firing
+//and the operation of the transition, trigger, escalate, deescalate or none.
+func (ar *AlertRunner) transitTriggered(newStatus *StatusResource, ruleId string, severity string) (bool, string) {
+	newStatus.NegativeCount = 0
+	newStatus.PositiveCount = newStatus.PositiveCount + 1
+	if newStatus.PositiveCount < ar.AlertConfig.Rules[ruleId].ConsecutiveCount {
+		return false, ""
+	}
 
-	for resourceMetrics := range ch {
-		logger.Debug(nil, "checkMetrics %v", resourceMetrics)
+	operation := ""
+	if newStatus.CurrentLevel == "cleared" {
+		newStatus.CurrentLevel = severity
+		newStatus.NextResendInterval = ar.AlertConfig.PolicyConfig[severity].RepeatIntervalInitvalue
+		newStatus.NextSendableTime = time.Now()
+		newStatus.FiringTime = time.Now()
+		newStatus.Transition = models.TriggerStatusTriggered
+		operation = "trigger"
+	} else if newStatus.CurrentLevel != severity {
+		//Move between levels, repeat from the beginning with policy config of the new severity
+		if ar.getLevelIndex(ruleId, severity) > ar.getLevelIndex(ruleId, newStatus.CurrentLevel) {
+			operation = "escalate"
+			//Acknowledge only covers the acknowledged level
+			newStatus.Acknowledger = ""
+			newStatus.AcknowledgeTime = time.Time{}
+		} else {
+			operation = "deescalate"
+		}
+		newStatus.CurrentLevel = severity
+		newStatus.Transition = operation + "d"
+		newStatus.CumulatedSendCount = 0
+		newStatus.NextResendInterval = ar.AlertConfig.PolicyConfig[severity].RepeatIntervalInitvalue
+		newStatus.NextSendableTime = time.Now()
+	}
 
-		checkResult := ar.checkOneMetric(resourceMetrics)
+	return true, operation
+}
 
-		needUpdate = needUpdate || checkResult
+//transitResumed counts evaluation of resource not meeting the rule, it returns resume once resource
+//has been healthy for enough consecutive evaluations, value pending between thresholds resets the count.
+func (ar *AlertRunner) transitResumed(newStatus *StatusResource, ruleId string, pending bool) string {
+	newStatus.PositiveCount = 0
+	if newStatus.CurrentLevel == "cleared" {
+		return ""
 	}
 
-	if needUpdate {
-		ar.signalUpdate()
+	if pending {
+		newStatus.NegativeCount = 0
+		return ""
 	}
-}
-
-func (ar *AlertRunner) writeHistory(historyName string, status string, content string, notificatioId string, ruleId string, resourceName string) {
-	history := models.NewHistory(
-		"",
-		status,
-		content,
-		notificatioId,
-		ar.AlertConfig.AlertId,
-		ruleId,
-		resourceName,
-	)
 
-	err := rs.CreateHistory(nil, history)
-	if err != nil {
-		logger.Error(nil, "writeHistory Alert[%s] %s in DB error, [%+v].", ar.AlertConfig.AlertId, status, err)
-		return
+	newStatus.NegativeCount = newStatus.NegativeCount + 1
+	if newStatus.NegativeCount < ar.AlertConfig.Rules[ruleId].ConsecutiveClearCount {
+		return ""
 	}
-	logger.Debug(nil, "writeHistory Alert[%s] %s in DB successfully.", ar.AlertConfig.AlertId, status)
-}
 
-func (ar *AlertRunner) commentAlert(historyId string) {
-	//TODO: Find and record which rule id and resource name created message id
-	ar.writeHistory("", "commented", historyId, "", "", "")
+	*newStatus = ar.getKeptResourceStatus(ruleId, *newStatus)
+	return "resume"
 }
 
-func (ar *AlertRunner) pushAggregatedAlerts(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) {
-	aggregatedAlerts := newStatus.AggregatedAlerts
-
-	aggregatedAlerts.CumulatedCount = aggregatedAlerts.CumulatedCount + 1
+//checkFlapping records state transition of resource in flap window, and moves resource into or out of flapping.
+//Individual trigger and resume notifications are suppressed while flapping, only start and stop are notified.
+func (ar *AlertRunner) checkFlapping(newStatus *StatusResource, ruleId string, resourceName string, transited bool, recordedMetric RecordedMetric) bool {
+	cfg := config.GetInstance().Executor
+	if cfg.FlapThreshold == 0 {
+		return false
+	}
 
-	triggeredMetric := triggeredRuleMetrics[len(triggeredRuleMetrics)-1]
-	alertTime := time.Unix(triggeredMetric.tvs[len(triggeredMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
-	if aggregatedAlerts.FirstAlertTime == "" {
-		aggregatedAlerts.FirstAlertTime = alertTime
+	event := updateFlapping(newStatus, transited, time.Now(), time.Duration(cfg.FlapWindow)*time.Minute, cfg.FlapThreshold)
+	if event == "" {
+		return false
 	}
-	aggregatedAlerts.LastAlertTime = alertTime
-	aggregatedAlerts.LastAlertValues = triggeredRuleMetrics
 
-	newStatus.AggregatedAlerts = aggregatedAlerts
+	logger.Debug(nil, "Rule[%v] Resource[%v] %v %s, write to message", ruleId, resourceName, recordedMetric, event)
+	ar.writeHistory("", event, fmt.Sprintf("%v", recordedMetric), "", ruleId, resourceName)
+	ar.sendFlappingNotification(newStatus, ruleId, resourceName, event)
+
+	return true
 }
 
-func (ar *AlertRunner) checkSendable(newStatus *StatusResource, ruleId string, resourceName string) bool {
-	policyConfig := ar.AlertConfig.PolicyConfig[ar.AlertConfig.Rules[ruleId].Severity]
-	switch policyConfig.RepeatType {
-	case "normal":
-		return true
-	case "not-repeat":
-		if newStatus.CumulatedSendCount > 0 {
-			return false
-		} else {
-			return true
-		}
-	case "fixed-minutes":
-		if newStatus.CumulatedSendCount >= policyConfig.MaxSendCount {
-			return false
-		}
-		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(TickPeriodSecond/3) * time.Second)) {
-			return true
-		} else {
-			return false
-		}
-	case "exp-minutes":
-		if newStatus.CumulatedSendCount >= policyConfig.MaxSendCount {
-			return false
-		}
-		if !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(TickPeriodSecond/3) * time.Second)) {
-			return true
-		} else {
-			return false
+//updateFlapping keeps transitions of resource in window before now, and returns the flapping event if resource
+//starts flapping with threshold transitions in window, or stops flapping with fewer than half of threshold.
+func updateFlapping(newStatus *StatusResource, transited bool, now time.Time, window time.Duration, threshold uint32) string {
+	windowStart := now.Add(-window)
+
+	transitions := []time.Time{}
+	for _, t := range newStatus.Transitions {
+		if t.After(windowStart) {
+			transitions = append(transitions, t)
 		}
 	}
-	return false
+	if transited {
+		transitions = append(transitions, now)
+	}
+	newStatus.Transitions = transitions
+
+	if !newStatus.Flapping && uint32(len(transitions)) >= threshold {
+		newStatus.Flapping = true
+		return notification.EventFlappingStarted
+	}
+	if newStatus.Flapping && uint32(len(transitions)) < (threshold+1)/2 {
+		newStatus.Flapping = false
+		return notification.EventFlappingStopped
+	}
+	return ""
 }
 
-func (ar *AlertRunner) clearAggregatedAlerts(newStatus *StatusResource, ruleId string, resourceName string) {
+//collectNodataMetrics finds resources known in status but missing from the latest metrics,
+//it returns true when the rule gets no data at all and has no known resource.
+func (ar *AlertRunner) collectNodataMetrics(ruleId string, oldResourceStatus map[string]StatusResource, triggeredMetrics []RecordedMetric, resumedMetrics []RecordedMetric, skippedResources []string, noDataMetrics *[]RecordedMetric) bool {
+	rule := ar.AlertConfig.Rules[ruleId]
+
+	reported := make(map[string]bool)
+	for _, triggeredMetric := range triggeredMetrics {
+		reported[triggeredMetric.ResourceName] = true
+	}
+	for _, resumedMetric := range resumedMetrics {
+		reported[resumedMetric.ResourceName] = true
+	}
+	for _, resourceName := range skippedResources {
+		reported[resourceName] = true
+	}
+
+	noData := make(map[string]bool)
+	for _, noDataMetric := range *noDataMetrics {
+		noData[noDataMetric.ResourceName] = true
+	}
+
+	knownResource := len(noData) > 0
+	for k := range oldResourceStatus {
+		param := strings.SplitN(k, " ", 2)
+		if len(param) != 2 || param[0] != ruleId || param[1] == "" {
+			continue
+		}
+		resourceName := param[1]
+		knownResource = true
+		if reported[resourceName] || noData[resourceName] {
+			continue
+		}
+		*noDataMetrics = append(*noDataMetrics, RecordedMetric{RuleName: rule.RuleName, ResourceName: resourceName, NoData: true})
+	}
+
+	return !knownResource && len(reported) == 0
+}
+
+//checkRuleNodata notifies rule getting no data at all in nodata state, and resumes it once data comes back.
+//Status of rule is kept apart from resources, so that it never shows up as a resource.
+func (ar *AlertRunner) checkRuleNodata(ruleId string, absent bool) bool {
+	rule := ar.AlertConfig.Rules[ruleId]
+	noDataMetric := RecordedMetric{RuleName: rule.RuleName, NoData: true}
+
+	ar.AlertStatus.RLock()
+	oldStatus, ok := ar.AlertStatus.RuleStatus[ruleId]
+	ar.AlertStatus.RUnlock()
+
+	needUpdate := false
+	if absent && rule.NodataState == models.RuleNodataStateNodata {
+		newStatus := oldStatus
+		if !ok {
+			logger.Debug(nil, "Rule[%v] %v nodata, write to message", ruleId, noDataMetric)
+			newStatus = ar.getResetResourceStatus(ruleId)
+			newStatus.CurrentLevel = "nodata"
+			ar.writeHistory("", "nodata", fmt.Sprintf("%v", noDataMetric), "", ruleId, "")
+			needUpdate = true
+		}
+		ar.sendActiveNotification(&newStatus, ruleId, "", []RecordedMetric{noDataMetric})
+
+		ar.AlertStatus.Lock()
+		ar.AlertStatus.RuleStatus[ruleId] = newStatus
+		ar.AlertStatus.Unlock()
+		return needUpdate
+	}
+
+	if !ok {
+		return false
+	}
+
+	//Rule in nodata state is resumed once any resource reports again
+	if !absent {
+		logger.Debug(nil, "Rule[%v] %v resumed from nodata, write to message", ruleId, noDataMetric)
+		ar.writeHistory("", "resumed", fmt.Sprintf("%v", noDataMetric), "", ruleId, "")
+		ar.sendResumeNotification(&oldStatus, ruleId, "", noDataMetric, []RecordedMetric{noDataMetric})
+	}
+
+	ar.AlertStatus.Lock()
+	delete(ar.AlertStatus.RuleStatus, ruleId)
+	ar.AlertStatus.Unlock()
+	return true
+}
+
+//checkNodataMetrics applies nodata state of rule to resources without data, and fills their status into newResourceStatus.
+//Resource dropped from status is kept while snoozed, so that snooze survives data gaps.
+func (ar *AlertRunner) checkNodataMetrics(ruleId string, noDataMetrics []RecordedMetric, oldResourceStatus map[string]StatusResource, newResourceStatus map[string]StatusResource) bool {
+	needUpdate := false
+
+	for _, noDataMetric := range noDataMetrics {
+		logger.Debug(nil, "noDataMetric %v", noDataMetric)
+		resourceName := noDataMetric.ResourceName
+		ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+		oldStatus, ok := oldResourceStatus[ruleResourceKey]
+
+		newStatus, operation, kept := ar.transitNodata(ruleId, oldStatus, ok)
+
+		switch operation {
+		case "nodata":
+			logger.Debug(nil, "Rule[%v] Resource[%v] %v nodata, write to message", ruleId, resourceName, noDataMetric)
+			ar.writeHistory("", "nodata", fmt.Sprintf("%v", noDataMetric), "", ruleId, resourceName)
+			//Firing state lives with the lease of runner, it is no longer refreshed by evaluation
+			if ok && oldStatus.CurrentLevel != "cleared" {
+				DeleteFiringState(ar.AlertConfig.RsTypeName, resourceName, ar.AlertConfig.AlertId, ruleId)
+			}
+			needUpdate = true
+		case "resume":
+			logger.Debug(nil, "Rule[%v] Resource[%v] %v resumed for nodata, write to message", ruleId, resourceName, noDataMetric)
+			ar.writeHistory("", "resumed", fmt.Sprintf("%v", noDataMetric), "", ruleId, resourceName)
+			DeleteFiringState(ar.AlertConfig.RsTypeName, resourceName, ar.AlertConfig.AlertId, ruleId)
+			ar.sendResumeNotification(&oldStatus, ruleId, resourceName, noDataMetric, []RecordedMetric{noDataMetric})
+			needUpdate = true
+		}
+
+		if ar.AlertConfig.Rules[ruleId].NodataState == models.RuleNodataStateNodata {
+			ar.sendActiveNotification(&newStatus, ruleId, resourceName, []RecordedMetric{noDataMetric})
+		}
+		if kept {
+			newResourceStatus[ruleResourceKey] = newStatus
+		}
+	}
+
+	return needUpdate
+}
+
+//transitNodata returns status of resource without data by nodata state of rule, the operation to write,
+//and whether resource is kept in status.
+func (ar *AlertRunner) transitNodata(ruleId string, oldStatus StatusResource, ok bool) (StatusResource, string, bool) {
+	switch ar.AlertConfig.Rules[ruleId].NodataState {
+	case models.RuleNodataStateNodata:
+		if ok && oldStatus.CurrentLevel == "nodata" {
+			return oldStatus, "", true
+		}
+		newStatus := ar.getKeptResourceStatus(ruleId, oldStatus)
+		newStatus.CurrentLevel = "nodata"
+		return newStatus, "nodata", true
+	case models.RuleNodataStateOk:
+		operation := ""
+		if ok && oldStatus.CurrentLevel != "cleared" {
+			operation = "resume"
+		}
+		//Resource without data is removed from status
+		return ar.getKeptResourceStatus(ruleId, oldStatus), operation, ok && ar.isSnoozed(&oldStatus)
+	default:
+		//Keep last state, cleared resource without data needs not to be kept
+		return oldStatus, "", ok && (oldStatus.CurrentLevel != "cleared" || ar.isSnoozed(&oldStatus))
+	}
+}
+
+func (ar *AlertRunner) checkMetrics(ch chan metric.ResourceMetrics) {
+	needUpdate := false
+
+	for resourceMetrics := range ch {
+		logger.Debug(nil, "checkMetrics %v", resourceMetrics)
+
+		checkResult := ar.checkOneMetric(resourceMetrics)
+
+		needUpdate = needUpdate || checkResult
+	}
+
+	if needUpdate {
+		ar.signalUpdate()
+	}
+}
+
+func (ar *AlertRunner) writeHistory(historyName string, status string, content string, notificatioId string, ruleId string, resourceName string) {
+	history := models.NewHistory(
+		"",
+		status,
+		content,
+		notificatioId,
+		ar.AlertConfig.AlertId,
+		ruleId,
+		resourceName,
+	)
+
+	err := rs.CreateHistory(nil, history)
+	if err != nil {
+		logger.Error(nil, "writeHistory Alert[%s] %s in DB error, [%+v].", ar.AlertConfig.AlertId, status, err)
+		return
+	}
+	logger.Debug(nil, "writeHistory Alert[%s] %s in DB successfully.", ar.AlertConfig.AlertId, status)
+}
+
+func (ar *AlertRunner) commentAlert(historyId string) {
+	//TODO: Find and record which rule id and resource name created message id
+	ar.writeHistory("", "commented", historyId, "", "", "")
+}
+
+//acknowledgeAlert stops repeat notifications of a firing resource until it resumes.
+func (ar *AlertRunner) acknowledgeAlert(ackInfoStr string) {
+	var ackInfo models.AcknowledgeInfo
+	err := json.Unmarshal([]byte(ackInfoStr), &ackInfo)
+	if err != nil {
+		logger.Error(nil, "acknowledgeAlert decode [%s] error: %v", ackInfoStr, err)
+		return
+	}
+
+	ruleResourceKey := getRuleResourceKey(ackInfo.RuleId, ackInfo.ResourceName)
+
+	ar.AlertStatus.Lock()
+	resourceStatus, ok := ar.AlertStatus.ResourceStatus[ruleResourceKey]
+	if !ok || resourceStatus.CurrentLevel == "cleared" || resourceStatus.CurrentLevel == "nodata" {
+		ar.AlertStatus.Unlock()
+		logger.Error(nil, "acknowledgeAlert Rule[%s] Resource[%s] is not firing", ackInfo.RuleId, ackInfo.ResourceName)
+		return
+	}
+	resourceStatus.Acknowledger = ackInfo.Acknowledger
+	resourceStatus.AcknowledgeTime = time.Now()
+	ar.AlertStatus.ResourceStatus[ruleResourceKey] = resourceStatus
+	ar.AlertStatus.Unlock()
+
+	ar.writeHistory("", "acknowledged", fmt.Sprintf("acknowledged by %s", ackInfo.Acknowledger), "", ackInfo.RuleId, ackInfo.ResourceName)
+	ar.signalUpdate()
+}
+
+//snoozeAlert mutes notifications of a single resource for a while, the resource needs not to be firing.
+func (ar *AlertRunner) snoozeAlert(snoozeInfoStr string) {
+	var snoozeInfo models.SnoozeInfo
+	err := json.Unmarshal([]byte(snoozeInfoStr), &snoozeInfo)
+	if err != nil {
+		logger.Error(nil, "snoozeAlert decode [%s] error: %v", snoozeInfoStr, err)
+		return
+	}
+
+	if _, ok := ar.AlertConfig.Rules[snoozeInfo.RuleId]; !ok {
+		logger.Error(nil, "snoozeAlert Rule[%s] does not exist", snoozeInfo.RuleId)
+		return
+	}
+
+	ruleResourceKey := getRuleResourceKey(snoozeInfo.RuleId, snoozeInfo.ResourceName)
+
+	ar.AlertStatus.Lock()
+	resourceStatus, ok := ar.AlertStatus.ResourceStatus[ruleResourceKey]
+	if !ok {
+		resourceStatus = ar.getResetResourceStatus(snoozeInfo.RuleId)
+	}
+	//Zero minutes clears the snooze
+	resourceStatus.SnoozeUntil = time.Time{}
+	if snoozeInfo.SnoozeMinutes > 0 {
+		resourceStatus.SnoozeUntil = time.Now().Add(time.Duration(snoozeInfo.SnoozeMinutes) * time.Minute)
+	}
+	snoozeUntil := resourceStatus.SnoozeUntil
+	ar.AlertStatus.ResourceStatus[ruleResourceKey] = resourceStatus
+	ar.AlertStatus.Unlock()
+
+	if snoozeInfo.SnoozeMinutes > 0 {
+		ar.writeHistory("", "snoozed", fmt.Sprintf("snoozed by %s until %s", snoozeInfo.Operator, snoozeUntil.Format("2006-01-02 15:04:05")), "", snoozeInfo.RuleId, snoozeInfo.ResourceName)
+	} else {
+		ar.writeHistory("", "unsnoozed", fmt.Sprintf("unsnoozed by %s", snoozeInfo.Operator), "", snoozeInfo.RuleId, snoozeInfo.ResourceName)
+	}
+	ar.signalUpdate()
+}
+
+func (ar *AlertRunner) pushAggregatedAlerts(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) {
+	aggregatedAlerts := newStatus.AggregatedAlerts
+
+	aggregatedAlerts.CumulatedCount = aggregatedAlerts.CumulatedCount + 1
+
+	triggeredMetric := triggeredRuleMetrics[len(triggeredRuleMetrics)-1]
+	alertTime := time.Now().Format("2006-01-02 15:04:05.99999")
+	if len(triggeredMetric.tvs) > 0 {
+		alertTime = time.Unix(triggeredMetric.tvs[len(triggeredMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
+	}
+	if aggregatedAlerts.FirstAlertTime == "" {
+		aggregatedAlerts.FirstAlertTime = alertTime
+	}
+	aggregatedAlerts.LastAlertTime = alertTime
+	aggregatedAlerts.LastAlertValues = triggeredRuleMetrics
+
+	newStatus.AggregatedAlerts = aggregatedAlerts
+}
+
+func (ar *AlertRunner) checkSendable(newStatus *StatusResource, ruleId string, resourceName string) bool {
+	strategy := ar.getResourcePolicyConfig(newStatus, ruleId, resourceName).Strategy
+	if !strategy.Repeatable(newStatus.CumulatedSendCount) {
+		return false
+	}
+	return !newStatus.NextSendableTime.After(time.Now().Add(time.Duration(TickPeriodSecond/3) * time.Second))
+}
+
+func (ar *AlertRunner) clearAggregatedAlerts(newStatus *StatusResource, ruleId string, resourceName string) {
 	newStatus.AggregatedAlerts = AggregatedAlert{}
 }
 
@@ -553,13 +1383,11 @@ func (ar *AlertRunner) processRepeat(newStatus *StatusResource, ruleId string, r
 	newStatus.CumulatedSendCount = newStatus.CumulatedSendCount + 1
 
 	//Update Next Sendable Time
-	switch ar.AlertConfig.PolicyConfig[ar.AlertConfig.Rules[ruleId].Severity].RepeatType {
-	case "fixed-minutes":
-		newStatus.NextSendableTime = newStatus.NextSendableTime.Add(time.Duration(newStatus.NextResendInterval) * time.Minute)
-	case "exp-minutes":
-		newStatus.NextSendableTime = newStatus.NextSendableTime.Add(time.Duration(newStatus.NextResendInterval) * time.Minute)
-		newStatus.NextResendInterval = newStatus.NextResendInterval * 2
-	}
+	now := time.Now()
+	strategy := ar.getResourcePolicyConfig(newStatus, ruleId, resourceName).Strategy
+	nextSendableTime := strategy.Next(newStatus.CumulatedSendCount, newStatus.NextSendableTime, now)
+	newStatus.NextResendInterval = uint32(nextSendableTime.Sub(newStatus.NextSendableTime) / time.Minute)
+	newStatus.NextSendableTime = nextSendableTime
 }
 
 func processResourceName(resourceName string) string {
@@ -570,14 +1398,19 @@ func processResourceName(resourceName string) string {
 	return resourceName
 }
 
-func (ar *AlertRunner) formatActiveNotificationEmail(newStatus *StatusResource, ruleId string, resourceName string, language string) *notification.Email {
+func (ar *AlertRunner) getActiveNotificationParam(newStatus *StatusResource, ruleId string, resourceName string) notification.NotificationParam {
 	aggregatedAlerts := newStatus.AggregatedAlerts
 	lastValue := ""
+	forecastTime := ""
 	for _, recordedRuleMetric := range aggregatedAlerts.LastAlertValues {
-		tv := recordedRuleMetric.tvs[len(recordedRuleMetric.tvs)-1]
 		if resourceName == recordedRuleMetric.ResourceName {
-			v, _ := strconv.ParseFloat(tv.V, 64)
-			lastValue = fmt.Sprintf("%.2f%s", v*ar.AlertConfig.Rules[ruleId].Scale, ar.AlertConfig.Rules[ruleId].Unit)
+			lastValue = fmt.Sprintf("%.2f%s", recordedRuleMetric.Value, ar.AlertConfig.Rules[ruleId].Unit)
+			if recordedRuleMetric.NoData {
+				lastValue = "nodata"
+			}
+			if recordedRuleMetric.ForecastTime != 0 {
+				forecastTime = time.Unix(recordedRuleMetric.ForecastTime, 0).Format("2006-01-02 15:04:05.99999")
+			}
 			break
 		}
 	}
@@ -589,40 +1422,29 @@ func (ar *AlertRunner) formatActiveNotificationEmail(newStatus *StatusResource,
 		FirstTime:      aggregatedAlerts.FirstAlertTime,
 		LastTime:       aggregatedAlerts.LastAlertTime,
 		LastValue:      lastValue,
+		ForecastTime:   forecastTime,
 	}
 
-	notificationParamBytes, err := json.Marshal(notificationParam)
-	if err != nil {
-		logger.Error(nil, "Marshal Notification Param error: %v", err)
-		return nil
-	}
-
-	emailStr := adapter.SendEmailRequest(string(notificationParamBytes), "false", language)
-
-	if emailStr == "" {
-		return nil
-	}
-
-	email := notification.Email{}
-
-	err = json.Unmarshal([]byte(emailStr), &email)
-	if err != nil {
-		logger.Error(nil, "Unmarshal Email error: %v", err)
-		return nil
-	}
+	return notificationParam
+}
 
-	return &email
+func (ar *AlertRunner) formatActiveNotificationEmail(newStatus *StatusResource, ruleId string, resourceName string, language string) *notification.Email {
+	return ar.formatNotificationEmail(ar.getActiveNotificationParam(newStatus, ruleId, resourceName), false, language)
 }
 
-func (ar *AlertRunner) formatResumeNotificationEmail(resumeStatus *StatusResource, ruleId string, resourceName string, resumedMetric RecordedMetric, language string) *notification.Email {
+func (ar *AlertRunner) getResumeNotificationParam(resumeStatus *StatusResource, ruleId string, resourceName string, resumedMetric RecordedMetric) notification.NotificationParam {
 	aggregatedAlerts := resumeStatus.AggregatedAlerts
 	lastValue := ""
-	tv := resumedMetric.tvs[len(resumedMetric.tvs)-1]
 	if resourceName == resumedMetric.ResourceName {
-		v, _ := strconv.ParseFloat(tv.V, 64)
-		lastValue = fmt.Sprintf("%.2f%s", v*ar.AlertConfig.Rules[ruleId].Scale, ar.AlertConfig.Rules[ruleId].Unit)
+		lastValue = fmt.Sprintf("%.2f%s", resumedMetric.Value, ar.AlertConfig.Rules[ruleId].Unit)
+		if resumedMetric.NoData {
+			lastValue = "nodata"
+		}
+	}
+	resumeTime := time.Now().Format("2006-01-02 15:04:05.99999")
+	if len(resumedMetric.tvs) > 0 {
+		resumeTime = time.Unix(resumedMetric.tvs[len(resumedMetric.tvs)-1].T, 0).Format("2006-01-02 15:04:05.99999")
 	}
-	resumeTime := time.Unix(tv.T, 0).Format("2006-01-02 15:04:05.99999")
 
 	notificationParam := notification.NotificationParam{
 		ResourceName: processResourceName(resourceName),
@@ -632,35 +1454,231 @@ func (ar *AlertRunner) formatResumeNotificationEmail(resumeStatus *StatusResourc
 		LastValue:    lastValue,
 	}
 
-	notificationParamBytes, err := json.Marshal(notificationParam)
+	return notificationParam
+}
+
+func (ar *AlertRunner) formatResumeNotificationEmail(resumeStatus *StatusResource, ruleId string, resourceName string, resumedMetric RecordedMetric, language string) *notification.Email {
+	return ar.formatNotificationEmail(ar.getResumeNotificationParam(resumeStatus, ruleId, resourceName, resumedMetric), true, language)
+}
+
+func (ar *AlertRunner) formatNotificationEmail(notificationParam notification.NotificationParam, resume bool, language string) *notification.Email {
+	if notificationParam.AlertName == "" {
+		notificationParam.AlertName = ar.AlertConfig.AlertName
+	}
+
+	template := ar.AlertConfig.Template
+	if template != nil {
+		email, err := template.Render(notificationParam, resume)
+		if err == nil {
+			return email
+		}
+		//Template of policy may fail on some notification param, eg. index out of range
+		logger.Error(nil, "Render Alert[%s] notification template error: %v", ar.AlertConfig.AlertId, err)
+	}
+
+	return formatNotificationEmail(notificationParam, resume, language)
+}
+
+//formatNotificationEmail renders notification param by built-in template of language.
+func formatNotificationEmail(notificationParam notification.NotificationParam, resume bool, language string) *notification.Email {
+	email, err := notification.DefaultTemplate(language).Render(notificationParam, resume)
 	if err != nil {
-		logger.Error(nil, "Marshal Notification Param error: %v", err)
+		logger.Error(nil, "Render notification param error: %v", err)
 		return nil
 	}
 
-	emailStr := adapter.SendEmailRequest(string(notificationParamBytes), "true", language)
+	return email
+}
 
-	if emailStr == "" {
-		return nil
+//checkTimeAvailable prefers schedule of policy, available start and end time are kept for policies without schedule.
+func (ar *AlertRunner) checkTimeAvailable() bool {
+	if ar.AlertConfig.AvailableSchedule != nil {
+		return ar.AlertConfig.AvailableSchedule.IsAvailable(time.Now())
 	}
+	return nf.CheckTimeAvailable(ar.AlertConfig.AvailableStartTime, ar.AlertConfig.AvailableEndTime)
+}
 
-	email := notification.Email{}
+//deferNotification persists notification suppressed outside available window if policy schedule defers delivery,
+//it is saved once for each status of resource until the digest is delivered.
+func (ar *AlertRunner) deferNotification(ruleId string, resourceName string, status string, notificationParam notification.NotificationParam) {
+	schedule := ar.AlertConfig.AvailableSchedule
+	if schedule == nil || !schedule.DeferDelivery {
+		return
+	}
 
-	err = json.Unmarshal([]byte(emailStr), &email)
+	ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+	if ar.Deferred[ruleResourceKey] == status {
+		return
+	}
+
+	deferredNotification := models.NewDeferredNotification(
+		ar.AlertConfig.AlertId,
+		ruleId,
+		resourceName,
+		status,
+		notificationParam.CumulatedCount,
+		notificationParam.FirstTime,
+		notificationParam.LastTime,
+		notificationParam.LastValue,
+	)
+	err := rs.SaveDeferredNotification(deferredNotification)
 	if err != nil {
-		logger.Error(nil, "Unmarshal Email error: %v", err)
-		return nil
+		logger.Error(nil, "deferNotification Rule[%s] Resource[%s] %s error: %v", ruleId, resourceName, status, err)
+		return
+	}
+	ar.Deferred[ruleResourceKey] = status
+	ar.HasDeferred = true
+}
+
+//getDeferredReceivers returns receivers routed for deferred notification of resource.
+func (ar *AlertRunner) getDeferredReceivers(ruleId string, resourceName string, event string, resourceStatus *StatusResource) []Receiver {
+	if event == models.DeferredStatusResumed {
+		return ar.getResourceReceivers(resourceStatus, ruleId, resourceName, models.TriggerStatusResumed)
+	}
+	return ar.getResourceReceivers(resourceStatus, ruleId, resourceName, getActiveTransition(resourceStatus))
+}
+
+//getReceiversKey identifies a set of receivers regardless of order.
+func getReceiversKey(receivers []Receiver) string {
+	keys := []string{}
+	for _, receiver := range receivers {
+		keys = append(keys, receiver.Notifier+" "+receiver.NfAddressListId)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, "\n")
+}
+
+//deliverDeferredNotifications sends one digest of deferred notifications when the available window opens.
+//Resource still firing is checked against current status, resource resumed in the meantime is folded into the digest.
+func (ar *AlertRunner) deliverDeferredNotifications() {
+	if !ar.HasDeferred || !ar.checkTimeAvailable() {
+		return
+	}
+
+	deferredNotifications, err := rs.QueryDeferredNotifications(ar.AlertConfig.AlertId)
+	if err != nil {
+		return
+	}
+	if len(deferredNotifications) == 0 {
+		ar.HasDeferred = false
+		return
+	}
+
+	deferredIds := []string{}
+	//Digest is split by receivers routed for resources, digest without receivers is dropped
+	receiversKeys := []string{}
+	digestReceivers := make(map[string][]Receiver)
+	digests := make(map[string][]notification.NotificationParam)
+	firingKeys := []string{}
+
+	ar.AlertStatus.RLock()
+	for _, deferredNotification := range deferredNotifications {
+		deferredIds = append(deferredIds, deferredNotification.DeferredId)
+
+		ruleId, resourceName := deferredNotification.RuleId, deferredNotification.ResourceName
+		ruleInfo, ok := ar.AlertConfig.Rules[ruleId]
+		if !ok {
+			continue
+		}
+
+		event := deferredNotification.Status
+		ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+		//Status is unknown after alert updated, keep the recorded event then
+		resourceStatus, ok := ar.AlertStatus.ResourceStatus[ruleResourceKey]
+		firing := false
+		if event == models.DeferredStatusFiring {
+			if ok && resourceStatus.CurrentLevel == "cleared" {
+				event = models.DeferredStatusResumed
+			} else if ok && (resourceStatus.Acknowledger != "" || ar.isSnoozed(&resourceStatus) ||
+				ar.findSilence(ruleId, resourceName, resourceStatus.CurrentLevel) != "" || ar.findInhibitor(ruleId, resourceName, resourceStatus.CurrentLevel) != "") {
+				//Silence and inhibition are checked again since they may change after deferred
+				continue
+			} else if ok {
+				firing = true
+			}
+		}
+
+		receivers := ar.getDeferredReceivers(ruleId, resourceName, event, &resourceStatus)
+		if len(receivers) == 0 {
+			continue
+		}
+		if firing {
+			firingKeys = append(firingKeys, ruleResourceKey)
+		}
+		receiversKey := getReceiversKey(receivers)
+		if _, ok := digests[receiversKey]; !ok {
+			receiversKeys = append(receiversKeys, receiversKey)
+			digestReceivers[receiversKey] = receivers
+		}
+
+		digests[receiversKey] = append(digests[receiversKey], notification.NotificationParam{
+			ResourceName:   processResourceName(resourceName),
+			RuleName:       ruleInfo.RuleName,
+			CumulatedCount: deferredNotification.CumulatedCount,
+			FirstTime:      deferredNotification.FirstAlertTime,
+			LastTime:       deferredNotification.LastAlertTime,
+			LastValue:      deferredNotification.LastValue,
+			Event:          event,
+		})
 	}
+	ar.AlertStatus.RUnlock()
+
+	for _, receiversKey := range receiversKeys {
+		digest := digests[receiversKey]
+		notificationParam := notification.NotificationParam{
+			RuleName:       ar.AlertConfig.AlertName,
+			CumulatedCount: uint32(len(digest)),
+			FirstTime:      deferredNotifications[0].CreateTime.Format("2006-01-02 15:04:05.99999"),
+			LastTime:       time.Now().Format("2006-01-02 15:04:05.99999"),
+			Event:          notification.EventDigest,
+			Digest:         digest,
+		}
+		email := ar.formatNotificationEmail(notificationParam, false, ar.AlertConfig.Language)
+		if email == nil {
+			logger.Error(nil, "formatNotificationEmail digest failed")
+			return
+		}
 
-	return &email
+		//Keep deferred notifications to retry in next tick, digests queued already are not queued again
+		key := fmt.Sprintf("digest %s %d", deferredIds[0], len(deferredIds))
+		queuedSuccess, outboxIds := ar.queueToReceivers(digestReceivers[receiversKey], email, key, "", "")
+		if !queuedSuccess {
+			logger.Error(nil, "deliverDeferredNotifications Alert[%s] queue digest failed", ar.AlertConfig.AlertId)
+			return
+		}
+		ar.writeHistory("", "queued", fmt.Sprintf("digest of %d deferred notifications in outbox %s", len(digest), outboxIds), "", "", "")
+	}
+
+	//Digest counts as a notification of resources still firing
+	ar.AlertStatus.Lock()
+	for _, ruleResourceKey := range firingKeys {
+		resourceStatus := ar.AlertStatus.ResourceStatus[ruleResourceKey]
+		resourceStatus.NextSendableTime = time.Now()
+		param := strings.SplitN(ruleResourceKey, " ", 2)
+		ar.processRepeat(&resourceStatus, param[0], param[1])
+		ar.AlertStatus.ResourceStatus[ruleResourceKey] = resourceStatus
+	}
+	ar.AlertStatus.Unlock()
+
+	err = rs.DeleteDeferredNotifications(deferredIds)
+	if err != nil {
+		return
+	}
+	ar.Deferred = make(map[string]string)
+	ar.HasDeferred = false
 }
 
 func (ar *AlertRunner) sendActiveNotification(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) {
 	ar.pushAggregatedAlerts(newStatus, ruleId, resourceName, triggeredRuleMetrics)
 
-	//Check Notification Sendable
-	if !nf.CheckTimeAvailable(ar.AlertConfig.AvailableStartTime, ar.AlertConfig.AvailableEndTime) {
-		logger.Debug(nil, "sendActiveNotification not in available time")
+	//Check Acknowledged, no repeat until resumed
+	if newStatus.Acknowledger != "" {
+		return
+	}
+
+	//Check Snoozed, resource is still evaluated but not notified
+	if ar.isSnoozed(newStatus) {
+		logger.Debug(nil, "sendActiveNotification Rule[%s] Resource[%s] snoozed until %v", ruleId, resourceName, newStatus.SnoozeUntil)
 		return
 	}
 
@@ -669,46 +1687,599 @@ func (ar *AlertRunner) sendActiveNotification(newStatus *StatusResource, ruleId
 		return
 	}
 
-	nfAddressListId := fmt.Sprintf(`["%s"]`, ar.AlertConfig.NfAddressListId)
+	//Check Silenced by active silences
+	if ar.checkSilenced(newStatus, ruleId, resourceName, triggeredRuleMetrics) {
+		return
+	}
+
+	//Check Inhibited by more severe rule
+	if ar.checkInhibited(newStatus, ruleId, resourceName, triggeredRuleMetrics) {
+		return
+	}
+
+	//Check Receivers of actions triggered by the transition
+	receivers := ar.getResourceReceivers(newStatus, ruleId, resourceName, getActiveTransition(newStatus))
+	if len(receivers) == 0 {
+		ar.processRepeat(newStatus, ruleId, resourceName)
+		return
+	}
+
+	//Check Notification Sendable, notification which would be sent is deferred outside available time,
+	//the digest counts as the notification so repeat is not processed here
+	if !ar.checkTimeAvailable() {
+		logger.Debug(nil, "sendActiveNotification not in available time")
+		ar.deferNotification(ruleId, resourceName, models.DeferredStatusFiring, ar.getActiveNotificationParam(newStatus, ruleId, resourceName))
+		return
+	}
+
+	//Summarize in alert storm
+	if ar.summarizeInStorm(ruleId, resourceName, receivers, notification.EventFiring, ar.getActiveNotificationParam(newStatus, ruleId, resourceName)) {
+		ar.processRepeat(newStatus, ruleId, resourceName)
+		return
+	}
+
+	//Merge into notification of group, which is sent when the group is flushed
+	if ar.AlertConfig.GroupConfig != nil {
+		notificationParam := ar.getActiveNotificationParam(newStatus, ruleId, resourceName)
+		notificationParam.Event = notification.EventFiring
+		ar.addToGroup(ruleId, resourceName, receivers, notificationParam)
+		ar.processRepeat(newStatus, ruleId, resourceName)
+		return
+	}
+
+	//Notification not queued is not counted, it is sent again in the next evaluation with the same idempotency key
 	email := ar.formatActiveNotificationEmail(newStatus, ruleId, resourceName, ar.AlertConfig.Language)
 	if email == nil {
 		logger.Error(nil, "formatActiveNotificationEmail failed")
-	} else {
-		sentSuccess, notificationId := nf.SendNotification("other", nfAddressListId, email.Title, email.Content)
-		if sentSuccess {
-			ar.writeHistory("", "sent_success", fmt.Sprintf("%v", triggeredRuleMetrics), notificationId, ruleId, resourceName)
-			//ar.clearAggregatedAlerts(newStatus, ruleId, resourceName)
-		} else {
-			ar.writeHistory("", "sent_failed", fmt.Sprintf("%v", triggeredRuleMetrics), "", ruleId, resourceName)
-			logger.Error(nil, "sendActiveNotification failed")
-		}
+		return
 	}
 
+	key := fmt.Sprintf("%s %s %s %s %d", ruleId, resourceName, getActiveTransition(newStatus), newStatus.AggregatedAlerts.FirstAlertTime, newStatus.CumulatedSendCount)
+	queuedSuccess, outboxIds := ar.queueToReceivers(receivers, email, key, ruleId, resourceName)
+	if !queuedSuccess {
+		ar.writeHistory("", "queue_failed", fmt.Sprintf("%v", triggeredRuleMetrics), "", ruleId, resourceName)
+		logger.Error(nil, "sendActiveNotification failed")
+		return
+	}
+
+	ar.writeHistory("", "queued", fmt.Sprintf("%v in outbox %s", triggeredRuleMetrics, outboxIds), "", ruleId, resourceName)
+	//ar.clearAggregatedAlerts(newStatus, ruleId, resourceName)
 	ar.processRepeat(newStatus, ruleId, resourceName)
 }
 
+//findInhibitingRule returns a rule firing with higher severity than severity on the same resource.
+func (ar *AlertRunner) findInhibitingRule(ruleId string, resourceName string, severity string) string {
+	for k, v := range ar.AlertStatus.ResourceStatus {
+		param := strings.SplitN(k, " ", 2)
+		if len(param) != 2 || param[0] == ruleId || param[1] != resourceName {
+			continue
+		}
+		if severityRank[v.CurrentLevel] > severityRank[severity] {
+			return param[0]
+		}
+	}
+	return ""
+}
+
+//findInhibitingAlert returns the inhibit rule muting this alert because the related source resource is firing,
+//the source resource is named by the equal label in resource filter param, eg. node_id of a pod alert.
+func (ar *AlertRunner) findInhibitingAlert() string {
+	inhibitRules := rs.QueryInhibitRules(ar.AlertConfig.RsTypeName)
+	if len(inhibitRules) == 0 {
+		return ""
+	}
+
+	filterParam := make(map[string]interface{})
+	err := json.Unmarshal([]byte(ar.AlertConfig.RsFilterParam), &filterParam)
+	if err != nil {
+		logger.Debug(nil, "findInhibitingAlert parse resource filter param [%s] error: %v", ar.AlertConfig.RsFilterParam, err)
+		return ""
+	}
+
+	for _, inhibitRule := range inhibitRules {
+		sourceResourceName := getInhibitSourceResource(inhibitRule, filterParam)
+		if sourceResourceName == "" {
+			continue
+		}
+
+		firingStates, err := GetFiringStates(inhibitRule.SourceRsTypeName, sourceResourceName)
+		if err != nil {
+			continue
+		}
+		if isInhibitedByFiringStates(ar.AlertConfig.AlertId, inhibitRule, firingStates) {
+			return fmt.Sprintf("%s %s %s", inhibitRule.InhibitRuleName, inhibitRule.SourceRsTypeName, sourceResourceName)
+		}
+	}
+
+	return ""
+}
+
+//getInhibitSourceResource returns the source resource of inhibit rule named by its equal label in resource filter param,
+//the name is processed the same way as resource names of firing states.
+func getInhibitSourceResource(inhibitRule models.InhibitRule, filterParam map[string]interface{}) string {
+	if filterParam[inhibitRule.EqualLabel] == nil {
+		return ""
+	}
+	return processResourceName(fmt.Sprintf("%v", filterParam[inhibitRule.EqualLabel]))
+}
+
+//isInhibitedByFiringStates reports whether any other alert fires on the source resource at least as severe as inhibit rule requires.
+func isInhibitedByFiringStates(alertId string, inhibitRule models.InhibitRule, firingStates []FiringState) bool {
+	for _, firingState := range firingStates {
+		if firingState.AlertId == alertId {
+			continue
+		}
+		if severityRank[firingState.Severity] >= severityRank[inhibitRule.SourceSeverity] {
+			return true
+		}
+	}
+	return false
+}
+
+//findInhibitor returns name of the more severe rule or the alert inhibiting notification of resource.
+func (ar *AlertRunner) findInhibitor(ruleId string, resourceName string, severity string) string {
+	if ar.AlertConfig.Rules[ruleId].Inhibit {
+		inhibitingRuleId := ar.findInhibitingRule(ruleId, resourceName, severity)
+		if inhibitingRuleId != "" {
+			return ar.AlertConfig.Rules[inhibitingRuleId].RuleName
+		}
+	}
+
+	//Inhibiting alert depends on the alert only, so it is found once in a tick
+	if !ar.Tick.InhibitorLoaded {
+		ar.Tick.Inhibitor = ar.findInhibitingAlert()
+		ar.Tick.InhibitorLoaded = true
+	}
+	return ar.Tick.Inhibitor
+}
+
+//checkInhibited suppresses notification of inhibit-enabled rule when a more severe rule is firing for the same resource,
+//or of any rule when an inhibit rule matches a firing alert of the related resource.
+//The inhibited event is written once when resource becomes inhibited.
+func (ar *AlertRunner) checkInhibited(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) bool {
+	inhibitor := ar.findInhibitor(ruleId, resourceName, newStatus.CurrentLevel)
+	if inhibitor == "" {
+		newStatus.Inhibited = false
+		return false
+	}
+
+	if !newStatus.Inhibited {
+		newStatus.Inhibited = true
+		logger.Debug(nil, "Rule[%v] Resource[%v] inhibited by [%v], write to message", ruleId, resourceName, inhibitor)
+		ar.writeHistory("", "inhibited", fmt.Sprintf("inhibited by %s %v", inhibitor, triggeredRuleMetrics), "", ruleId, resourceName)
+	}
+
+	return true
+}
+
+//ActiveSilence is an active silence with parsed matchers.
+type ActiveSilence struct {
+	SilenceId string
+	Matchers  *models.SilenceMatchers
+}
+
+//loadActiveSilences queries active silences and parses their matchers, silences failing to parse are dropped.
+func loadActiveSilences() []ActiveSilence {
+	activeSilences := []ActiveSilence{}
+	for _, silence := range rs.QueryActiveSilences() {
+		matchers, err := models.ParseSilenceMatchers(silence.Matchers)
+		if err != nil {
+			logger.Error(nil, "loadActiveSilences parse Silence[%s] matchers [%s] error: %v", silence.SilenceId, silence.Matchers, err)
+			continue
+		}
+		activeSilences = append(activeSilences, ActiveSilence{silence.SilenceId, matchers})
+	}
+	return activeSilences
+}
+
+//findSilence returns the active silence muting notification of resource, active silences are loaded once in a tick.
+func (ar *AlertRunner) findSilence(ruleId string, resourceName string, severity string) string {
+	if !ar.Tick.SilencesLoaded {
+		ar.Tick.Silences = loadActiveSilences()
+		ar.Tick.SilencesLoaded = true
+	}
+	if len(ar.Tick.Silences) == 0 {
+		return ""
+	}
+
+	rsFilterParam := ar.getResourceLabels()
+	for _, silence := range ar.Tick.Silences {
+		if silence.Matchers.Match(ar.AlertConfig.AlertName, ar.AlertConfig.Rules[ruleId].RuleName, severity, resourceName, rsFilterParam) {
+			return silence.SilenceId
+		}
+	}
+
+	return ""
+}
+
+//checkSilenced suppresses notification matched by an active silence, the silenced event is written once when resource becomes silenced.
+func (ar *AlertRunner) checkSilenced(newStatus *StatusResource, ruleId string, resourceName string, triggeredRuleMetrics []RecordedMetric) bool {
+	silenceId := ar.findSilence(ruleId, resourceName, newStatus.CurrentLevel)
+
+	if silenceId == "" {
+		newStatus.Silenced = false
+		return false
+	}
+
+	if !newStatus.Silenced {
+		newStatus.Silenced = true
+		logger.Debug(nil, "Rule[%v] Resource[%v] silenced by Silence[%v], write to message", ruleId, resourceName, silenceId)
+		ar.writeHistory("", "silenced", fmt.Sprintf("silenced by %s %v", silenceId, triggeredRuleMetrics), "", ruleId, resourceName)
+	}
+
+	return true
+}
+
+//firingLeaseTTL keeps firing states alive between two evaluations of the rule with the longest monitor periods.
+func (ar *AlertRunner) firingLeaseTTL() int64 {
+	ttl := int64(180)
+	for _, rule := range ar.AlertConfig.Rules {
+		if expireTime := int64(rule.MonitorPeriods) * 60 * 3; expireTime > ttl {
+			ttl = expireTime
+		}
+	}
+	return ttl
+}
+
+//putFiringState shares firing resource with other executors, the lease of runner is kept alive once in a tick.
+func (ar *AlertRunner) putFiringState(ruleId string, resourceName string, severity string) {
+	lease, err := ar.FiringLease.Refresh(ar.firingLeaseTTL())
+	if err != nil {
+		logger.Error(nil, "putFiringState Alert[%s] Rule[%s] Resource[%s] get lease failed: %v", ar.AlertConfig.AlertId, ruleId, resourceName, err)
+		return
+	}
+
+	err = PutFiringState(lease, ar.AlertConfig.RsTypeName, resourceName, ar.AlertConfig.AlertId, ruleId, severity)
+	if err != nil {
+		//Lease may be revoked meanwhile, get a new one next time
+		ar.FiringLease.Expire()
+	}
+}
+
 func (ar *AlertRunner) sendResumeNotification(resumeStatus *StatusResource, ruleId string, resourceName string, resumedMetric RecordedMetric, resumedMetrics []RecordedMetric) {
+	if ar.isSnoozed(resumeStatus) {
+		logger.Debug(nil, "sendResumeNotification Rule[%s] Resource[%s] snoozed until %v", ruleId, resourceName, resumeStatus.SnoozeUntil)
+		return
+	}
+
+	receivers := ar.getResourceReceivers(resumeStatus, ruleId, resourceName, models.TriggerStatusResumed)
+	if len(receivers) == 0 {
+		return
+	}
+
 	//Check Notification Sendable
-	if !nf.CheckTimeAvailable(ar.AlertConfig.AvailableStartTime, ar.AlertConfig.AvailableEndTime) {
+	if !ar.checkTimeAvailable() {
 		logger.Debug(nil, "sendResumeNotification not in available time")
+		ar.deferNotification(ruleId, resourceName, models.DeferredStatusResumed, ar.getResumeNotificationParam(resumeStatus, ruleId, resourceName, resumedMetric))
+		return
+	}
+
+	if ar.summarizeInStorm(ruleId, resourceName, receivers, notification.EventResumed, ar.getResumeNotificationParam(resumeStatus, ruleId, resourceName, resumedMetric)) {
+		return
+	}
+
+	if ar.AlertConfig.GroupConfig != nil {
+		notificationParam := ar.getResumeNotificationParam(resumeStatus, ruleId, resourceName, resumedMetric)
+		notificationParam.Event = notification.EventResumed
+		ar.addToGroup(ruleId, resourceName, receivers, notificationParam)
 		return
 	}
 
-	nfAddressListId := fmt.Sprintf(`["%s"]`, ar.AlertConfig.NfAddressListId)
 	email := ar.formatResumeNotificationEmail(resumeStatus, ruleId, resourceName, resumedMetric, ar.AlertConfig.Language)
 	if email == nil {
 		logger.Error(nil, "formatResumeNotificationEmail failed")
 	} else {
-		sentSuccess, notificationId := nf.SendNotification("other", nfAddressListId, email.Title, email.Content)
-		if sentSuccess {
-			ar.writeHistory("", "sent_success", fmt.Sprintf("%v", resumedMetrics), notificationId, ruleId, resourceName)
+		key := fmt.Sprintf("%s %s resumed %s", ruleId, resourceName, resumeStatus.AggregatedAlerts.FirstAlertTime)
+		queuedSuccess, outboxIds := ar.queueToReceivers(receivers, email, key, ruleId, resourceName)
+		if queuedSuccess {
+			ar.writeHistory("", "queued", fmt.Sprintf("%v in outbox %s", resumedMetrics, outboxIds), "", ruleId, resourceName)
 		} else {
-			ar.writeHistory("", "sent_failed", fmt.Sprintf("%v", resumedMetrics), "", ruleId, resourceName)
+			ar.writeHistory("", "queue_failed", fmt.Sprintf("%v", resumedMetrics), "", ruleId, resourceName)
 			logger.Error(nil, "sendResumeNotification failed")
 		}
 	}
 }
 
+func (ar *AlertRunner) sendFlappingNotification(newStatus *StatusResource, ruleId string, resourceName string, event string) {
+	//Check Notification Sendable
+	if !ar.checkTimeAvailable() {
+		logger.Debug(nil, "sendFlappingNotification not in available time")
+		return
+	}
+
+	if ar.isSnoozed(newStatus) {
+		logger.Debug(nil, "sendFlappingNotification Rule[%s] Resource[%s] snoozed until %v", ruleId, resourceName, newStatus.SnoozeUntil)
+		return
+	}
+
+	notificationParam := notification.NotificationParam{
+		ResourceName:   processResourceName(resourceName),
+		RuleName:       ar.AlertConfig.Rules[ruleId].RuleName,
+		CumulatedCount: uint32(len(newStatus.Transitions)),
+		LastTime:       time.Now().Format("2006-01-02 15:04:05.99999"),
+		LastValue:      newStatus.CurrentLevel,
+		Event:          event,
+	}
+	if len(newStatus.Transitions) > 0 {
+		notificationParam.FirstTime = newStatus.Transitions[0].Format("2006-01-02 15:04:05.99999")
+	}
+	//Flapping stopped does not mean resumed, resource may still be firing
+	email := ar.formatNotificationEmail(notificationParam, false, ar.AlertConfig.Language)
+	if email == nil {
+		logger.Error(nil, "formatNotificationEmail failed")
+		return
+	}
+
+	receivers := ar.getActionReceivers(models.TriggerStatusTriggered)
+	if len(receivers) == 0 {
+		return
+	}
+
+	//Each flapping start or stop follows a new transition, or the loss of old ones after the latest transition
+	lastTransition := ""
+	if len(newStatus.Transitions) > 0 {
+		lastTransition = newStatus.Transitions[len(newStatus.Transitions)-1].Format(time.RFC3339Nano)
+	}
+	key := fmt.Sprintf("%s %s %s %s", ruleId, resourceName, event, lastTransition)
+	queuedSuccess, outboxIds := ar.queueToReceivers(receivers, email, key, ruleId, resourceName)
+	if queuedSuccess {
+		ar.writeHistory("", "queued", fmt.Sprintf("%s in outbox %s", event, outboxIds), "", ruleId, resourceName)
+	} else {
+		ar.writeHistory("", "queue_failed", event, "", ruleId, resourceName)
+		logger.Error(nil, "sendFlappingNotification failed")
+	}
+}
+
+//escalateNotification notifies address lists of escalation steps which are due while resource keeps firing without acknowledgement,
+//the step reached is kept in resource status so that escalation goes on after alert moves to another executor.
+func (ar *AlertRunner) escalateNotification(newStatus *StatusResource, ruleId string, resourceName string) bool {
+	actions := []ConfigAction{}
+	for _, action := range ar.AlertConfig.Actions {
+		if action.Escalation != nil && action.Escalation.IsEscalated(newStatus.CurrentLevel) {
+			actions = append(actions, action)
+		}
+	}
+	if len(actions) == 0 {
+		return false
+	}
+
+	escalated := false
+	//Resource firing before escalation is configured escalates from now on
+	if newStatus.FiringTime.IsZero() {
+		newStatus.FiringTime = time.Now()
+		escalated = true
+	}
+
+	//Escalation waits while resource is not notified, it goes on from the step reached once resource is notified again
+	if newStatus.Acknowledger != "" || ar.isSnoozed(newStatus) || newStatus.Silenced || newStatus.Inhibited {
+		return escalated
+	}
+
+	if !ar.checkTimeAvailable() {
+		return escalated
+	}
+
+	if newStatus.EscalationSteps == nil {
+		newStatus.EscalationSteps = make(map[string]uint32)
+	}
+
+	//Each action escalates through its own steps
+	for _, action := range actions {
+		for {
+			stepIndex, due := getDueEscalationStep(newStatus, action, time.Now())
+			if !due {
+				break
+			}
+			step := action.Escalation.Steps[stepIndex]
+
+			notificationParam := ar.getActiveNotificationParam(newStatus, ruleId, resourceName)
+			notificationParam.Event = notification.EventEscalation
+			email := ar.formatNotificationEmail(notificationParam, false, ar.AlertConfig.Language)
+			if email == nil {
+				logger.Error(nil, "formatNotificationEmail escalation failed")
+				break
+			}
+
+			content := fmt.Sprintf("escalation step %d of action [%s] to [%s]", stepIndex+1, action.ActionId, step.NfAddressListId)
+			key := fmt.Sprintf("%s %s escalation %s %d %s", ruleId, resourceName, action.ActionId, stepIndex, newStatus.FiringTime.Format(time.RFC3339Nano))
+			queuedSuccess, outboxIds := ar.queueToReceivers([]Receiver{{action.TriggerAction, step.NfAddressListId}}, email, key, ruleId, resourceName)
+			if !queuedSuccess {
+				//Retry the step in the next evaluation
+				ar.writeHistory("", "queue_failed", content, "", ruleId, resourceName)
+				logger.Error(nil, "escalateNotification Rule[%s] Resource[%s] failed", ruleId, resourceName)
+				break
+			}
+
+			ar.writeHistory("", "escalation_queued", fmt.Sprintf("%s in outbox %s", content, outboxIds), "", ruleId, resourceName)
+			newStatus.EscalationSteps[action.ActionId] = stepIndex + 1
+			escalated = true
+		}
+	}
+
+	return escalated
+}
+
+//getDueEscalationStep returns the next step of action for resource, and whether it is due at now.
+func getDueEscalationStep(newStatus *StatusResource, action ConfigAction, now time.Time) (uint32, bool) {
+	stepIndex := newStatus.EscalationSteps[action.ActionId]
+	if int(stepIndex) >= len(action.Escalation.Steps) {
+		return stepIndex, false
+	}
+	step := action.Escalation.Steps[stepIndex]
+	return stepIndex, now.Sub(newStatus.FiringTime) >= time.Duration(step.Delay)*time.Minute
+}
+
+//summarizeInStorm counts notification of resource for storm detection, and puts it into summaries of address lists of receivers in alert storm.
+func (ar *AlertRunner) summarizeInStorm(ruleId string, resourceName string, receivers []Receiver, event string, notificationParam notification.NotificationParam) bool {
+	if ar.StormDetector == nil || !ar.StormDetector.Count() {
+		return false
+	}
+
+	notificationParam.AlertName = ar.AlertConfig.AlertName
+	notificationParam.Event = event
+	summarized := make(map[Receiver]bool)
+	for _, receiver := range receivers {
+		if !summarized[receiver] {
+			summarized[receiver] = true
+			ar.StormDetector.AddSummary(receiver.Notifier, receiver.NfAddressListId, ar.AlertConfig.Language, notificationParam)
+		}
+	}
+	ar.writeHistory("", "summarized", fmt.Sprintf("%v", notificationParam), "", ruleId, resourceName)
+
+	return true
+}
+
+//getGroupKey returns key and labels of the group notification of rule belongs to, labels are values of group by keys in resource filter param.
+//Labels are the same for all resources of the alert, so only group by rule splits its notifications.
+func (ar *AlertRunner) getGroupKey(ruleId string) (string, string) {
+	filterParam := make(map[string]interface{})
+	json.Unmarshal([]byte(ar.AlertConfig.RsFilterParam), &filterParam)
+
+	labels := []string{}
+	for _, key := range ar.AlertConfig.GroupConfig.GroupBy {
+		if key == models.GroupByRule {
+			continue
+		}
+		labels = append(labels, fmt.Sprintf("%s=%v", key, filterParam[key]))
+	}
+	groupLabels := strings.Join(labels, ",")
+
+	if ar.AlertConfig.GroupConfig.IsGroupByRule() {
+		return ruleId + " " + groupLabels, groupLabels
+	}
+	return groupLabels, groupLabels
+}
+
+//addToGroup queues notification of resource into its group instead of sending it at once.
+func (ar *AlertRunner) addToGroup(ruleId string, resourceName string, receivers []Receiver, notificationParam notification.NotificationParam) {
+	groupKey, groupLabels := ar.getGroupKey(ruleId)
+	//Notifications to different receivers are never merged
+	groupKey = fmt.Sprintf("%v %s", receivers, groupKey)
+
+	ar.AlertStatus.Lock()
+	defer ar.AlertStatus.Unlock()
+
+	group, ok := ar.AlertStatus.Groups[groupKey]
+	if !ok {
+		group = &NotificationGroup{
+			Labels:    groupLabels,
+			Receivers: receivers,
+			Entries:   make(map[string]GroupEntry),
+		}
+		ar.AlertStatus.Groups[groupKey] = group
+	}
+	if group.Entries == nil {
+		group.Entries = make(map[string]GroupEntry)
+	}
+
+	if len(group.EntryKeys) == 0 {
+		group.FirstPendingTime = time.Now()
+	}
+	ruleResourceKey := getRuleResourceKey(ruleId, resourceName)
+	if _, ok := group.Entries[ruleResourceKey]; !ok {
+		group.EntryKeys = append(group.EntryKeys, ruleResourceKey)
+	}
+	group.Entries[ruleResourceKey] = GroupEntry{
+		RuleId:       ruleId,
+		ResourceName: resourceName,
+		Param:        notificationParam,
+	}
+}
+
+//flushNotificationGroups sends a new group after group wait, and a group already notified at most once every group interval.
+//Group without notification for a whole group interval is removed, so that it waits again for the next one.
+func (ar *AlertRunner) flushNotificationGroups() {
+	now := time.Now()
+	flushedGroups := []NotificationGroup{}
+
+	ar.AlertStatus.Lock()
+	for groupKey, group := range ar.AlertStatus.Groups {
+		due, expired := checkGroupDue(group, ar.AlertConfig.GroupConfig, now)
+		if expired {
+			delete(ar.AlertStatus.Groups, groupKey)
+		}
+		if !due {
+			continue
+		}
+
+		//Group is sent out of lock, the pending notifications are taken away from status
+		flushedGroups = append(flushedGroups, *group)
+		group.Entries = make(map[string]GroupEntry)
+		group.EntryKeys = nil
+		group.LastSentTime = now
+	}
+	ar.AlertStatus.Unlock()
+
+	for i := range flushedGroups {
+		ar.sendGroupNotification(&flushedGroups[i])
+	}
+}
+
+//checkGroupDue reports whether group is due to be sent at now, and whether group without notification is expired.
+//Group config may be removed by update, groups left are flushed at once.
+func checkGroupDue(group *NotificationGroup, groupConfig *models.PolicyGroupConfig, now time.Time) (bool, bool) {
+	if groupConfig == nil {
+		groupConfig = &models.PolicyGroupConfig{}
+	}
+	groupWait := time.Duration(groupConfig.GroupWait) * time.Second
+	groupInterval := time.Duration(groupConfig.GroupInterval) * time.Second
+
+	if len(group.EntryKeys) == 0 {
+		return false, now.Sub(group.LastSentTime) >= groupInterval
+	}
+
+	if group.LastSentTime.IsZero() {
+		return now.Sub(group.FirstPendingTime) >= groupWait, false
+	}
+	return now.Sub(group.LastSentTime) >= groupInterval, false
+}
+
+//sendGroupNotification renders group as resumed when all resources in it are resumed.
+func (ar *AlertRunner) sendGroupNotification(group *NotificationGroup) {
+	entries := []GroupEntry{}
+	digest := []notification.NotificationParam{}
+	resume := true
+	for _, key := range group.EntryKeys {
+		entries = append(entries, group.Entries[key])
+		digest = append(digest, group.Entries[key].Param)
+		if group.Entries[key].Param.Event != notification.EventResumed {
+			resume = false
+		}
+	}
+
+	notificationParam := notification.NotificationParam{
+		ResourceName:   group.Labels,
+		RuleName:       ar.AlertConfig.AlertName,
+		CumulatedCount: uint32(len(digest)),
+		FirstTime:      group.FirstPendingTime.Format("2006-01-02 15:04:05.99999"),
+		LastTime:       time.Now().Format("2006-01-02 15:04:05.99999"),
+		Event:          notification.EventGroup,
+		Digest:         digest,
+	}
+	if ar.AlertConfig.GroupConfig != nil && ar.AlertConfig.GroupConfig.IsGroupByRule() {
+		notificationParam.RuleName = ar.AlertConfig.Rules[entries[0].RuleId].RuleName
+	}
+
+	email := ar.formatNotificationEmail(notificationParam, resume, ar.AlertConfig.Language)
+	if email == nil {
+		logger.Error(nil, "formatNotificationEmail group failed")
+		return
+	}
+
+	key := fmt.Sprintf("group %s %s", group.Labels, group.FirstPendingTime.Format(time.RFC3339Nano))
+	queuedSuccess, outboxIds := ar.queueToReceivers(group.Receivers, email, key, "", "")
+	for _, entry := range entries {
+		if queuedSuccess {
+			ar.writeHistory("", "queued", fmt.Sprintf("group of %d %v in outbox %s", len(entries), entry.Param, outboxIds), "", entry.RuleId, entry.ResourceName)
+		} else {
+			ar.writeHistory("", "queue_failed", fmt.Sprintf("group of %d %v", len(entries), entry.Param), "", entry.RuleId, entry.ResourceName)
+		}
+	}
+	if !queuedSuccess {
+		logger.Error(nil, "sendGroupNotification failed")
+	}
+}
+
 func (ar *AlertRunner) updateAlertUpdateTime() {
 	ar.AlertStatus.Lock()
 	ar.AlertStatus.UpdateTime = time.Now()
@@ -739,11 +2310,18 @@ func (ar *AlertRunner) runAlertRules() {
 		return
 	}
 
+	ar.Tick = TickCache{}
+	ar.FiringLease.Expire()
+
+	ar.deliverDeferredNotifications()
+
 	ch := make(chan metric.ResourceMetrics, 100)
 	ar.getResourceMetrics(ch)
 	close(ch)
 
 	ar.checkMetrics(ch)
+
+	ar.flushNotificationGroups()
 }
 
 func (ar *AlertRunner) Run(initStatus string) {
@@ -769,6 +2347,7 @@ func (ar *AlertRunner) Run(initStatus string) {
 		case operation := <-ar.SignalCh:
 			switch operation {
 			case "Stop":
+				ar.FiringLease.Revoke()
 				//Drain SignalCh
 				for len(ar.SignalCh) > 0 {
 					<-ar.SignalCh
@@ -776,6 +2355,8 @@ func (ar *AlertRunner) Run(initStatus string) {
 				logger.Debug(nil, "AlertRunner alert %s stop", ar.AlertConfig.AlertId)
 				return
 			case "Update":
+				//Status is reset, resources fire again from scratch
+				ar.FiringLease.Revoke()
 				ar.loadAlertInfo()
 				ar.AlertStatus.Lock()
 				ar.resetAlertStatus()
@@ -783,7 +2364,7 @@ func (ar *AlertRunner) Run(initStatus string) {
 				ar.updateAlertUpdateTime()
 				logger.Debug(nil, "AlertRunner alert %s update", ar.AlertConfig.AlertId)
 			default:
-				param := strings.Split(operation, " ")
+				param := strings.SplitN(operation, " ", 2)
 				if len(param) != 2 {
 					break
 				}
@@ -792,6 +2373,14 @@ func (ar *AlertRunner) Run(initStatus string) {
 					ar.commentAlert(param[1])
 					ar.updateAlertUpdateTime()
 					logger.Debug(nil, "AlertRunner alert %s comment", ar.AlertConfig.AlertId)
+				case "Acknowledge":
+					ar.acknowledgeAlert(param[1])
+					ar.updateAlertUpdateTime()
+					logger.Debug(nil, "AlertRunner alert %s acknowledge", ar.AlertConfig.AlertId)
+				case "Snooze":
+					ar.snoozeAlert(param[1])
+					ar.updateAlertUpdateTime()
+					logger.Debug(nil, "AlertRunner alert %s snooze", ar.AlertConfig.AlertId)
 				}
 			}
 		}
@@ -0,0 +1,719 @@
+package executor
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+func TestTransitNodata(t *testing.T) {
+	snoozeUntil := time.Now().Add(time.Hour)
+	testCase := []struct {
+		nodataState string
+		oldLevel    string
+		snoozed     bool
+		level       string
+		operation   string
+		kept        bool
+	}{
+		{models.RuleNodataStateNodata, "", false, "nodata", "nodata", true},
+		{models.RuleNodataStateNodata, "critical", false, "nodata", "nodata", true},
+		{models.RuleNodataStateNodata, "nodata", false, "nodata", "", true},
+		{models.RuleNodataStateOk, "", false, "cleared", "", false},
+		{models.RuleNodataStateOk, "cleared", false, "cleared", "", false},
+		{models.RuleNodataStateOk, "critical", false, "cleared", "resume", false},
+		{models.RuleNodataStateOk, "critical", true, "cleared", "resume", true},
+		{models.RuleNodataStateKeepLast, "", false, "", "", false},
+		{models.RuleNodataStateKeepLast, "cleared", false, "cleared", "", false},
+		{models.RuleNodataStateKeepLast, "cleared", true, "cleared", "", true},
+		{models.RuleNodataStateKeepLast, "critical", false, "critical", "", true},
+	}
+	for _, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {NodataState: c.nodataState}}
+		oldStatus := StatusResource{CurrentLevel: c.oldLevel}
+		if c.snoozed {
+			oldStatus.SnoozeUntil = snoozeUntil
+		}
+
+		newStatus, operation, kept := ar.transitNodata("rule-1", oldStatus, c.oldLevel != "")
+		if newStatus.CurrentLevel != c.level || operation != c.operation || kept != c.kept {
+			t.Fatalf("transitNodata [%s] of [%s] expect [%s %s %v] but get [%s %s %v]",
+				c.nodataState, c.oldLevel, c.level, c.operation, c.kept, newStatus.CurrentLevel, operation, kept)
+		}
+		if c.snoozed && c.kept && newStatus.SnoozeUntil != snoozeUntil {
+			t.Fatalf("transitNodata [%s] of [%s] should keep snooze", c.nodataState, c.oldLevel)
+		}
+	}
+}
+
+func TestCollectNodataMetrics(t *testing.T) {
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {NodataState: models.RuleNodataStateNodata}}
+	oldResourceStatus := map[string]StatusResource{
+		getRuleResourceKey("rule-1", "node1"): {CurrentLevel: "minor"},
+		getRuleResourceKey("rule-1", "node2"): {CurrentLevel: "cleared"},
+		getRuleResourceKey("rule-1", "node3"): {CurrentLevel: "cleared"},
+		getRuleResourceKey("rule-2", "node4"): {CurrentLevel: "cleared"},
+	}
+
+	noDataMetrics := []RecordedMetric{}
+	absent := ar.collectNodataMetrics("rule-1", oldResourceStatus, []RecordedMetric{{ResourceName: "node1"}}, nil, []string{"node2"}, &noDataMetrics)
+	if absent || len(noDataMetrics) != 1 || noDataMetrics[0].ResourceName != "node3" || !noDataMetrics[0].NoData {
+		t.Fatalf("collectNodataMetrics expect [false node3] but get [%v %+v]", absent, noDataMetrics)
+	}
+
+	noDataMetrics = []RecordedMetric{}
+	absent = ar.collectNodataMetrics("rule-1", map[string]StatusResource{}, nil, nil, nil, &noDataMetrics)
+	if !absent || len(noDataMetrics) != 0 {
+		t.Fatalf("collectNodataMetrics of rule without any data expect [true] but get [%v %+v]", absent, noDataMetrics)
+	}
+}
+
+func TestHysteresis(t *testing.T) {
+	//t is value meeting thresholds, p is value pending between thresholds and recovery thresholds, r is healthy value
+	testCase := []struct {
+		evaluations string
+		operations  []string
+		level       string
+	}{
+		{"t", []string{""}, "cleared"},
+		{"tt", []string{"", "trigger"}, "minor"},
+		{"trt", []string{"", "", ""}, "cleared"},
+		{"ttrr", []string{"", "trigger", "", ""}, "minor"},
+		{"ttrrr", []string{"", "trigger", "", "", "resume"}, "cleared"},
+		{"ttrrprrr", []string{"", "trigger", "", "", "", "", "", "resume"}, "cleared"},
+		{"ttrrtrrr", []string{"", "trigger", "", "", "", "", "", "resume"}, "cleared"},
+		{"ttppp", []string{"", "trigger", "", "", ""}, "minor"},
+	}
+	for _, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {ConsecutiveCount: 2, ConsecutiveClearCount: 3}}
+		newStatus := ar.getResetResourceStatus("rule-1")
+		for i, evaluation := range c.evaluations {
+			operation := ""
+			if evaluation == 't' {
+				_, operation = ar.transitTriggered(&newStatus, "rule-1", "minor")
+			} else {
+				operation = ar.transitResumed(&newStatus, "rule-1", evaluation == 'p')
+			}
+			if operation != c.operations[i] {
+				t.Fatalf("Evaluation %d of [%s] expect [%s] but get [%s]", i+1, c.evaluations, c.operations[i], operation)
+			}
+		}
+		if newStatus.CurrentLevel != c.level {
+			t.Fatalf("Evaluations [%s] expect [%s] but get [%s]", c.evaluations, c.level, newStatus.CurrentLevel)
+		}
+	}
+}
+
+func TestUpdateFlapping(t *testing.T) {
+	now := time.Now()
+	minutesAgo := func(minutes ...int) []time.Time {
+		transitions := []time.Time{}
+		for _, m := range minutes {
+			transitions = append(transitions, now.Add(-time.Duration(m)*time.Minute))
+		}
+		return transitions
+	}
+
+	//Window is 30 minutes and threshold is 4, flapping stops with fewer than 2 transitions
+	testCase := []struct {
+		transitions []time.Time
+		flapping    bool
+		transited   bool
+		event       string
+		count       int
+	}{
+		{minutesAgo(3, 2, 1), false, false, "", 3},
+		{minutesAgo(3, 2, 1), false, true, notification.EventFlappingStarted, 4},
+		{minutesAgo(40, 3, 2, 1), false, false, "", 3},
+		{minutesAgo(40, 35, 1), true, false, notification.EventFlappingStopped, 1},
+		{minutesAgo(40, 35, 1), true, true, "", 2},
+		{minutesAgo(3, 2, 1, 0), true, false, "", 4},
+	}
+	for i, c := range testCase {
+		newStatus := StatusResource{Transitions: c.transitions, Flapping: c.flapping}
+		event := updateFlapping(&newStatus, c.transited, now, 30*time.Minute, 4)
+		if event != c.event || len(newStatus.Transitions) != c.count {
+			t.Fatalf("updateFlapping case %d expect [%s %d] but get [%s %d]", i, c.event, c.count, event, len(newStatus.Transitions))
+		}
+		if newStatus.Flapping != (c.flapping != (event != "")) {
+			t.Fatalf("updateFlapping case %d get wrong flapping [%v]", i, newStatus.Flapping)
+		}
+	}
+}
+
+func TestLevels(t *testing.T) {
+	condition, _ := models.ParseRuleCondition(">")
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {
+		Condition:          condition,
+		Thresholds:         80,
+		Scale:              1,
+		Severity:           "minor",
+		ConsecutiveCount:   1,
+		RecoveryEnabled:    true,
+		RecoveryThresholds: 70,
+		Levels:             []LevelInfo{{80, "minor"}, {90, "major"}, {95, "critical"}},
+	}}
+
+	value := func(v string) []metric.TV { return []metric.TV{{T: 1569549600, V: v}} }
+	resourceMetrics := metric.ResourceMetrics{RuleId: "rule-1", ResourceMetric: map[string][]metric.TV{
+		"node1": value("85"),
+		"node2": value("92"),
+		"node3": value("99"),
+		"node4": value("75"),
+		"node5": value("60"),
+		"node6": {},
+	}}
+	triggeredMetrics := []RecordedMetric{}
+	resumedMetrics := []RecordedMetric{}
+	noDataMetrics := []RecordedMetric{}
+	skippedResources := []string{}
+	if !ar.readRuleResourceMetric(resourceMetrics, &triggeredMetrics, &resumedMetrics, &noDataMetrics, &skippedResources) {
+		t.Fatalf("readRuleResourceMetric failed")
+	}
+
+	severities := map[string]string{}
+	for _, triggeredMetric := range triggeredMetrics {
+		severities[triggeredMetric.ResourceName] = triggeredMetric.Severity
+	}
+	for resourceName, severity := range map[string]string{"node1": "minor", "node2": "major", "node3": "critical"} {
+		if severities[resourceName] != severity {
+			t.Fatalf("Resource [%s] expect severity [%s] but get [%s]", resourceName, severity, severities[resourceName])
+		}
+	}
+	pending := map[string]bool{}
+	for _, resumedMetric := range resumedMetrics {
+		pending[resumedMetric.ResourceName] = resumedMetric.Pending
+	}
+	if len(pending) != 2 || !pending["node4"] || pending["node5"] {
+		t.Fatalf("Resumed resources expect [node4 pending, node5] but get %v", pending)
+	}
+	if len(noDataMetrics) != 1 || noDataMetrics[0].ResourceName != "node6" {
+		t.Fatalf("Nodata resources expect [node6] but get %+v", noDataMetrics)
+	}
+
+	testCase := []struct {
+		severities []string
+		operations []string
+	}{
+		{[]string{"minor", "minor"}, []string{"trigger", ""}},
+		{[]string{"major", "critical"}, []string{"trigger", "escalate"}},
+		{[]string{"critical", "minor"}, []string{"trigger", "deescalate"}},
+		{[]string{"minor", "critical", "major"}, []string{"trigger", "escalate", "deescalate"}},
+	}
+	for _, c := range testCase {
+		newStatus := ar.getResetResourceStatus("rule-1")
+		for i, severity := range c.severities {
+			newStatus.Acknowledger = "admin"
+			newStatus.CumulatedSendCount = 2
+			_, operation := ar.transitTriggered(&newStatus, "rule-1", severity)
+			if operation != c.operations[i] || newStatus.CurrentLevel != severity {
+				t.Fatalf("Levels %v step %d expect [%s %s] but get [%s %s]", c.severities, i+1, c.operations[i], severity, operation, newStatus.CurrentLevel)
+			}
+			if operation != "" && operation != "trigger" && newStatus.CumulatedSendCount != 0 {
+				t.Fatalf("Levels %v step %d should repeat from the beginning", c.severities, i+1)
+			}
+			//Acknowledge only covers the acknowledged level
+			if (operation == "escalate") != (newStatus.Acknowledger == "") {
+				t.Fatalf("Levels %v step %d %s get wrong acknowledger [%s]", c.severities, i+1, operation, newStatus.Acknowledger)
+			}
+		}
+	}
+}
+
+func TestFindInhibitor(t *testing.T) {
+	testCase := []struct {
+		inhibit   bool
+		severity  string
+		firing    map[string]string
+		inhibitor string
+	}{
+		{true, "minor", map[string]string{"rule-2 node1": "critical"}, "rule-2"},
+		{true, "minor", map[string]string{"rule-2 node1": "minor"}, ""},
+		{true, "critical", map[string]string{"rule-2 node1": "major"}, ""},
+		{true, "minor", map[string]string{"rule-2 node2": "critical"}, ""},
+		{true, "minor", map[string]string{"rule-2 node1": "cleared"}, ""},
+		{false, "minor", map[string]string{"rule-2 node1": "critical"}, ""},
+	}
+	for i, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {Inhibit: c.inhibit}, "rule-2": {RuleName: "rule-2"}}
+		ar.AlertStatus.ResourceStatus = make(map[string]StatusResource)
+		for k, level := range c.firing {
+			ar.AlertStatus.ResourceStatus[k] = StatusResource{CurrentLevel: level}
+		}
+		//Inhibiting alert is found once in a tick, none here
+		ar.Tick.InhibitorLoaded = true
+		if inhibitor := ar.findInhibitor("rule-1", "node1", c.severity); inhibitor != c.inhibitor {
+			t.Fatalf("findInhibitor case %d expect [%s] but get [%s]", i, c.inhibitor, inhibitor)
+		}
+	}
+
+	//Inhibiting alert found in tick inhibits every rule
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {}}
+	ar.Tick = TickCache{InhibitorLoaded: true, Inhibitor: "inhibit-1 node node1"}
+	if inhibitor := ar.findInhibitor("rule-1", "node1", "critical"); inhibitor != ar.Tick.Inhibitor {
+		t.Fatalf("findInhibitor expect [%s] but get [%s]", ar.Tick.Inhibitor, inhibitor)
+	}
+}
+
+func TestInhibitRule(t *testing.T) {
+	inhibitRule := models.InhibitRule{InhibitRuleName: "inhibit-1", SourceRsTypeName: "node", SourceSeverity: "major", EqualLabel: "node_id"}
+
+	for filterParam, expect := range map[string]string{
+		`{"node_id":"node1","namespace":"default"}`: "node1",
+		`{"node_id":"node:node1"}`:                  "node1",
+		`{"node_id":""}`:                            "",
+		`{"namespace":"default"}`:                   "",
+	} {
+		param := make(map[string]interface{})
+		json.Unmarshal([]byte(filterParam), &param)
+		if sourceResourceName := getInhibitSourceResource(inhibitRule, param); sourceResourceName != expect {
+			t.Fatalf("getInhibitSourceResource of [%s] expect [%s] but get [%s]", filterParam, expect, sourceResourceName)
+		}
+	}
+
+	testCase := []struct {
+		firingStates []FiringState
+		inhibited    bool
+	}{
+		{[]FiringState{{"alert-2", "rule-1", "critical"}}, true},
+		{[]FiringState{{"alert-2", "rule-1", "major"}}, true},
+		{[]FiringState{{"alert-2", "rule-1", "minor"}}, false},
+		{[]FiringState{{"alert-1", "rule-1", "critical"}}, false},
+		{[]FiringState{{"alert-2", "rule-1", "minor"}, {"alert-3", "rule-1", "critical"}}, true},
+		{[]FiringState{}, false},
+	}
+	for i, c := range testCase {
+		if inhibited := isInhibitedByFiringStates("alert-1", inhibitRule, c.firingStates); inhibited != c.inhibited {
+			t.Fatalf("isInhibitedByFiringStates case %d expect [%v] but get [%v]", i, c.inhibited, inhibited)
+		}
+	}
+}
+
+func TestFindSilence(t *testing.T) {
+	//Each matcher is matched against its own field of the alert, rule or resource
+	testCase := []struct {
+		matchers string
+		severity string
+		silenced bool
+	}{
+		{`{"alert_name":"alert-1"}`, "minor", true},
+		{`{"rule_name":"cpu","severity":"critical"}`, "critical", true},
+		{`{"rule_name":"cpu","severity":"critical"}`, "minor", false},
+		{`{"resource_name":"node1"}`, "minor", true},
+		{`{"rs_filter_param":{"node_id":"node1"}}`, "minor", true},
+		{`{"rs_filter_param":{"node_id":"node2"}}`, "minor", false},
+	}
+	for _, c := range testCase {
+		matchers, err := models.ParseSilenceMatchers(c.matchers)
+		if err != nil {
+			t.Fatalf("ParseSilenceMatchers [%s] failed: %+v", c.matchers, err)
+		}
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.AlertName = "alert-1"
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {RuleName: "cpu"}}
+		ar.AlertConfig.RsFilterParam = `{"node_id":"node1"}`
+		ar.Tick = TickCache{SilencesLoaded: true, Silences: []ActiveSilence{{"sl-1", matchers}}}
+
+		silenceId := ar.findSilence("rule-1", "node1", c.severity)
+		if (silenceId == "sl-1") != c.silenced {
+			t.Fatalf("findSilence [%s] of [%s] expect [%v] but get [%s]", c.matchers, c.severity, c.silenced, silenceId)
+		}
+	}
+}
+
+func TestAcknowledge(t *testing.T) {
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {}}
+
+	//Resource not firing is never acknowledged
+	ar.AlertStatus.ResourceStatus = map[string]StatusResource{
+		getRuleResourceKey("rule-1", "node1"): {CurrentLevel: "cleared"},
+		getRuleResourceKey("rule-1", "node2"): {CurrentLevel: "nodata"},
+	}
+	for _, resourceName := range []string{"node1", "node2", "node3"} {
+		ar.acknowledgeAlert(fmt.Sprintf(`{"rule_id":"rule-1","resource_name":"%s","acknowledger":"admin"}`, resourceName))
+		if ar.AlertStatus.ResourceStatus[getRuleResourceKey("rule-1", resourceName)].Acknowledger != "" {
+			t.Fatalf("acknowledgeAlert of [%s] not firing should be rejected", resourceName)
+		}
+	}
+
+	//Acknowledged resource is still aggregated, but neither notified nor counted as sent
+	newStatus := ar.getResetResourceStatus("rule-1")
+	newStatus.CurrentLevel = "minor"
+	newStatus.Acknowledger = "admin"
+	ar.sendActiveNotification(&newStatus, "rule-1", "node1", []RecordedMetric{{ResourceName: "node1", Value: 90}})
+	if newStatus.AggregatedAlerts.CumulatedCount != 1 || newStatus.CumulatedSendCount != 0 {
+		t.Fatalf("sendActiveNotification of acknowledged resource get wrong status %+v", newStatus)
+	}
+
+	//Acknowledge is cleared when resource resumes
+	newStatus.NegativeCount = 0
+	if operation := ar.transitResumed(&newStatus, "rule-1", false); operation != "resume" || newStatus.Acknowledger != "" {
+		t.Fatalf("transitResumed of acknowledged resource expect [resume] but get [%s %s]", operation, newStatus.Acknowledger)
+	}
+}
+
+func TestSnooze(t *testing.T) {
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {}}
+	for snoozeUntil, snoozed := range map[time.Time]bool{
+		time.Now().Add(time.Minute):  true,
+		time.Now().Add(-time.Minute): false,
+		time.Time{}:                  false,
+	} {
+		if ar.isSnoozed(&StatusResource{SnoozeUntil: snoozeUntil}) != snoozed {
+			t.Fatalf("isSnoozed until %v expect [%v]", snoozeUntil, snoozed)
+		}
+	}
+
+	//Snoozed resource is still aggregated, but neither notified nor counted as sent
+	newStatus := ar.getResetResourceStatus("rule-1")
+	newStatus.CurrentLevel = "minor"
+	newStatus.SnoozeUntil = time.Now().Add(30 * time.Minute)
+	ar.sendActiveNotification(&newStatus, "rule-1", "node1", []RecordedMetric{{ResourceName: "node1", Value: 90}})
+	if newStatus.AggregatedAlerts.CumulatedCount != 1 || newStatus.CumulatedSendCount != 0 {
+		t.Fatalf("sendActiveNotification of snoozed resource get wrong status %+v", newStatus)
+	}
+
+	//Snooze outlives resume of resource
+	if operation := ar.transitResumed(&newStatus, "rule-1", false); operation != "resume" || !ar.isSnoozed(&newStatus) {
+		t.Fatalf("transitResumed of snoozed resource expect [resume] and keep snooze but get [%s %v]", operation, newStatus.SnoozeUntil)
+	}
+}
+
+func parseTestSchedule(t *testing.T, format string, a ...interface{}) *models.PolicySchedule {
+	schedule, err := models.ParsePolicySchedule(fmt.Sprintf(format, a...))
+	if err != nil {
+		t.Fatalf("ParsePolicySchedule failed: %+v", err)
+	}
+	return schedule
+}
+
+func TestCheckTimeAvailable(t *testing.T) {
+	now := time.Now()
+	aroundNow := fmt.Sprintf(`{"start_time":"%s","end_time":"%s"}`, now.Add(-time.Hour).Format(models.ScheduleTimeFormat), now.Add(time.Hour).Format(models.ScheduleTimeFormat))
+	awayFromNow := fmt.Sprintf(`{"start_time":"%s","end_time":"%s"}`, now.Add(time.Hour).Format(models.ScheduleTimeFormat), now.Add(2*time.Hour).Format(models.ScheduleTimeFormat))
+
+	testCase := []struct {
+		schedule  *models.PolicySchedule
+		available bool
+	}{
+		{nil, false},
+		{parseTestSchedule(t, `{"windows":[%s]}`, aroundNow), true},
+		{parseTestSchedule(t, `{"windows":[%s]}`, awayFromNow), false},
+		{parseTestSchedule(t, `{"windows":[%s,%s]}`, awayFromNow, aroundNow), true},
+		{parseTestSchedule(t, `{"windows":[%s],"exclude_dates":["%s"]}`, aroundNow, now.Format(models.ScheduleDateFormat)), false},
+	}
+	for i, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		//Available start and end time never available are taken only without schedule
+		ar.AlertConfig.AvailableStartTime = "00:00:00"
+		ar.AlertConfig.AvailableEndTime = "00:00:00"
+		ar.AlertConfig.AvailableSchedule = c.schedule
+		if available := ar.checkTimeAvailable(); available != c.available {
+			t.Fatalf("checkTimeAvailable case %d expect [%v] but get [%v]", i, c.available, available)
+		}
+	}
+}
+
+func TestDeferNotification(t *testing.T) {
+	window := fmt.Sprintf(`{"start_time":"%s","end_time":"%s"}`, time.Now().Add(time.Hour).Format(models.ScheduleTimeFormat), time.Now().Add(2*time.Hour).Format(models.ScheduleTimeFormat))
+	closed := parseTestSchedule(t, `{"windows":[%s]}`, window)
+	deferred := *parseTestSchedule(t, `{"windows":[%s],"defer_delivery":true}`, window)
+
+	//Notification outside window is neither sent nor counted, unless it would not be sent anyway
+	testCase := []struct {
+		schedule     *models.PolicySchedule
+		acknowledger string
+		deferred     string
+		actions      []ConfigAction
+		sendCount    uint32
+	}{
+		{closed, "", "", []ConfigAction{{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "email", NfAddressListId: "nfl-1"}}, 0},
+		{&deferred, "", models.DeferredStatusFiring, []ConfigAction{{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "email", NfAddressListId: "nfl-1"}}, 0},
+		{&deferred, "admin", "", []ConfigAction{{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "email", NfAddressListId: "nfl-1"}}, 0},
+		{&deferred, "", "", []ConfigAction{{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusResumed}, TriggerAction: "email", NfAddressListId: "nfl-1"}}, 1},
+	}
+	for i, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {}}
+		ar.AlertConfig.AvailableSchedule = c.schedule
+		ar.AlertConfig.Actions = c.actions
+		//Deferred notifications are loaded, and nothing mutes the resource
+		ar.HasDeferred = false
+		ar.Tick = TickCache{InhibitorLoaded: true, SilencesLoaded: true}
+		if c.deferred != "" {
+			ar.Deferred[getRuleResourceKey("rule-1", "node1")] = c.deferred
+		}
+
+		newStatus := ar.getResetResourceStatus("rule-1")
+		newStatus.CurrentLevel = "minor"
+		newStatus.Acknowledger = c.acknowledger
+		ar.sendActiveNotification(&newStatus, "rule-1", "node1", []RecordedMetric{{ResourceName: "node1", Value: 90}})
+		if newStatus.CumulatedSendCount != c.sendCount || ar.HasDeferred {
+			t.Fatalf("sendActiveNotification case %d expect send count [%d] but get [%d %v]", i, c.sendCount, newStatus.CumulatedSendCount, ar.HasDeferred)
+		}
+	}
+
+	//Digest is sent once for each set of receivers regardless of order
+	receivers := []Receiver{{"email", "nfl-1"}, {"webhook", "nfl-2"}}
+	if getReceiversKey(receivers) != getReceiversKey([]Receiver{receivers[1], receivers[0]}) || getReceiversKey(receivers) == getReceiversKey(receivers[:1]) {
+		t.Fatalf("getReceiversKey of %v get wrong key [%s]", receivers, getReceiversKey(receivers))
+	}
+
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {}}
+	ar.AlertConfig.Actions = []ConfigAction{
+		{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "email", NfAddressListId: "nfl-1"},
+		{ActionId: "act-2", TriggerStatus: []string{models.TriggerStatusResumed}, TriggerAction: "email", NfAddressListId: "nfl-2"},
+	}
+	resourceStatus := StatusResource{CurrentLevel: "minor"}
+	for event, nfAddressListId := range map[string]string{models.DeferredStatusFiring: "nfl-1", models.DeferredStatusResumed: "nfl-2"} {
+		deferredReceivers := ar.getDeferredReceivers("rule-1", "node1", event, &resourceStatus)
+		if len(deferredReceivers) != 1 || deferredReceivers[0].NfAddressListId != nfAddressListId {
+			t.Fatalf("getDeferredReceivers of [%s] expect [%s] but get %v", event, nfAddressListId, deferredReceivers)
+		}
+	}
+}
+
+func TestParsePolicyConfig(t *testing.T) {
+	testCase := []struct {
+		policyConfig string
+		valid        bool
+		severities   int
+	}{
+		{"", true, 3},
+		{`{"critical":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":5,"max_send_count":3}}`, true, 1},
+		{`{"critical":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":0,"max_send_count":3}}`, false, 3},
+		{`{"critical":`, false, 3},
+	}
+	for _, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		err := ar.parsePolicyConfig(rs.AlertDetail{PolicyConfig: c.policyConfig})
+		if (err == nil) != c.valid || len(ar.AlertConfig.PolicyConfig) != c.severities {
+			t.Fatalf("parsePolicyConfig [%s] expect valid [%v] with %d severities but get [%v] %d", c.policyConfig, c.valid, c.severities, err, len(ar.AlertConfig.PolicyConfig))
+		}
+		for severity, policyConfig := range ar.AlertConfig.PolicyConfig {
+			if policyConfig.Strategy == nil {
+				t.Fatalf("parsePolicyConfig [%s] get no strategy of [%s]", c.policyConfig, severity)
+			}
+		}
+	}
+}
+
+func TestNotificationGroup(t *testing.T) {
+	for groupBy, expect := range map[string]string{
+		`["rule"]`:              "rule-1 ",
+		`["rule","ns_name"]`:    "rule-1 ns_name=default",
+		`["ns_name","node_id"]`: "ns_name=default,node_id=node1",
+	} {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.RsFilterParam = `{"ns_name":"default","node_id":"node1"}`
+		ar.AlertConfig.GroupConfig = &models.PolicyGroupConfig{}
+		json.Unmarshal([]byte(groupBy), &ar.AlertConfig.GroupConfig.GroupBy)
+		if groupKey, _ := ar.getGroupKey("rule-1"); groupKey != expect {
+			t.Fatalf("getGroupKey by %s expect [%s] but get [%s]", groupBy, expect, groupKey)
+		}
+	}
+
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.GroupConfig = &models.PolicyGroupConfig{GroupBy: []string{models.GroupByRule}}
+	ar.AlertStatus.Groups = make(map[string]*NotificationGroup)
+	email := []Receiver{{"email", "nfl-1"}}
+	ar.addToGroup("rule-1", "node1", email, notification.NotificationParam{Event: notification.EventFiring})
+	ar.addToGroup("rule-1", "node2", email, notification.NotificationParam{Event: notification.EventFiring})
+	ar.addToGroup("rule-1", "node1", email, notification.NotificationParam{Event: notification.EventResumed})
+	ar.addToGroup("rule-1", "node1", []Receiver{{"email", "nfl-2"}}, notification.NotificationParam{Event: notification.EventFiring})
+	if len(ar.AlertStatus.Groups) != 2 {
+		t.Fatalf("addToGroup should never merge notifications to different receivers, get %d groups", len(ar.AlertStatus.Groups))
+	}
+	for _, group := range ar.AlertStatus.Groups {
+		if group.Receivers[0].NfAddressListId != "nfl-1" {
+			continue
+		}
+		//Resume replaces firing of the same resource, and keeps its place in group
+		if len(group.EntryKeys) != 2 || group.EntryKeys[0] != "rule-1 node1" || group.Entries["rule-1 node1"].Param.Event != notification.EventResumed {
+			t.Fatalf("addToGroup get wrong group %+v", group)
+		}
+	}
+
+	now := time.Now()
+	groupConfig := &models.PolicyGroupConfig{GroupWait: 30, GroupInterval: 300}
+	testCase := []struct {
+		group       NotificationGroup
+		groupConfig *models.PolicyGroupConfig
+		due         bool
+		expired     bool
+	}{
+		{NotificationGroup{EntryKeys: []string{"rule-1 node1"}, FirstPendingTime: now.Add(-10 * time.Second)}, groupConfig, false, false},
+		{NotificationGroup{EntryKeys: []string{"rule-1 node1"}, FirstPendingTime: now.Add(-30 * time.Second)}, groupConfig, true, false},
+		{NotificationGroup{EntryKeys: []string{"rule-1 node1"}, FirstPendingTime: now.Add(-time.Minute), LastSentTime: now.Add(-time.Minute)}, groupConfig, false, false},
+		{NotificationGroup{EntryKeys: []string{"rule-1 node1"}, FirstPendingTime: now.Add(-time.Hour), LastSentTime: now.Add(-5 * time.Minute)}, groupConfig, true, false},
+		{NotificationGroup{LastSentTime: now.Add(-time.Minute)}, groupConfig, false, false},
+		{NotificationGroup{LastSentTime: now.Add(-5 * time.Minute)}, groupConfig, false, true},
+		{NotificationGroup{EntryKeys: []string{"rule-1 node1"}, FirstPendingTime: now, LastSentTime: now}, nil, true, false},
+	}
+	for i, c := range testCase {
+		due, expired := checkGroupDue(&c.group, c.groupConfig, now)
+		if due != c.due || expired != c.expired {
+			t.Fatalf("checkGroupDue case %d expect [%v %v] but get [%v %v]", i, c.due, c.expired, due, expired)
+		}
+	}
+}
+
+func TestEscalation(t *testing.T) {
+	escalation := &models.EscalationConfig{
+		Severities: []string{"critical"},
+		Steps:      []models.EscalationStep{{Delay: 30, NfAddressListId: "nfl-2"}, {Delay: 60, NfAddressListId: "nfl-3"}},
+	}
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.AlertConfig.Actions = []ConfigAction{
+		{ActionId: "act-1", TriggerAction: "email", NfAddressListId: "nfl-1", Escalation: escalation},
+		{ActionId: "act-2", TriggerAction: "email", NfAddressListId: "nfl-1"},
+	}
+
+	now := time.Now()
+	testCase := []struct {
+		firing time.Duration
+		steps  uint32
+		index  uint32
+		due    bool
+	}{
+		{10 * time.Minute, 0, 0, false},
+		{30 * time.Minute, 0, 0, true},
+		{45 * time.Minute, 1, 1, false},
+		{2 * time.Hour, 1, 1, true},
+		{2 * time.Hour, 2, 2, false},
+	}
+	for i, c := range testCase {
+		newStatus := StatusResource{FiringTime: now.Add(-c.firing), EscalationSteps: map[string]uint32{"act-1": c.steps}}
+		index, due := getDueEscalationStep(&newStatus, ar.AlertConfig.Actions[0], now)
+		if index != c.index || due != c.due {
+			t.Fatalf("getDueEscalationStep case %d expect [%d %v] but get [%d %v]", i, c.index, c.due, index, due)
+		}
+	}
+
+	//Escalation of resource not notified waits even if steps are due
+	for i, newStatus := range []StatusResource{
+		{Acknowledger: "admin"},
+		{SnoozeUntil: now.Add(time.Hour)},
+		{Silenced: true},
+		{Inhibited: true},
+	} {
+		newStatus.CurrentLevel = "critical"
+		newStatus.FiringTime = now.Add(-2 * time.Hour)
+		if ar.escalateNotification(&newStatus, "rule-1", "node1") || len(newStatus.EscalationSteps) != 0 {
+			t.Fatalf("escalateNotification case %d should hold escalation, get %+v", i, newStatus.EscalationSteps)
+		}
+	}
+
+	//Resource of severity not escalated is left alone
+	newStatus := StatusResource{CurrentLevel: "minor"}
+	if ar.escalateNotification(&newStatus, "rule-1", "node1") || !newStatus.FiringTime.IsZero() {
+		t.Fatalf("escalateNotification of minor resource get wrong status %+v", newStatus)
+	}
+
+	//Resource firing before escalation is configured escalates from now on, held escalation steps nothing
+	newStatus = StatusResource{CurrentLevel: "critical", Acknowledger: "admin"}
+	if !ar.escalateNotification(&newStatus, "rule-1", "node1") || newStatus.FiringTime.IsZero() || len(newStatus.EscalationSteps) != 0 {
+		t.Fatalf("escalateNotification of acknowledged resource get wrong status %+v", newStatus)
+	}
+	if ar.escalateNotification(&newStatus, "rule-1", "node1") {
+		t.Fatalf("escalateNotification of acknowledged resource should not escalate again")
+	}
+}
+
+func TestRouting(t *testing.T) {
+	policyRoute, err := models.ParsePolicyRoute(`{"routes":[
+		{"severities":["critical"],"nf_address_list_id":"nfl-oncall","repeat_config":{"repeat_type":"fixed-minutes","repeat_interval_initvalue":5,"max_send_count":10}},
+		{"labels":{"namespace":"db"},"nf_address_list_id":"nfl-dba","continue":true}]}`)
+	if err != nil {
+		t.Fatalf("ParsePolicyRoute failed: %+v", err)
+	}
+	repeatConfig := models.RepeatConfig{RepeatType: models.RepeatTypeNotRepeat}
+	strategy, _ := models.NewRepeatStrategy(repeatConfig)
+
+	testCase := []struct {
+		level           string
+		filterParam     string
+		receivers       []Receiver
+		repeatType      string
+		repeatInitvalue uint32
+	}{
+		{"minor", `{"namespace":"default"}`, []Receiver{{"email", "nfl-1"}, {"webhook", "nfl-2"}}, models.RepeatTypeNotRepeat, 0},
+		{"critical", `{"namespace":"default"}`, []Receiver{{"email", "nfl-oncall"}, {"webhook", "nfl-oncall"}}, models.RepeatTypeFixedMinutes, 5},
+		{"minor", `{"namespace":"db"}`, []Receiver{{"email", "nfl-dba"}, {"webhook", "nfl-dba"}}, models.RepeatTypeNotRepeat, 0},
+		{"critical", `{"namespace":"db"}`, []Receiver{{"email", "nfl-oncall"}, {"webhook", "nfl-oncall"}}, models.RepeatTypeFixedMinutes, 5},
+	}
+	for i, c := range testCase {
+		ar := NewAlertRunner("alert-1", nil, nil)
+		ar.AlertConfig.Rules = map[string]RuleInfo{"rule-1": {Severity: "minor", Levels: []LevelInfo{{80, "minor"}, {95, "critical"}}}}
+		ar.AlertConfig.Route = policyRoute
+		ar.AlertConfig.RsFilterParam = c.filterParam
+		ar.AlertConfig.PolicyConfig = map[string]ConfigPolicy{"minor": {repeatConfig, strategy}}
+		ar.AlertConfig.Actions = []ConfigAction{
+			{ActionId: "act-1", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "email", NfAddressListId: "nfl-1"},
+			{ActionId: "act-2", TriggerStatus: []string{models.TriggerStatusTriggered}, TriggerAction: "webhook", NfAddressListId: "nfl-2"},
+		}
+
+		newStatus := StatusResource{CurrentLevel: c.level}
+		receivers := ar.getResourceReceivers(&newStatus, "rule-1", "node1", models.TriggerStatusTriggered)
+		if getReceiversKey(receivers) != getReceiversKey(c.receivers) {
+			t.Fatalf("getResourceReceivers case %d expect %v but get %v", i, c.receivers, receivers)
+		}
+		policyConfig := ar.getResourcePolicyConfig(&newStatus, "rule-1", "node1")
+		if policyConfig.RepeatType != c.repeatType || policyConfig.RepeatIntervalInitvalue != c.repeatInitvalue {
+			t.Fatalf("getResourcePolicyConfig case %d expect [%s %d] but get %+v", i, c.repeatType, c.repeatInitvalue, policyConfig.RepeatConfig)
+		}
+	}
+}
+
+func TestTriggerActions(t *testing.T) {
+	ar := NewAlertRunner("alert-1", nil, nil)
+	ar.parseActions([]models.Action{
+		{ActionId: "act-1", TriggerStatus: "", NfAddressListId: "nfl-1"},
+		{ActionId: "act-2", TriggerStatus: "resumed", TriggerAction: models.TriggerActionWebhook, NfAddressListId: "nfl-2"},
+		{ActionId: "act-3", TriggerStatus: "escalated", TriggerAction: models.TriggerActionSlack, NfAddressListId: "nfl-3"},
+		{ActionId: "act-4", TriggerStatus: "triggered", TriggerAction: "sms", NfAddressListId: "nfl-4"},
+		{ActionId: "act-5", TriggerStatus: "fired", NfAddressListId: "nfl-5"},
+		{ActionId: "act-6", TriggerStatus: "triggered", NfAddressListId: "nfl-1"},
+	})
+	if len(ar.AlertConfig.Actions) != 4 || ar.AlertConfig.Actions[0].TriggerAction != models.TriggerActionNotification {
+		t.Fatalf("parseActions get wrong actions %+v", ar.AlertConfig.Actions)
+	}
+
+	testCase := []struct {
+		newStatus  StatusResource
+		transition string
+		receivers  []Receiver
+	}{
+		{StatusResource{Transition: models.TriggerStatusTriggered}, models.TriggerStatusTriggered, []Receiver{{models.TriggerActionNotification, "nfl-1"}}},
+		{StatusResource{Transition: models.TriggerStatusTriggered, CumulatedSendCount: 1}, models.TriggerStatusRepeated, []Receiver{{models.TriggerActionNotification, "nfl-1"}}},
+		{StatusResource{Transition: models.TriggerStatusEscalated}, models.TriggerStatusEscalated, []Receiver{{models.TriggerActionSlack, "nfl-3"}}},
+		{StatusResource{Transition: "deescalated"}, models.TriggerStatusTriggered, []Receiver{{models.TriggerActionNotification, "nfl-1"}}},
+	}
+	for i, c := range testCase {
+		transition := getActiveTransition(&c.newStatus)
+		receivers := ar.getActionReceivers(transition)
+		if transition != c.transition || getReceiversKey(receivers) != getReceiversKey(c.receivers) {
+			t.Fatalf("Action receivers case %d expect [%s %v] but get [%s %v]", i, c.transition, c.receivers, transition, receivers)
+		}
+	}
+
+	receivers := ar.getActionReceivers(models.TriggerStatusResumed)
+	if getReceiversKey(receivers) != getReceiversKey([]Receiver{{models.TriggerActionNotification, "nfl-1"}, {models.TriggerActionWebhook, "nfl-2"}}) {
+		t.Fatalf("getActionReceivers of resumed get wrong receivers %v", receivers)
+	}
+}
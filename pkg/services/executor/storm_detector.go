@@ -0,0 +1,310 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
+	rs "kubesphere.io/alert/pkg/services/executor/resource_control"
+)
+
+//Notifications of all executors are counted in etcd to detect alert storm, eg. during a cluster outage,
+//key is alert-storm/<window>/<executor_name> and value is the count of the executor in the window.
+const stormPrefix = "alert-storm/"
+
+//Summary lists at most this number of notifications, the others are only counted.
+const maxStormSummaryEntries = 50
+
+//StormSummary collects notifications to one address list of a notifier in summary mode.
+type StormSummary struct {
+	Notifier        string
+	NfAddressListId string
+	Language        string
+	Count           uint32
+	Entries         []notification.NotificationParam
+	FirstTime       time.Time
+}
+
+type StormDetector struct {
+	sync.Mutex
+	executor        *Executor
+	window          int64
+	localCount      uint32
+	storming        bool
+	summaries       map[string]*StormSummary
+	lastSummaryTime time.Time
+}
+
+func NewStormDetector() *StormDetector {
+	sd := &StormDetector{
+		summaries: make(map[string]*StormSummary),
+	}
+
+	return sd
+}
+
+func (sd *StormDetector) SetExecutor(executor *Executor) {
+	sd.executor = executor
+}
+
+func formatStormPrefix(window int64) string {
+	return fmt.Sprintf("%s%d/", stormPrefix, window)
+}
+
+func getStormWindowSeconds() int64 {
+	stormWindow := int64(config.GetInstance().Executor.StormWindow)
+	if stormWindow == 0 {
+		stormWindow = 60
+	}
+	return stormWindow
+}
+
+func getStormWindow(now time.Time) int64 {
+	return now.Unix() / getStormWindowSeconds()
+}
+
+//Count records a notification about to be sent, and reports whether it should be put into summary instead.
+//Executor exceeding the threshold alone starts summary mode at once, without waiting for the count in etcd.
+func (sd *StormDetector) Count() bool {
+	stormThreshold := config.GetInstance().Executor.StormThreshold
+	if stormThreshold == 0 {
+		return false
+	}
+
+	sd.Lock()
+	defer sd.Unlock()
+
+	window := getStormWindow(time.Now())
+	if window != sd.window {
+		sd.window = window
+		sd.localCount = 0
+	}
+	sd.localCount = sd.localCount + 1
+
+	if !sd.storming && sd.localCount >= stormThreshold {
+		sd.startStorm()
+	}
+
+	return sd.storming
+}
+
+func (sd *StormDetector) AddSummary(notifier string, nfAddressListId string, language string, notificationParam notification.NotificationParam) {
+	sd.Lock()
+	defer sd.Unlock()
+
+	key := getStormSummaryKey(notifier, nfAddressListId, language)
+	summary, ok := sd.summaries[key]
+	if !ok {
+		summary = &StormSummary{
+			Notifier:        notifier,
+			NfAddressListId: nfAddressListId,
+			Language:        language,
+			FirstTime:       time.Now(),
+		}
+		sd.summaries[key] = summary
+	}
+
+	summary.Count = summary.Count + 1
+	if len(summary.Entries) < maxStormSummaryEntries {
+		summary.Entries = append(summary.Entries, notificationParam)
+	}
+}
+
+func getStormSummaryKey(notifier string, nfAddressListId string, language string) string {
+	return notifier + " " + nfAddressListId + " " + language
+}
+
+//restoreSummary puts back summary failed to send, it is merged into the one collected meanwhile for the same address list.
+func (sd *StormDetector) restoreSummary(summary *StormSummary) {
+	sd.Lock()
+	defer sd.Unlock()
+
+	key := getStormSummaryKey(summary.Notifier, summary.NfAddressListId, summary.Language)
+	collected, ok := sd.summaries[key]
+	if ok {
+		summary.Count = summary.Count + collected.Count
+		for _, entry := range collected.Entries {
+			if len(summary.Entries) >= maxStormSummaryEntries {
+				break
+			}
+			summary.Entries = append(summary.Entries, entry)
+		}
+	}
+	sd.summaries[key] = summary
+}
+
+func (sd *StormDetector) startStorm() {
+	sd.storming = true
+	sd.lastSummaryTime = time.Now()
+	logger.Info(nil, "StormDetector executor %s enters summary mode", sd.executor.GetName())
+}
+
+//takeSummaries returns summaries collected and starts new ones, caller must hold the lock.
+func (sd *StormDetector) takeSummaries() []*StormSummary {
+	summaries := []*StormSummary{}
+	for _, summary := range sd.summaries {
+		summaries = append(summaries, summary)
+	}
+	sd.summaries = make(map[string]*StormSummary)
+	sd.lastSummaryTime = time.Now()
+
+	return summaries
+}
+
+//putCount publishes count of this executor in window, the key expires after a few windows.
+func (sd *StormDetector) putCount(window int64, count uint32) error {
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	key := formatStormPrefix(window) + sd.executor.GetName()
+
+	resp, err := e.Grant(ctx, getStormWindowSeconds()*3)
+	if err != nil {
+		logger.Error(nil, "Grant TTL from etcd failed: %+v", err)
+		return err
+	}
+
+	_, err = e.Put(ctx, key, strconv.FormatUint(uint64(count), 10), clientv3.WithLease(resp.ID))
+	if err != nil {
+		logger.Error(nil, "StormDetector put [%s] to etcd failed: %+v", key, err)
+		return err
+	}
+
+	return nil
+}
+
+//getCount sums up counts of all executors in window.
+func (sd *StormDetector) getCount(window int64) (uint32, error) {
+	ctx := context.Background()
+	e := global.GetInstance().GetEtcd()
+
+	prefix := formatStormPrefix(window)
+
+	resp, err := e.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		logger.Error(nil, "StormDetector get [%s] from etcd failed: %+v", prefix, err)
+		return 0, err
+	}
+
+	count := uint64(0)
+	for _, kv := range resp.Kvs {
+		c, err := strconv.ParseUint(string(kv.Value), 10, 32)
+		if err != nil {
+			continue
+		}
+		count = count + c
+	}
+
+	return uint32(count), nil
+}
+
+//check enters summary mode when count of the cluster in current or last window reaches threshold,
+//and leaves it when both are below half of threshold. Summaries are sent every summary interval and when storm subsides.
+func (sd *StormDetector) check() {
+	cfg := config.GetInstance().Executor
+
+	sd.Lock()
+	window := getStormWindow(time.Now())
+	if window != sd.window {
+		sd.window = window
+		sd.localCount = 0
+	}
+	localCount := sd.localCount
+	sd.Unlock()
+
+	clusterCount := uint32(0)
+	lastClusterCount := uint32(0)
+	err := sd.putCount(window, localCount)
+	if err == nil {
+		clusterCount, err = sd.getCount(window)
+	}
+	if err == nil {
+		lastClusterCount, err = sd.getCount(window - 1)
+	}
+	//Fall back to executor-wide detection without etcd
+	if err != nil {
+		clusterCount = localCount
+	}
+
+	summaries := []*StormSummary{}
+
+	sd.Lock()
+	if cfg.StormThreshold == 0 {
+		sd.storming = false
+		summaries = sd.takeSummaries()
+	} else if !sd.storming {
+		if clusterCount >= cfg.StormThreshold || lastClusterCount >= cfg.StormThreshold {
+			sd.startStorm()
+		}
+	} else if clusterCount < cfg.StormThreshold/2 && lastClusterCount < cfg.StormThreshold/2 {
+		sd.storming = false
+		summaries = sd.takeSummaries()
+		logger.Info(nil, "StormDetector executor %s leaves summary mode", sd.executor.GetName())
+	} else if time.Now().Sub(sd.lastSummaryTime) >= time.Duration(cfg.StormSummaryInterval)*time.Second {
+		summaries = sd.takeSummaries()
+	}
+	sd.Unlock()
+
+	for _, summary := range summaries {
+		if !sd.sendSummary(summary) {
+			sd.restoreSummary(summary)
+		}
+	}
+}
+
+//sendSummary queues summary to outbox, it returns false if summary is not queued.
+func (sd *StormDetector) sendSummary(summary *StormSummary) bool {
+	notificationParam := notification.NotificationParam{
+		CumulatedCount: summary.Count,
+		FirstTime:      summary.FirstTime.Format("2006-01-02 15:04:05.99999"),
+		LastTime:       time.Now().Format("2006-01-02 15:04:05.99999"),
+		Event:          notification.EventStorm,
+		Digest:         summary.Entries,
+	}
+
+	email := formatNotificationEmail(notificationParam, false, summary.Language)
+	if email == nil {
+		logger.Error(nil, "formatNotificationEmail storm summary failed")
+		return false
+	}
+
+	if notification.GetNotifier(summary.Notifier) == nil {
+		logger.Error(nil, "StormDetector send summary to [%s] unsupported notifier [%s]", summary.NfAddressListId, summary.Notifier)
+		return false
+	}
+
+	//Summary belongs to no alert, it is queued once for the address list by the first time of storm
+	addresses := []string{summary.NfAddressListId}
+	key := fmt.Sprintf("storm %s", summary.FirstTime.Format(time.RFC3339Nano))
+	idempotencyKey := models.NewOutboxIdempotencyKey("", key, summary.Notifier, addresses)
+	outbox := models.NewOutbox(idempotencyKey, "", "", "", summary.Notifier, addresses, email.Title, email.Content, email.Html)
+	outboxId, err := rs.EnqueueOutbox(outbox)
+	if err != nil {
+		logger.Error(nil, "StormDetector queue summary of %d notifications to [%s] failed: %v", summary.Count, summary.NfAddressListId, err)
+		return false
+	}
+
+	logger.Info(nil, "StormDetector queue summary of %d notifications to [%s] success, outbox [%s]", summary.Count, summary.NfAddressListId, outboxId)
+	return true
+}
+
+func (sd *StormDetector) Serve() {
+	timer := time.NewTicker(time.Second * TickPeriodSecond)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			sd.check()
+		}
+	}
+}
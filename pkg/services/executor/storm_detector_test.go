@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"testing"
+
+	"kubesphere.io/alert/pkg/config"
+	"kubesphere.io/alert/pkg/notification"
+)
+
+func TestStormDetector(t *testing.T) {
+	cfg := &config.GetInstance().Executor
+	stormThreshold, stormWindow := cfg.StormThreshold, cfg.StormWindow
+	defer func() { cfg.StormThreshold, cfg.StormWindow = stormThreshold, stormWindow }()
+	//Counts never move to the next window during test
+	cfg.StormWindow = 1 << 31
+
+	cfg.StormThreshold = 0
+	sd := NewStormDetector()
+	sd.SetExecutor(&Executor{name: "executor-1"})
+	for i := 0; i < 5; i++ {
+		if sd.Count() {
+			t.Fatalf("Count should never summarize with storm detection disabled")
+		}
+	}
+
+	//Executor exceeding the threshold alone starts summary mode at once
+	cfg.StormThreshold = 3
+	for i, expect := range []bool{false, false, true, true} {
+		if storming := sd.Count(); storming != expect {
+			t.Fatalf("Count %d expect [%v] but get [%v]", i+1, expect, storming)
+		}
+	}
+
+	for i := 0; i < maxStormSummaryEntries+10; i++ {
+		sd.AddSummary("email", "nfl-1", "en", notification.NotificationParam{})
+	}
+	sd.AddSummary("email", "nfl-1", "zh_cn", notification.NotificationParam{})
+	sd.AddSummary("webhook", "nfl-1", "en", notification.NotificationParam{})
+
+	summaries := sd.takeSummaries()
+	if len(summaries) != 3 || len(sd.summaries) != 0 {
+		t.Fatalf("takeSummaries expect 3 summaries but get %d, %d left", len(summaries), len(sd.summaries))
+	}
+	for _, summary := range summaries {
+		if summary.Notifier == "email" && summary.Language == "en" &&
+			(summary.Count != maxStormSummaryEntries+10 || len(summary.Entries) != maxStormSummaryEntries) {
+			t.Fatalf("AddSummary expect count [%d] with [%d] entries but get [%d %d]", maxStormSummaryEntries+10, maxStormSummaryEntries, summary.Count, len(summary.Entries))
+		}
+	}
+
+	//Summary failed to send is merged into the one collected meanwhile
+	failed := &StormSummary{Notifier: "email", NfAddressListId: "nfl-1", Language: "en", Count: 2, Entries: []notification.NotificationParam{{}, {}}}
+	sd.AddSummary("email", "nfl-1", "en", notification.NotificationParam{})
+	sd.restoreSummary(failed)
+	sd.restoreSummary(&StormSummary{Notifier: "webhook", NfAddressListId: "nfl-1", Language: "en", Count: 1})
+	summaries = sd.takeSummaries()
+	if len(summaries) != 2 || failed.Count != 3 || len(failed.Entries) != 3 {
+		t.Fatalf("restoreSummary expect 2 summaries and count [3] with [3] entries but get %d [%d %d]", len(summaries), failed.Count, len(failed.Entries))
+	}
+}
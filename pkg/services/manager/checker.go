@@ -40,7 +40,7 @@ func (s *Server) Checker(ctx context.Context, req interface{}) error {
 			Exec()
 	case *pb.CreatePolicyRequest:
 		return manager.NewChecker(ctx, r).
-			Required(models.PlColConfig, models.PlColCreator, models.PlColAvailableStartTime, models.PlColAvailableEndTime, models.PlColTypeId).
+			Required(models.PlColConfig, models.PlColCreator, models.PlColTypeId).
 			Exec()
 	case *pb.ModifyPolicyRequest:
 		return manager.NewChecker(ctx, r).
@@ -74,6 +74,38 @@ func (s *Server) Checker(ctx context.Context, req interface{}) error {
 		return manager.NewChecker(ctx, r).
 			Required(models.AcColId).
 			Exec()
+	case *pb.CreateInhibitRuleRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.IrColSourceRsTypeName, models.IrColTargetRsTypeName, models.IrColEqualLabel).
+			Exec()
+	case *pb.ModifyInhibitRuleRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.IrColId).
+			Exec()
+	case *pb.CreateSilenceRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.SlColCreator, models.SlColMatchers).
+			Exec()
+	case *pb.ModifySilenceRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.SlColId).
+			Exec()
+	case *pb.CreateTemplateRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.TpColLanguage, models.TpColTitle, models.TpColContent).
+			Exec()
+	case *pb.ModifyTemplateRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.TpColId).
+			Exec()
+	case *pb.AcknowledgeAlertRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.AlColId, models.RlColId, models.HsColResourceName, "acknowledger").
+			Exec()
+	case *pb.SnoozeAlertRequest:
+		return manager.NewChecker(ctx, r).
+			Required(models.AlColId, models.RlColId, models.HsColResourceName, "operator").
+			Exec()
 	}
 
 	return nil
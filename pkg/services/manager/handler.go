@@ -2,12 +2,15 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 
 	"kubesphere.io/alert/pkg/gerr"
 	"kubesphere.io/alert/pkg/logger"
 	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
 	. "kubesphere.io/alert/pkg/pb"
 	rs "kubesphere.io/alert/pkg/services/manager/resource_control"
+	"kubesphere.io/alert/pkg/util/pbutil"
 	"kubesphere.io/alert/pkg/util/stringutil"
 )
 
@@ -293,6 +296,9 @@ func (s *Server) CreatePolicy(ctx context.Context, req *CreatePolicyRequest) (*C
 		req.GetCreator(),
 		req.GetAvailableStartTime(),
 		req.GetAvailableEndTime(),
+		req.GetAvailableSchedule(),
+		req.GetGroupConfig(),
+		req.GetRouteConfig(),
 		req.GetRsTypeId(),
 		req.GetLanguage(),
 	)
@@ -372,6 +378,11 @@ func (s *Server) CreateRule(ctx context.Context, req *CreateRuleRequest) (*Creat
 		req.GetInhibit(),
 		req.GetPolicyId(),
 		req.GetMetricId(),
+		req.GetPredictHorizon(),
+		req.GetNodataState(),
+		req.GetRecoveryThresholds(),
+		req.GetConsecutiveClearCount(),
+		req.GetLevels(),
 	)
 
 	err = rs.CreateRule(ctx, rule)
@@ -400,12 +411,21 @@ func (s *Server) DescribeRules(ctx context.Context, req *DescribeRulesRequest) (
 }
 
 func (s *Server) ModifyRule(ctx context.Context, req *ModifyRuleRequest) (*ModifyRuleResponse, error) {
-	err := ValidateModifyRuleParams(ctx, req)
+	ruleId := req.GetRuleId()
+	rule, err := rs.GetRule(ctx, ruleId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	if rule == nil {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, ruleId)
+	}
+
+	err = ValidateModifyRuleParams(ctx, req, rule)
 	if err != nil {
 		return nil, err
 	}
 
-	ruleId, err := rs.ModifyRule(ctx, req)
+	ruleId, err = rs.ModifyRule(ctx, req)
 	if err != nil {
 		logger.Error(ctx, "Failed to Modify Rule[%s], [%+v].", ruleId, err)
 		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, ruleId)
@@ -605,6 +625,7 @@ func (s *Server) CreateAction(ctx context.Context, req *CreateActionRequest) (*C
 		req.GetTriggerAction(),
 		req.GetPolicyId(),
 		req.GetNfAddressListId(),
+		req.GetEscalationConfig(),
 	)
 
 	err = rs.CreateAction(ctx, action)
@@ -660,3 +681,330 @@ func (s *Server) DeleteActions(ctx context.Context, req *DeleteActionsRequest) (
 		ActionId: actionIds,
 	}, nil
 }
+
+//10.InhibitRule
+//********************************************************************************************************
+func (s *Server) CreateInhibitRule(ctx context.Context, req *CreateInhibitRuleRequest) (*CreateInhibitRuleResponse, error) {
+	err := ValidateCreateInhibitRuleParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	inhibitRule := models.NewInhibitRule(
+		req.GetInhibitRuleName(),
+		req.GetSourceRsTypeName(),
+		req.GetSourceSeverity(),
+		req.GetTargetRsTypeName(),
+		req.GetEqualLabel(),
+		req.GetDisabled(),
+	)
+
+	err = rs.CreateInhibitRule(ctx, inhibitRule)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(ctx, "Create InhibitRule[%s] in DB successfully.", inhibitRule.InhibitRuleId)
+
+	return &CreateInhibitRuleResponse{InhibitRuleId: inhibitRule.InhibitRuleId}, nil
+}
+
+func (s *Server) DescribeInhibitRules(ctx context.Context, req *DescribeInhibitRulesRequest) (*DescribeInhibitRulesResponse, error) {
+	irs, irCnt, err := rs.DescribeInhibitRules(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe InhibitRules, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	irPbSet := models.ParseIrSet2PbSet(irs)
+	res := &DescribeInhibitRulesResponse{
+		Total:          uint32(irCnt),
+		InhibitRuleSet: irPbSet,
+	}
+
+	logger.Debug(ctx, "Describe InhibitRules successfully, InhibitRules=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) ModifyInhibitRule(ctx context.Context, req *ModifyInhibitRuleRequest) (*ModifyInhibitRuleResponse, error) {
+	err := ValidateModifyInhibitRuleParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	inhibitRuleId, err := rs.ModifyInhibitRule(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Modify InhibitRule[%s], [%+v].", inhibitRuleId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, inhibitRuleId)
+	}
+	logger.Debug(ctx, "Modify InhibitRule[%s] successfully.", inhibitRuleId)
+	return &ModifyInhibitRuleResponse{
+		InhibitRuleId: inhibitRuleId,
+	}, nil
+}
+
+func (s *Server) DeleteInhibitRules(ctx context.Context, req *DeleteInhibitRulesRequest) (*DeleteInhibitRulesResponse, error) {
+	inhibitRuleIds, err := rs.DeleteInhibitRules(ctx, stringutil.SimplifyStringList(req.InhibitRuleId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Delete InhibitRules[%+v], [%+v].", inhibitRuleIds, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, inhibitRuleIds)
+	}
+	logger.Debug(ctx, "Delete InhibitRules[%+v] successfully.", inhibitRuleIds)
+	return &DeleteInhibitRulesResponse{
+		InhibitRuleId: inhibitRuleIds,
+	}, nil
+}
+
+//11.Silence
+//********************************************************************************************************
+func (s *Server) CreateSilence(ctx context.Context, req *CreateSilenceRequest) (*CreateSilenceResponse, error) {
+	err := ValidateCreateSilenceParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	silence := models.NewSilence(
+		req.GetCreator(),
+		req.GetComment(),
+		req.GetMatchers(),
+		pbutil.GetTime(req.GetStartTime()),
+		pbutil.FromProtoTimestamp(req.GetEndTime()),
+	)
+
+	err = rs.CreateSilence(ctx, silence)
+	if err != nil {
+		return nil, err
+	}
+	logger.Debug(ctx, "Create Silence[%s] in DB successfully.", silence.SilenceId)
+
+	return &CreateSilenceResponse{SilenceId: silence.SilenceId}, nil
+}
+
+func (s *Server) DescribeSilences(ctx context.Context, req *DescribeSilencesRequest) (*DescribeSilencesResponse, error) {
+	sls, slCnt, err := rs.DescribeSilences(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe Silences, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	slPbSet := models.ParseSlSet2PbSet(sls)
+	res := &DescribeSilencesResponse{
+		Total:      uint32(slCnt),
+		SilenceSet: slPbSet,
+	}
+
+	logger.Debug(ctx, "Describe Silences successfully, Silences=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) ModifySilence(ctx context.Context, req *ModifySilenceRequest) (*ModifySilenceResponse, error) {
+	err := ValidateModifySilenceParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	silenceId, err := rs.ModifySilence(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Modify Silence[%s], [%+v].", silenceId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, silenceId)
+	}
+	logger.Debug(ctx, "Modify Silence[%s] successfully.", silenceId)
+	return &ModifySilenceResponse{
+		SilenceId: silenceId,
+	}, nil
+}
+
+func (s *Server) DeleteSilences(ctx context.Context, req *DeleteSilencesRequest) (*DeleteSilencesResponse, error) {
+	silenceIds, err := rs.DeleteSilences(ctx, stringutil.SimplifyStringList(req.SilenceId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Delete Silences[%+v], [%+v].", silenceIds, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, silenceIds)
+	}
+	logger.Debug(ctx, "Delete Silences[%+v] successfully.", silenceIds)
+	return &DeleteSilencesResponse{
+		SilenceId: silenceIds,
+	}, nil
+}
+
+//12.Template
+//********************************************************************************************************
+func (s *Server) CreateTemplate(ctx context.Context, req *CreateTemplateRequest) (*CreateTemplateResponse, error) {
+	err := ValidateCreateTemplateParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	template := models.NewTemplate(
+		req.GetPolicyId(),
+		req.GetLanguage(),
+		req.GetFormat(),
+		req.GetTitle(),
+		req.GetContent(),
+	)
+	if template.Format == "" {
+		template.Format = notification.TemplateFormatText
+	}
+
+	err = rs.CreateTemplate(ctx, template)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorCreateResourcesFailed)
+	}
+	logger.Debug(ctx, "Create Template[%s] in DB successfully.", template.TemplateId)
+
+	return &CreateTemplateResponse{TemplateId: template.TemplateId}, nil
+}
+
+func (s *Server) DescribeTemplates(ctx context.Context, req *DescribeTemplatesRequest) (*DescribeTemplatesResponse, error) {
+	tps, tpCnt, err := rs.DescribeTemplates(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe Templates, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	tpPbSet := models.ParseTpSet2PbSet(tps)
+	res := &DescribeTemplatesResponse{
+		Total:       uint32(tpCnt),
+		TemplateSet: tpPbSet,
+	}
+
+	logger.Debug(ctx, "Describe Templates successfully, Templates=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) ModifyTemplate(ctx context.Context, req *ModifyTemplateRequest) (*ModifyTemplateResponse, error) {
+	templateId := req.GetTemplateId()
+	template, err := rs.GetTemplate(ctx, templateId)
+	if err != nil {
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	if template == nil {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, templateId)
+	}
+
+	err = ValidateModifyTemplateParams(ctx, req, template)
+	if err != nil {
+		return nil, err
+	}
+
+	templateId, err = rs.ModifyTemplate(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Modify Template[%s], [%+v].", templateId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, templateId)
+	}
+	logger.Debug(ctx, "Modify Template[%s] successfully.", templateId)
+	return &ModifyTemplateResponse{
+		TemplateId: templateId,
+	}, nil
+}
+
+func (s *Server) DeleteTemplates(ctx context.Context, req *DeleteTemplatesRequest) (*DeleteTemplatesResponse, error) {
+	templateIds, err := rs.DeleteTemplates(ctx, stringutil.SimplifyStringList(req.TemplateId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Delete Templates[%+v], [%+v].", templateIds, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDeleteResourceFailed, templateIds)
+	}
+	logger.Debug(ctx, "Delete Templates[%+v] successfully.", templateIds)
+	return &DeleteTemplatesResponse{
+		TemplateId: templateIds,
+	}, nil
+}
+
+//PreviewTemplate renders the template as executor does, falling back to built-in template of language if none is stored.
+func (s *Server) PreviewTemplate(ctx context.Context, req *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	err := ValidatePreviewTemplateParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	format, title, content := req.GetFormat(), req.GetTitle(), req.GetContent()
+	if title == "" && content == "" {
+		var template *models.Template
+		if req.GetTemplateId() != "" {
+			template, err = rs.GetTemplate(ctx, req.GetTemplateId())
+			if err == nil && template == nil {
+				return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, req.GetTemplateId())
+			}
+		} else {
+			template, err = rs.GetPolicyTemplate(ctx, req.GetPolicyId(), req.GetLanguage())
+		}
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+		}
+		if template != nil {
+			format, title, content = template.Format, template.Title, template.Content
+		}
+	}
+
+	tpl := notification.DefaultTemplate(req.GetLanguage())
+	if title != "" || content != "" {
+		tpl, err = notification.NewTemplate(format, title, content)
+		if err != nil {
+			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "template", title+"\n"+content)
+		}
+	}
+
+	notificationParam := notification.SampleNotificationParam()
+	if req.GetNotificationParam() != "" {
+		notificationParam = notification.NotificationParam{}
+		err = json.Unmarshal([]byte(req.GetNotificationParam()), &notificationParam)
+		if err != nil {
+			logger.Error(ctx, "Failed to parse notification param [%s], [%+v].", req.GetNotificationParam(), err)
+			return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "notification_param", req.GetNotificationParam())
+		}
+	}
+
+	email, err := tpl.Render(notificationParam, req.GetResume())
+	if err != nil {
+		logger.Error(ctx, "Failed to Preview Template, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorValidateFailed)
+	}
+
+	return &PreviewTemplateResponse{
+		Title:   email.Title,
+		Content: email.Content,
+	}, nil
+}
+
+//13.Outbox
+//********************************************************************************************************
+func (s *Server) DescribeOutboxes(ctx context.Context, req *DescribeOutboxesRequest) (*DescribeOutboxesResponse, error) {
+	obs, obCnt, err := rs.DescribeOutboxes(ctx, req)
+	if err != nil {
+		logger.Error(ctx, "Failed to Describe Outboxes, [%+v], [%+v].", req, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorDescribeResourcesFailed)
+	}
+	obPbSet := models.ParseObSet2PbSet(obs)
+	res := &DescribeOutboxesResponse{
+		Total:     uint32(obCnt),
+		OutboxSet: obPbSet,
+	}
+
+	logger.Debug(ctx, "Describe Outboxes successfully, Outboxes=[%+v].", res)
+	return res, nil
+}
+
+func (s *Server) RetryOutboxes(ctx context.Context, req *RetryOutboxesRequest) (*RetryOutboxesResponse, error) {
+	outboxIds, err := rs.RetryOutboxes(ctx, stringutil.SimplifyStringList(req.OutboxId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Retry Outboxes[%+v], [%+v].", req.OutboxId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, req.OutboxId)
+	}
+	if len(outboxIds) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, req.OutboxId)
+	}
+	logger.Debug(ctx, "Retry Outboxes[%+v] successfully.", outboxIds)
+	return &RetryOutboxesResponse{
+		OutboxId: outboxIds,
+	}, nil
+}
+
+func (s *Server) DiscardOutboxes(ctx context.Context, req *DiscardOutboxesRequest) (*DiscardOutboxesResponse, error) {
+	outboxIds, err := rs.DiscardOutboxes(ctx, stringutil.SimplifyStringList(req.OutboxId))
+	if err != nil {
+		logger.Error(ctx, "Failed to Discard Outboxes[%+v], [%+v].", req.OutboxId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, req.OutboxId)
+	}
+	if len(outboxIds) == 0 {
+		return nil, gerr.New(ctx, gerr.NotFound, gerr.ErrorResourceNotFound, req.OutboxId)
+	}
+	logger.Debug(ctx, "Discard Outboxes[%+v] successfully.", outboxIds)
+	return &DiscardOutboxesResponse{
+		OutboxId: outboxIds,
+	}, nil
+}
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
 
 	"kubesphere.io/alert/pkg/gerr"
 	"kubesphere.io/alert/pkg/logger"
@@ -60,6 +61,67 @@ func (s *Server) DescribeAlertStatus(ctx context.Context, req *DescribeAlertStat
 	return res, nil
 }
 
+func (s *Server) AcknowledgeAlert(ctx context.Context, req *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	err := ValidateAcknowledgeAlertParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ackInfo := models.AcknowledgeInfo{
+		RuleId:       req.GetRuleId(),
+		ResourceName: req.GetResourceName(),
+		Acknowledger: req.GetAcknowledger(),
+	}
+	ackInfoBytes, err := json.Marshal(ackInfo)
+	if err != nil {
+		logger.Error(ctx, "Marshal AcknowledgeInfo [%+v] to json failed", ackInfo)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, req.GetAlertId())
+	}
+
+	// Broadcast alert acknowledge to the executor running it.
+	alertId := req.GetAlertId()
+	operation := "acknowledging " + string(ackInfoBytes)
+	err = s.alertBroadcast.Broadcast(alertId, operation, 10)
+	if err != nil {
+		logger.Error(ctx, "Manager broadast alert %s[%s] into etcd failed, [%+v].", operation, alertId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, alertId)
+	}
+	logger.Debug(ctx, "Manager broadast alert %s[%s] into etcd successfully.", operation, alertId)
+
+	return &AcknowledgeAlertResponse{AlertId: alertId}, nil
+}
+
+func (s *Server) SnoozeAlert(ctx context.Context, req *SnoozeAlertRequest) (*SnoozeAlertResponse, error) {
+	err := ValidateSnoozeAlertParams(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	snoozeInfo := models.SnoozeInfo{
+		RuleId:        req.GetRuleId(),
+		ResourceName:  req.GetResourceName(),
+		SnoozeMinutes: req.GetSnoozeMinutes(),
+		Operator:      req.GetOperator(),
+	}
+	snoozeInfoBytes, err := json.Marshal(snoozeInfo)
+	if err != nil {
+		logger.Error(ctx, "Marshal SnoozeInfo [%+v] to json failed", snoozeInfo)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, req.GetAlertId())
+	}
+
+	// Broadcast alert snooze to the executor running it.
+	alertId := req.GetAlertId()
+	operation := "snoozing " + string(snoozeInfoBytes)
+	err = s.alertBroadcast.Broadcast(alertId, operation, 10)
+	if err != nil {
+		logger.Error(ctx, "Manager broadast alert %s[%s] into etcd failed, [%+v].", operation, alertId, err)
+		return nil, gerr.NewWithDetail(ctx, gerr.Internal, err, gerr.ErrorUpdateResourceFailed, alertId)
+	}
+	logger.Debug(ctx, "Manager broadast alert %s[%s] into etcd successfully.", operation, alertId)
+
+	return &SnoozeAlertResponse{AlertId: alertId}, nil
+}
+
 //1.History
 //********************************************************************************************************
 func (s *Server) DescribeHistoryDetail(ctx context.Context, req *DescribeHistoryDetailRequest) (*DescribeHistoryDetailResponse, error) {
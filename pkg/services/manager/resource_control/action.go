@@ -80,6 +80,11 @@ func ModifyAction(ctx context.Context, req *pb.ModifyActionRequest) (string, err
 	if req.NfAddressListId != "" {
 		attributes[models.AcColNfAddressListId] = req.NfAddressListId
 	}
+	if req.ClearEscalationConfig {
+		attributes[models.AcColEscalationConfig] = ""
+	} else if req.EscalationConfig != "" {
+		attributes[models.AcColEscalationConfig] = req.EscalationConfig
+	}
 
 	attributes[models.AcColUpdateTime] = time.Now()
 
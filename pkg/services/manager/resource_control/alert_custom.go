@@ -147,6 +147,22 @@ type MetricInfo struct {
 	MetricName string `gorm:"column:metric_name" json:"metric_name"`
 }
 
+//getActionsByPolicyId returns all actions of policy, the joined action of alert detail is only the first one.
+func getActionsByPolicyId(policyId string) []*models.Action {
+	var actions []*models.Action
+	err := global.GetInstance().GetDB().Table(models.TableAction).
+		Where(models.AcColPolicyId+" = ?", policyId).
+		Order(models.AcColCreateTime + " asc").
+		Find(&actions).
+		Error
+	if err != nil {
+		logger.Error(nil, "Failed to getActionsByPolicyId [%v], error: %+v.", policyId, err)
+		return nil
+	}
+
+	return actions
+}
+
 func getMetricsByAlertId(alertId string) []string {
 	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("alert t1").
 		Select("t3.metric_name").
@@ -187,7 +203,7 @@ func getMostRecentAlertTimeByAlertId(alertId string) string {
 		Select("t2.create_time").
 		Joins("left join history t2 on t2.alert_id=t1.alert_id"))
 
-	dbChain.DB = dbChain.DB.Where(`t1.alert_id in (?) and t2.event in ("triggered", "sent_success", "sent_failed")`, alertId)
+	dbChain.DB = dbChain.DB.Where(`t1.alert_id in (?) and t2.event in ("triggered", "queued", "sent_success", "sent_failed")`, alertId)
 
 	var mis []*MessageInfo
 
@@ -213,6 +229,7 @@ func getMostRecentAlertTimeByAlertId(alertId string) string {
 
 type StatusAlert struct {
 	ResourceStatus map[string]StatusResource `json:resource_status`
+	RuleStatus     map[string]StatusResource `json:"rule_status"`
 	UpdateTime     time.Time
 }
 
@@ -223,6 +240,10 @@ type StatusResource struct {
 	NextResendInterval uint32          `json:next_resend_interval`
 	NextSendableTime   time.Time       `json:next_sendable_time`
 	AggregatedAlerts   AggregatedAlert `json:aggregated_alerts`
+	Flapping           bool            `json:"flapping"`
+	Acknowledger       string          `json:"acknowledger"`
+	AcknowledgeTime    time.Time       `json:"acknowledge_time"`
+	SnoozeUntil        time.Time       `json:"snooze_until"`
 }
 
 type AggregatedAlert struct {
@@ -240,11 +261,11 @@ type RecordedMetric struct {
 
 func DescribeAlertDetails(ctx context.Context, req *pb.DescribeAlertDetailsRequest) ([]*models.AlertDetail, uint64, error) {
 	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table("alert t1").
-		Select("t1.alert_id,t1.alert_name,t1.disabled,t1.create_time,t1.running_status,t1.alert_status,t1.policy_id,t3.rs_filter_name,t3.rs_filter_param,t4.rs_type_name,t1.executor_id,t2.policy_name,t2.policy_description,t2.policy_config,t2.creator,t2.available_start_time,t2.available_end_time,t2.language,t5.nf_address_list_id").
+		Select("t1.alert_id,t1.alert_name,t1.disabled,t1.create_time,t1.running_status,t1.alert_status,t1.policy_id,t3.rs_filter_name,t3.rs_filter_param,t4.rs_type_name,t1.executor_id,t2.policy_name,t2.policy_description,t2.policy_config,t2.creator,t2.available_start_time,t2.available_end_time,t2.available_schedule,t2.group_config,t2.route_config,t2.language,t5.nf_address_list_id,t5.escalation_config").
 		Joins("left join policy t2 on t1.policy_id=t2.policy_id").
 		Joins("left join resource_filter t3 on t1.rs_filter_id=t3.rs_filter_id").
 		Joins("left join resource_type t4 on t3.rs_type_id=t4.rs_type_id").
-		Joins("left join action t5 on t5.policy_id=t1.policy_id"))
+		Joins("left join action t5 on t5.action_id=(select action_id from action where policy_id=t1.policy_id order by create_time limit 1)"))
 
 	offset := getOffset(req.Offset)
 	limit := getLimit(req.Limit)
@@ -273,6 +294,7 @@ func DescribeAlertDetails(ctx context.Context, req *pb.DescribeAlertDetailsReque
 
 	for _, ald := range alds {
 		ald.Metrics = getMetricsByAlertId(ald.AlertId)
+		ald.Actions = getActionsByPolicyId(ald.PolicyId)
 		alertStatus := StatusAlert{}
 		err := json.Unmarshal([]byte(ald.AlertStatus), &alertStatus)
 		if err == nil {
@@ -284,6 +306,11 @@ func DescribeAlertDetails(ctx context.Context, req *pb.DescribeAlertDetailsReque
 					ald.PositivesCount = ald.PositivesCount + 1
 				}
 			}
+			//Rule without any data is in nodata state
+			for range alertStatus.RuleStatus {
+				ald.RulesCount = ald.RulesCount + 1
+				ald.PositivesCount = ald.PositivesCount + 1
+			}
 		}
 		if ald.RulesCount > 0 {
 			ald.MostRecentAlertTime = getMostRecentAlertTimeByAlertId(ald.AlertId)
@@ -563,11 +590,21 @@ func DescribeAlertStatus(ctx context.Context, req *pb.DescribeAlertStatusRequest
 					resourceStatus := models.ResourceStatus{}
 					resourceStatus.ResourceName = alertid_resourcename[1]
 					resourceStatus.CurrentLevel = v.CurrentLevel
+					if v.Flapping {
+						resourceStatus.CurrentLevel = "flapping"
+					}
 					resourceStatus.PositiveCount = v.PositiveCount
 					resourceStatus.CumulatedSendCount = v.CumulatedSendCount
 					resourceStatus.NextResendInterval = v.NextResendInterval
 					resourceStatus.NextSendableTime = v.NextSendableTime.Format("2006-01-02 15:04:05.99999")
 					resourceStatus.AggregatedAlerts = fmt.Sprintf("%v", v.AggregatedAlerts)
+					resourceStatus.Acknowledger = v.Acknowledger
+					if v.Acknowledger != "" {
+						resourceStatus.AcknowledgeTime = v.AcknowledgeTime.Format("2006-01-02 15:04:05.99999")
+					}
+					if v.SnoozeUntil.After(time.Now()) {
+						resourceStatus.SnoozeUntil = v.SnoozeUntil.Format("2006-01-02 15:04:05.99999")
+					}
 					als_resource.Resources = append(als_resource.Resources, resourceStatus)
 				}
 			}
@@ -0,0 +1,112 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func CreateInhibitRule(ctx context.Context, inhibitRule *models.InhibitRule) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	err := tx.Create(&inhibitRule).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Insert InhibitRule failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func DescribeInhibitRules(ctx context.Context, req *pb.DescribeInhibitRulesRequest) ([]*models.InhibitRule, uint64, error) {
+	req.InhibitRuleId = stringutil.SimplifyStringList(req.InhibitRuleId)
+	req.InhibitRuleName = stringutil.SimplifyStringList(req.InhibitRuleName)
+	req.SourceRsTypeName = stringutil.SimplifyStringList(req.SourceRsTypeName)
+	req.TargetRsTypeName = stringutil.SimplifyStringList(req.TargetRsTypeName)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var irs []*models.InhibitRule
+	var count uint64
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableInhibitRule)).
+		AddQueryOrderDir(req, models.IrColCreateTime).
+		BuildFilterConditions(req, models.TableInhibitRule).
+		Offset(offset).
+		Limit(limit).
+		Find(&irs).Error; err != nil {
+		logger.Error(ctx, "Describe InhibitRules failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableInhibitRule)).
+		BuildFilterConditions(req, models.TableInhibitRule).
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe InhibitRules count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return irs, count, nil
+}
+
+func ModifyInhibitRule(ctx context.Context, req *pb.ModifyInhibitRuleRequest) (string, error) {
+	inhibitRuleId := req.InhibitRuleId
+
+	attributes := make(map[string]interface{})
+
+	if req.InhibitRuleName != "" {
+		attributes[models.IrColName] = req.InhibitRuleName
+	}
+	if req.SourceRsTypeName != "" {
+		attributes[models.IrColSourceRsTypeName] = req.SourceRsTypeName
+	}
+	if req.SourceSeverity != "" {
+		attributes[models.IrColSourceSeverity] = req.SourceSeverity
+	}
+	if req.TargetRsTypeName != "" {
+		attributes[models.IrColTargetRsTypeName] = req.TargetRsTypeName
+	}
+	if req.EqualLabel != "" {
+		attributes[models.IrColEqualLabel] = req.EqualLabel
+	}
+	attributes[models.IrColDisabled] = req.Disabled
+
+	attributes[models.IrColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var inhibitRule models.InhibitRule
+	err := tx.Model(&inhibitRule).Where(models.IrColId+" = ?", inhibitRuleId).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update InhibitRule [%s] failed: %+v", inhibitRuleId, err.Error)
+		return "", err.Error
+	}
+
+	tx.Commit()
+	return inhibitRuleId, nil
+}
+
+func DeleteInhibitRules(ctx context.Context, inhibitRuleIds []string) ([]string, error) {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var inhibitRule models.InhibitRule
+	err := tx.Model(&inhibitRule).Where(models.IrColId+" in (?)", inhibitRuleIds).Delete(models.InhibitRule{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Delete InhibitRules failed: %+v", err.Error)
+		return nil, err.Error
+	}
+	tx.Commit()
+	return inhibitRuleIds, nil
+}
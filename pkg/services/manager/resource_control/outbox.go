@@ -0,0 +1,95 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func DescribeOutboxes(ctx context.Context, req *pb.DescribeOutboxesRequest) ([]*models.Outbox, uint64, error) {
+	req.OutboxId = stringutil.SimplifyStringList(req.OutboxId)
+	req.AlertId = stringutil.SimplifyStringList(req.AlertId)
+	req.Status = stringutil.SimplifyStringList(req.Status)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var obs []*models.Outbox
+	var count uint64
+
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableOutbox)).
+		BuildFilterConditions(req, models.TableOutbox)
+
+	if err := aldb.GetChain(dbChain.DB).
+		AddQueryOrderDir(req, models.ObColCreateTime).
+		Offset(offset).
+		Limit(limit).
+		Find(&obs).Error; err != nil {
+		logger.Error(ctx, "Describe Outboxes failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := dbChain.
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe Outboxes count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return obs, count, nil
+}
+
+//updateOutboxes updates outboxes in one of status, outboxes in other status are left untouched.
+//updateOutboxes updates outboxes in one of status and returns ids of outboxes actually updated.
+func updateOutboxes(ctx context.Context, outboxIds []string, status []string, attributes map[string]interface{}) ([]string, error) {
+	attributes[models.ObColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var outbox models.Outbox
+	var updatedIds []string
+	err := tx.Set("gorm:query_option", "FOR UPDATE").Model(&outbox).Where(models.ObColId+" in (?) and "+models.ObColStatus+" in (?)", outboxIds, status).Pluck(models.ObColId, &updatedIds)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Query Outboxes [%+v] failed: %+v", outboxIds, err.Error)
+		return nil, err.Error
+	}
+
+	if len(updatedIds) == 0 {
+		tx.Rollback()
+		return updatedIds, nil
+	}
+
+	err = tx.Model(&outbox).Where(models.ObColId+" in (?)", updatedIds).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update Outboxes [%+v] failed: %+v", updatedIds, err.Error)
+		return nil, err.Error
+	}
+
+	tx.Commit()
+	return updatedIds, nil
+}
+
+func RetryOutboxes(ctx context.Context, outboxIds []string) ([]string, error) {
+	attributes := map[string]interface{}{
+		models.ObColStatus:          models.OutboxStatusPending,
+		models.ObColAttempts:        0,
+		models.ObColNextAttemptTime: time.Now(),
+	}
+	return updateOutboxes(ctx, outboxIds, []string{models.OutboxStatusPending, models.OutboxStatusDead, models.OutboxStatusDiscarded}, attributes)
+}
+
+func DiscardOutboxes(ctx context.Context, outboxIds []string) ([]string, error) {
+	attributes := map[string]interface{}{
+		models.ObColStatus: models.OutboxStatusDiscarded,
+	}
+	return updateOutboxes(ctx, outboxIds, []string{models.OutboxStatusPending, models.OutboxStatusDead}, attributes)
+}
@@ -82,6 +82,21 @@ func ModifyPolicy(ctx context.Context, req *pb.ModifyPolicyRequest) (string, err
 	if req.AvailableEndTime != "" {
 		attributes[models.PlColAvailableEndTime] = req.AvailableEndTime
 	}*/
+	if req.ClearAvailableSchedule {
+		attributes[models.PlColAvailableSchedule] = ""
+	} else if req.AvailableSchedule != "" {
+		attributes[models.PlColAvailableSchedule] = req.AvailableSchedule
+	}
+	if req.ClearGroupConfig {
+		attributes[models.PlColGroupConfig] = ""
+	} else if req.GroupConfig != "" {
+		attributes[models.PlColGroupConfig] = req.GroupConfig
+	}
+	if req.ClearRouteConfig {
+		attributes[models.PlColRouteConfig] = ""
+	} else if req.RouteConfig != "" {
+		attributes[models.PlColRouteConfig] = req.RouteConfig
+	}
 	if req.RsTypeId != "" {
 		attributes[models.PlColTypeId] = req.RsTypeId
 	}
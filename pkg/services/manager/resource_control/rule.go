@@ -63,6 +63,22 @@ func DescribeRules(ctx context.Context, req *pb.DescribeRulesRequest) ([]*models
 	return rss, count, nil
 }
 
+//GetRule returns rule of ruleId, nil if it does not exist.
+func GetRule(ctx context.Context, ruleId string) (*models.Rule, error) {
+	var rls []*models.Rule
+	err := global.GetInstance().GetDB().Table(models.TableRule).
+		Where(models.RlColId+" = ?", ruleId).
+		Find(&rls).Error
+	if err != nil {
+		logger.Error(ctx, "Get Rule [%s] failed: %+v", ruleId, err)
+		return nil, err
+	}
+	if len(rls) == 0 {
+		return nil, nil
+	}
+	return rls[0], nil
+}
+
 func ModifyRule(ctx context.Context, req *pb.ModifyRuleRequest) (string, error) {
 	ruleId := req.RuleId
 
@@ -90,6 +106,15 @@ func ModifyRule(ctx context.Context, req *pb.ModifyRuleRequest) (string, error)
 	}
 	attributes[models.RlColConsecutiveCount] = req.ConsecutiveCount
 	attributes[models.RlColInhibit] = req.Inhibit
+	if req.PredictHorizon != 0 {
+		attributes[models.RlColPredictHorizon] = req.PredictHorizon
+	}
+	if req.NodataState != "" {
+		attributes[models.RlColNodataState] = req.NodataState
+	}
+	attributes[models.RlColRecoveryThresholds] = req.RecoveryThresholds
+	attributes[models.RlColConsecutiveClearCount] = req.ConsecutiveClearCount
+	attributes[models.RlColLevels] = req.Levels
 
 	attributes[models.RlColUpdateTime] = time.Now()
 
@@ -0,0 +1,114 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func CreateSilence(ctx context.Context, silence *models.Silence) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	err := tx.Create(&silence).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Insert Silence failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func DescribeSilences(ctx context.Context, req *pb.DescribeSilencesRequest) ([]*models.Silence, uint64, error) {
+	req.SilenceId = stringutil.SimplifyStringList(req.SilenceId)
+	req.Creator = stringutil.SimplifyStringList(req.Creator)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var sls []*models.Silence
+	var count uint64
+
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableSilence)).
+		BuildFilterConditions(req, models.TableSilence)
+	if req.Active {
+		now := time.Now()
+		dbChain.DB = dbChain.DB.Where(models.SlColStartTime+" <= ? and "+models.SlColEndTime+" > ?", now, now)
+	}
+
+	if err := aldb.GetChain(dbChain.DB).
+		AddQueryOrderDir(req, models.SlColCreateTime).
+		Offset(offset).
+		Limit(limit).
+		Find(&sls).Error; err != nil {
+		logger.Error(ctx, "Describe Silences failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := dbChain.
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe Silences count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return sls, count, nil
+}
+
+func ModifySilence(ctx context.Context, req *pb.ModifySilenceRequest) (string, error) {
+	silenceId := req.SilenceId
+
+	attributes := make(map[string]interface{})
+
+	if req.Creator != "" {
+		attributes[models.SlColCreator] = req.Creator
+	}
+	if req.Comment != "" {
+		attributes[models.SlColComment] = req.Comment
+	}
+	if req.Matchers != "" {
+		attributes[models.SlColMatchers] = req.Matchers
+	}
+	if req.StartTime != nil {
+		attributes[models.SlColStartTime] = pbutil.FromProtoTimestamp(req.StartTime)
+	}
+	if req.EndTime != nil {
+		attributes[models.SlColEndTime] = pbutil.FromProtoTimestamp(req.EndTime)
+	}
+
+	attributes[models.SlColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var silence models.Silence
+	err := tx.Model(&silence).Where(models.SlColId+" = ?", silenceId).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update Silence [%s] failed: %+v", silenceId, err.Error)
+		return "", err.Error
+	}
+
+	tx.Commit()
+	return silenceId, nil
+}
+
+func DeleteSilences(ctx context.Context, silenceIds []string) ([]string, error) {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var silence models.Silence
+	err := tx.Model(&silence).Where(models.SlColId+" in (?)", silenceIds).Delete(models.Silence{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Delete Silences failed: %+v", err.Error)
+		return nil, err.Error
+	}
+	tx.Commit()
+	return silenceIds, nil
+}
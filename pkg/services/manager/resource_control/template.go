@@ -0,0 +1,139 @@
+package resource_control
+
+import (
+	"context"
+	"time"
+
+	aldb "kubesphere.io/alert/pkg/db"
+	"kubesphere.io/alert/pkg/global"
+	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
+	"kubesphere.io/alert/pkg/util/stringutil"
+)
+
+func CreateTemplate(ctx context.Context, template *models.Template) error {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	err := tx.Create(&template).Error
+	if err != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Insert Template failed, [%+v]", err)
+		return err
+	}
+	tx.Commit()
+	return nil
+}
+
+func DescribeTemplates(ctx context.Context, req *pb.DescribeTemplatesRequest) ([]*models.Template, uint64, error) {
+	req.TemplateId = stringutil.SimplifyStringList(req.TemplateId)
+	req.PolicyId = stringutil.SimplifyStringList(req.PolicyId)
+	req.Language = stringutil.SimplifyStringList(req.Language)
+
+	offset := pbutil.GetOffsetFromRequest(req)
+	limit := pbutil.GetLimitFromRequest(req)
+
+	var tps []*models.Template
+	var count uint64
+
+	dbChain := aldb.GetChain(global.GetInstance().GetDB().Table(models.TableTemplate)).
+		BuildFilterConditions(req, models.TableTemplate)
+
+	if err := aldb.GetChain(dbChain.DB).
+		AddQueryOrderDir(req, models.TpColCreateTime).
+		Offset(offset).
+		Limit(limit).
+		Find(&tps).Error; err != nil {
+		logger.Error(ctx, "Describe Templates failed: %+v", err)
+		return nil, 0, err
+	}
+
+	if err := dbChain.
+		Count(&count).Error; err != nil {
+		logger.Error(ctx, "Describe Templates count failed: %+v", err)
+		return nil, 0, err
+	}
+
+	return tps, count, nil
+}
+
+//GetTemplate returns nil if template does not exist.
+func GetTemplate(ctx context.Context, templateId string) (*models.Template, error) {
+	var tps []*models.Template
+	err := global.GetInstance().GetDB().Table(models.TableTemplate).
+		Where(models.TpColId+" = ?", templateId).
+		Find(&tps).Error
+	if err != nil {
+		logger.Error(ctx, "Get Template [%s] failed: %+v", templateId, err)
+		return nil, err
+	}
+	if len(tps) == 0 {
+		return nil, nil
+	}
+	return tps[0], nil
+}
+
+//GetPolicyTemplate returns template of policy in language, or template of all policies if policy has none, nil if neither exists.
+func GetPolicyTemplate(ctx context.Context, policyId string, language string) (*models.Template, error) {
+	var tps []*models.Template
+	err := global.GetInstance().GetDB().Table(models.TableTemplate).
+		Where(models.TpColPolicyId+" in (?, '') and "+models.TpColLanguage+" = ?", policyId, language).
+		Order(models.TpColPolicyId + " desc").
+		Limit(1).
+		Find(&tps).Error
+	if err != nil {
+		logger.Error(ctx, "Get Template of Policy [%s] Language [%s] failed: %+v", policyId, language, err)
+		return nil, err
+	}
+	if len(tps) == 0 {
+		return nil, nil
+	}
+	return tps[0], nil
+}
+
+func ModifyTemplate(ctx context.Context, req *pb.ModifyTemplateRequest) (string, error) {
+	templateId := req.TemplateId
+
+	attributes := make(map[string]interface{})
+
+	if req.Format != "" {
+		attributes[models.TpColFormat] = req.Format
+	}
+	if req.Title != "" {
+		attributes[models.TpColTitle] = req.Title
+	}
+	if req.Content != "" {
+		attributes[models.TpColContent] = req.Content
+	}
+
+	attributes[models.TpColUpdateTime] = time.Now()
+
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+
+	var template models.Template
+	err := tx.Model(&template).Where(models.TpColId+" = ?", templateId).Updates(attributes)
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Update Template [%s] failed: %+v", templateId, err.Error)
+		return "", err.Error
+	}
+
+	tx.Commit()
+	return templateId, nil
+}
+
+func DeleteTemplates(ctx context.Context, templateIds []string) ([]string, error) {
+	db := global.GetInstance().GetDB()
+	tx := db.Begin()
+	var template models.Template
+	err := tx.Model(&template).Where(models.TpColId+" in (?)", templateIds).Delete(models.Template{})
+	if err.Error != nil {
+		tx.Rollback()
+		logger.Error(ctx, "Delete Templates failed: %+v", err.Error)
+		return nil, err.Error
+	}
+	tx.Commit()
+	return templateIds, nil
+}
@@ -6,11 +6,17 @@ package manager
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
 	"time"
 
 	"kubesphere.io/alert/pkg/gerr"
 	"kubesphere.io/alert/pkg/logger"
+	"kubesphere.io/alert/pkg/metric"
+	"kubesphere.io/alert/pkg/models"
+	"kubesphere.io/alert/pkg/notification"
 	"kubesphere.io/alert/pkg/pb"
+	"kubesphere.io/alert/pkg/util/pbutil"
 )
 
 func checkStringLen(ctx context.Context, str string, length int) error {
@@ -33,6 +39,191 @@ func checkTimeFormat(ctx context.Context, timeStr string) error {
 	}
 }
 
+func checkConditionFormat(ctx context.Context, conditionType string) error {
+	_, err := models.ParseRuleCondition(conditionType)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorIllegalConditionFormat, conditionType)
+	}
+}
+
+func checkMetricsType(ctx context.Context, metricsType string) error {
+	if metric.IsAggregateSupported(metricsType) {
+		return nil
+	} else {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "metrics_type", metricsType)
+	}
+}
+
+func checkNodataState(ctx context.Context, nodataState string) error {
+	switch nodataState {
+	case "", models.RuleNodataStateNodata, models.RuleNodataStateKeepLast, models.RuleNodataStateOk:
+		return nil
+	default:
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "nodata_state", nodataState)
+	}
+}
+
+func checkRecoveryThresholds(ctx context.Context, recoveryThresholds string) error {
+	if recoveryThresholds == "" {
+		return nil
+	}
+
+	_, err := strconv.ParseFloat(recoveryThresholds, 64)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "recovery_thresholds", recoveryThresholds)
+	}
+}
+
+func checkRuleLevels(ctx context.Context, conditionType string, severity string, thresholds string, ruleLevels []models.RuleLevel) error {
+	err := models.CheckRuleLevels(conditionType, severity, thresholds, ruleLevels)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "levels", ruleLevels)
+	}
+}
+
+func checkSilenceMatchers(ctx context.Context, matchers string) error {
+	_, err := models.ParseSilenceMatchers(matchers)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "matchers", matchers)
+	}
+}
+
+func checkPolicySchedule(ctx context.Context, schedule string) error {
+	_, err := models.ParsePolicySchedule(schedule)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "available_schedule", schedule)
+	}
+}
+
+func checkPolicyConfig(ctx context.Context, policyConfig string) error {
+	_, err := models.ParsePolicyConfig(policyConfig)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "policy_config", policyConfig)
+	}
+}
+
+func checkPolicyRouteConfig(ctx context.Context, routeConfig string) error {
+	_, err := models.ParsePolicyRoute(routeConfig)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "route_config", routeConfig)
+	}
+}
+
+func checkTriggerStatus(ctx context.Context, triggerStatus string) error {
+	_, err := models.ParseTriggerStatus(triggerStatus)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "trigger_status", triggerStatus)
+	}
+}
+
+func checkTriggerAction(ctx context.Context, triggerAction string) error {
+	if models.IsTriggerActionSupported(triggerAction) {
+		return nil
+	} else {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "trigger_action", triggerAction)
+	}
+}
+
+func checkReceiverAddress(ctx context.Context, triggerAction string, nfAddressListId string) error {
+	err := models.CheckReceiverAddress(triggerAction, nfAddressListId)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "nf_address_list_id", nfAddressListId)
+	}
+}
+
+func checkTemplateFormat(ctx context.Context, format string) error {
+	switch format {
+	case "", notification.TemplateFormatText, notification.TemplateFormatHtml:
+		return nil
+	default:
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "format", format)
+	}
+}
+
+func checkTemplate(ctx context.Context, format string, title string, content string) error {
+	err := notification.CheckTemplate(format, title, content)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "template", title+"\n"+content)
+	}
+}
+
+func checkNotificationParam(ctx context.Context, notificationParam string) error {
+	err := json.Unmarshal([]byte(notificationParam), &notification.NotificationParam{})
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "notification_param", notificationParam)
+	}
+}
+
+func checkEscalationConfig(ctx context.Context, escalationConfig string) error {
+	_, err := models.ParseEscalationConfig(escalationConfig)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "escalation_config", escalationConfig)
+	}
+}
+
+func checkPolicyGroupConfig(ctx context.Context, groupConfig string) error {
+	_, err := models.ParsePolicyGroupConfig(groupConfig)
+
+	if err == nil {
+		return nil
+	} else {
+		return gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "group_config", groupConfig)
+	}
+}
+
+func checkTimeRange(ctx context.Context, startTime time.Time, endTime time.Time) error {
+	if endTime.After(startTime) {
+		return nil
+	} else {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorIllegalTimeRange, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+	}
+}
+
+func checkSnoozeMinutes(ctx context.Context, snoozeMinutes uint32) error {
+	//Snooze is for a short break, use silence for longer maintenance
+	if snoozeMinutes <= 7*24*60 {
+		return nil
+	} else {
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "snooze_minutes", strconv.FormatUint(uint64(snoozeMinutes), 10))
+	}
+}
+
 func ValidateCreateResourceTypeParams(ctx context.Context, req *pb.CreateResourceTypeRequest) error {
 	rsTypeName := req.GetRsTypeName()
 	err := checkStringLen(ctx, rsTypeName, 50)
@@ -156,18 +347,60 @@ func ValidateCreatePolicyParams(ctx context.Context, req *pb.CreatePolicyRequest
 		return err
 	}
 
+	//Empty policy config uses the default repeat strategies in executor
+	policyConfig := req.GetPolicyConfig()
+	if policyConfig != "" {
+		err = checkPolicyConfig(ctx, policyConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate PolicyConfig [%s]: %+v", policyConfig, err)
+			return err
+		}
+	}
+
+	//Available start and end time are only required without schedule
+	availableSchedule := req.GetAvailableSchedule()
+	if availableSchedule != "" {
+		err = checkPolicySchedule(ctx, availableSchedule)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate AvailableSchedule [%s]: %+v", availableSchedule, err)
+			return err
+		}
+	}
+
+	groupConfig := req.GetGroupConfig()
+	if groupConfig != "" {
+		err = checkPolicyGroupConfig(ctx, groupConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate GroupConfig [%s]: %+v", groupConfig, err)
+			return err
+		}
+	}
+
+	routeConfig := req.GetRouteConfig()
+	if routeConfig != "" {
+		err = checkPolicyRouteConfig(ctx, routeConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate RouteConfig [%s]: %+v", routeConfig, err)
+			return err
+		}
+	}
+
 	availableStartTime := req.GetAvailableStartTime()
-	err = checkTimeFormat(ctx, availableStartTime)
-	if err != nil {
-		logger.Error(ctx, "Failed to validate AvailableStartTime [%s]: %+v", availableStartTime, err)
-		return err
+	if availableSchedule == "" || availableStartTime != "" {
+		err = checkTimeFormat(ctx, availableStartTime)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate AvailableStartTime [%s]: %+v", availableStartTime, err)
+			return err
+		}
 	}
 
 	availableEndTime := req.GetAvailableEndTime()
-	err = checkTimeFormat(ctx, availableEndTime)
-	if err != nil {
-		logger.Error(ctx, "Failed to validate AvailableEndTime [%s]: %+v", availableEndTime, err)
-		return err
+	if availableSchedule == "" || availableEndTime != "" {
+		err = checkTimeFormat(ctx, availableEndTime)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate AvailableEndTime [%s]: %+v", availableEndTime, err)
+			return err
+		}
 	}
 
 	rsTypeId := req.GetRsTypeId()
@@ -230,6 +463,54 @@ func ValidateModifyPolicyParams(ctx context.Context, req *pb.ModifyPolicyRequest
 		return err
 	}*/
 
+	policyConfig := req.GetPolicyConfig()
+	if policyConfig != "" {
+		err = checkPolicyConfig(ctx, policyConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate PolicyConfig [%s]: %+v", policyConfig, err)
+			return err
+		}
+	}
+
+	availableSchedule := req.GetAvailableSchedule()
+	if req.GetClearAvailableSchedule() && availableSchedule != "" {
+		logger.Error(ctx, "Failed to validate AvailableSchedule [%s]: schedule can not be cleared and set at the same time", availableSchedule)
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "available_schedule", availableSchedule)
+	}
+	if availableSchedule != "" {
+		err = checkPolicySchedule(ctx, availableSchedule)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate AvailableSchedule [%s]: %+v", availableSchedule, err)
+			return err
+		}
+	}
+
+	groupConfig := req.GetGroupConfig()
+	if req.GetClearGroupConfig() && groupConfig != "" {
+		logger.Error(ctx, "Failed to validate GroupConfig [%s]: config can not be cleared and set at the same time", groupConfig)
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "group_config", groupConfig)
+	}
+	if groupConfig != "" {
+		err = checkPolicyGroupConfig(ctx, groupConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate GroupConfig [%s]: %+v", groupConfig, err)
+			return err
+		}
+	}
+
+	routeConfig := req.GetRouteConfig()
+	if req.GetClearRouteConfig() && routeConfig != "" {
+		logger.Error(ctx, "Failed to validate RouteConfig [%s]: config can not be cleared and set at the same time", routeConfig)
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "route_config", routeConfig)
+	}
+	if routeConfig != "" {
+		err = checkPolicyRouteConfig(ctx, routeConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate RouteConfig [%s]: %+v", routeConfig, err)
+			return err
+		}
+	}
+
 	rsTypeId := req.GetRsTypeId()
 	err = checkStringLen(ctx, rsTypeId, 50)
 	if err != nil {
@@ -263,16 +544,65 @@ func ValidateCreateRuleParams(ctx context.Context, req *pb.CreateRuleRequest) er
 	}
 
 	metricsType := req.GetMetricsType()
-	err = checkStringLen(ctx, metricsType, 10)
+	err = checkStringLen(ctx, metricsType, 20)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", metricsType, err)
 		return err
 	}
+	err = checkMetricsType(ctx, metricsType)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", metricsType, err)
+		return err
+	}
+
+	predictHorizon := req.GetPredictHorizon()
+	if metricsType == metric.AggregatePredictLinear && predictHorizon == 0 {
+		err = gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "predict_horizon")
+		logger.Error(ctx, "Failed to validate PredictHorizon [%d]: %+v", predictHorizon, err)
+		return err
+	}
+
+	nodataState := req.GetNodataState()
+	err = checkNodataState(ctx, nodataState)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate NodataState [%s]: %+v", nodataState, err)
+		return err
+	}
+
+	recoveryThresholds := req.GetRecoveryThresholds()
+	err = checkStringLen(ctx, recoveryThresholds, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+	err = checkRecoveryThresholds(ctx, recoveryThresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	levels := req.GetLevels()
+	err = checkStringLen(ctx, levels, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+	ruleLevels, err := models.ParseRuleLevels(levels)
+	if err != nil {
+		err = gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "levels", levels)
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
 
 	conditionType := req.GetConditionType()
-	err = checkStringLen(ctx, conditionType, 10)
+	err = checkStringLen(ctx, conditionType, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ConditionType [%s]: %+v", conditionType, err)
+		return err
+	}
+	err = checkConditionFormat(ctx, conditionType)
 	if err != nil {
-		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", conditionType, err)
+		logger.Error(ctx, "Failed to validate ConditionType [%s]: %+v", conditionType, err)
 		return err
 	}
 
@@ -283,6 +613,12 @@ func ValidateCreateRuleParams(ctx context.Context, req *pb.CreateRuleRequest) er
 		return err
 	}
 
+	err = checkRuleLevels(ctx, conditionType, severity, thresholds, ruleLevels)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
 	unit := req.GetUnit()
 	err = checkStringLen(ctx, unit, 50)
 	if err != nil {
@@ -307,7 +643,7 @@ func ValidateCreateRuleParams(ctx context.Context, req *pb.CreateRuleRequest) er
 	return nil
 }
 
-func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest) error {
+func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest, rule *models.Rule) error {
 	ruleId := req.GetRuleId()
 	err := checkStringLen(ctx, ruleId, 50)
 	if err != nil {
@@ -330,18 +666,69 @@ func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest) er
 	}
 
 	metricsType := req.GetMetricsType()
-	err = checkStringLen(ctx, metricsType, 10)
+	err = checkStringLen(ctx, metricsType, 20)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", metricsType, err)
+		return err
+	}
+	err = checkMetricsType(ctx, metricsType)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", metricsType, err)
 		return err
 	}
 
+	predictHorizon := req.GetPredictHorizon()
+	if metricsType == metric.AggregatePredictLinear && predictHorizon == 0 {
+		err = gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, "predict_horizon")
+		logger.Error(ctx, "Failed to validate PredictHorizon [%d]: %+v", predictHorizon, err)
+		return err
+	}
+
+	nodataState := req.GetNodataState()
+	err = checkNodataState(ctx, nodataState)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate NodataState [%s]: %+v", nodataState, err)
+		return err
+	}
+
+	recoveryThresholds := req.GetRecoveryThresholds()
+	err = checkStringLen(ctx, recoveryThresholds, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+	err = checkRecoveryThresholds(ctx, recoveryThresholds)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RecoveryThresholds [%s]: %+v", recoveryThresholds, err)
+		return err
+	}
+
+	levels := req.GetLevels()
+	err = checkStringLen(ctx, levels, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+	ruleLevels, err := models.ParseRuleLevels(levels)
+	if err != nil {
+		err = gerr.NewWithDetail(ctx, gerr.InvalidArgument, err, gerr.ErrorUnsupportedParameterValue, "levels", levels)
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
 	conditionType := req.GetConditionType()
-	err = checkStringLen(ctx, conditionType, 10)
+	err = checkStringLen(ctx, conditionType, 255)
 	if err != nil {
-		logger.Error(ctx, "Failed to validate MetricsType [%s]: %+v", conditionType, err)
+		logger.Error(ctx, "Failed to validate ConditionType [%s]: %+v", conditionType, err)
 		return err
 	}
+	if conditionType != "" {
+		err = checkConditionFormat(ctx, conditionType)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate ConditionType [%s]: %+v", conditionType, err)
+			return err
+		}
+	}
 
 	thresholds := req.GetThresholds()
 	err = checkStringLen(ctx, thresholds, 50)
@@ -350,6 +737,22 @@ func ValidateModifyRuleParams(ctx context.Context, req *pb.ModifyRuleRequest) er
 		return err
 	}
 
+	//Levels are checked against rule merged with modification
+	if conditionType == "" {
+		conditionType = rule.ConditionType
+	}
+	if severity == "" {
+		severity = rule.Severity
+	}
+	if thresholds == "" {
+		thresholds = rule.Thresholds
+	}
+	err = checkRuleLevels(ctx, conditionType, severity, thresholds, ruleLevels)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Levels [%s]: %+v", levels, err)
+		return err
+	}
+
 	unit := req.GetUnit()
 	err = checkStringLen(ctx, unit, 50)
 	if err != nil {
@@ -457,6 +860,12 @@ func ValidateCreateActionParams(ctx context.Context, req *pb.CreateActionRequest
 		return err
 	}
 
+	err = checkTriggerStatus(ctx, triggerStatus)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TriggerStatus [%s]: %+v", triggerStatus, err)
+		return err
+	}
+
 	triggerAction := req.GetTriggerAction()
 	err = checkStringLen(ctx, triggerAction, 255)
 	if err != nil {
@@ -464,6 +873,12 @@ func ValidateCreateActionParams(ctx context.Context, req *pb.CreateActionRequest
 		return err
 	}
 
+	err = checkTriggerAction(ctx, triggerAction)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TriggerAction [%s]: %+v", triggerAction, err)
+		return err
+	}
+
 	policyId := req.GetPolicyId()
 	err = checkStringLen(ctx, policyId, 50)
 	if err != nil {
@@ -472,12 +887,29 @@ func ValidateCreateActionParams(ctx context.Context, req *pb.CreateActionRequest
 	}
 
 	nfAddressListId := req.GetNfAddressListId()
-	err = checkStringLen(ctx, nfAddressListId, 50)
+	err = checkStringLen(ctx, nfAddressListId, 1000)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate NfAddressListId [%s]: %+v", nfAddressListId, err)
 		return err
 	}
 
+	if nfAddressListId != "" {
+		err = checkReceiverAddress(ctx, triggerAction, nfAddressListId)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate NfAddressListId [%s]: %+v", nfAddressListId, err)
+			return err
+		}
+	}
+
+	escalationConfig := req.GetEscalationConfig()
+	if escalationConfig != "" {
+		err = checkEscalationConfig(ctx, escalationConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate EscalationConfig [%s]: %+v", escalationConfig, err)
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -503,6 +935,12 @@ func ValidateModifyActionParams(ctx context.Context, req *pb.ModifyActionRequest
 		return err
 	}
 
+	err = checkTriggerStatus(ctx, triggerStatus)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TriggerStatus [%s]: %+v", triggerStatus, err)
+		return err
+	}
+
 	triggerAction := req.GetTriggerAction()
 	err = checkStringLen(ctx, triggerAction, 255)
 	if err != nil {
@@ -510,6 +948,12 @@ func ValidateModifyActionParams(ctx context.Context, req *pb.ModifyActionRequest
 		return err
 	}
 
+	err = checkTriggerAction(ctx, triggerAction)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TriggerAction [%s]: %+v", triggerAction, err)
+		return err
+	}
+
 	policyId := req.GetPolicyId()
 	err = checkStringLen(ctx, policyId, 50)
 	if err != nil {
@@ -518,11 +962,360 @@ func ValidateModifyActionParams(ctx context.Context, req *pb.ModifyActionRequest
 	}
 
 	nfAddressListId := req.GetNfAddressListId()
-	err = checkStringLen(ctx, nfAddressListId, 50)
+	err = checkStringLen(ctx, nfAddressListId, 1000)
 	if err != nil {
 		logger.Error(ctx, "Failed to validate NfAddressListId [%s]: %+v", nfAddressListId, err)
 		return err
 	}
 
+	if nfAddressListId != "" {
+		err = checkReceiverAddress(ctx, triggerAction, nfAddressListId)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate NfAddressListId [%s]: %+v", nfAddressListId, err)
+			return err
+		}
+	}
+
+	escalationConfig := req.GetEscalationConfig()
+	if req.GetClearEscalationConfig() && escalationConfig != "" {
+		logger.Error(ctx, "Failed to validate EscalationConfig [%s]: config can not be cleared and set at the same time", escalationConfig)
+		return gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorUnsupportedParameterValue, "escalation_config", escalationConfig)
+	}
+	if escalationConfig != "" {
+		err = checkEscalationConfig(ctx, escalationConfig)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate EscalationConfig [%s]: %+v", escalationConfig, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateCreateInhibitRuleParams(ctx context.Context, req *pb.CreateInhibitRuleRequest) error {
+	inhibitRuleName := req.GetInhibitRuleName()
+	err := checkStringLen(ctx, inhibitRuleName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate InhibitRuleName [%s]: %+v", inhibitRuleName, err)
+		return err
+	}
+
+	sourceRsTypeName := req.GetSourceRsTypeName()
+	err = checkStringLen(ctx, sourceRsTypeName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SourceRsTypeName [%s]: %+v", sourceRsTypeName, err)
+		return err
+	}
+
+	sourceSeverity := req.GetSourceSeverity()
+	err = checkStringLen(ctx, sourceSeverity, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SourceSeverity [%s]: %+v", sourceSeverity, err)
+		return err
+	}
+
+	targetRsTypeName := req.GetTargetRsTypeName()
+	err = checkStringLen(ctx, targetRsTypeName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TargetRsTypeName [%s]: %+v", targetRsTypeName, err)
+		return err
+	}
+
+	equalLabel := req.GetEqualLabel()
+	err = checkStringLen(ctx, equalLabel, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate EqualLabel [%s]: %+v", equalLabel, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateModifyInhibitRuleParams(ctx context.Context, req *pb.ModifyInhibitRuleRequest) error {
+	inhibitRuleId := req.GetInhibitRuleId()
+	err := checkStringLen(ctx, inhibitRuleId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate InhibitRuleId [%s]: %+v", inhibitRuleId, err)
+		return err
+	}
+
+	inhibitRuleName := req.GetInhibitRuleName()
+	err = checkStringLen(ctx, inhibitRuleName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate InhibitRuleName [%s]: %+v", inhibitRuleName, err)
+		return err
+	}
+
+	sourceRsTypeName := req.GetSourceRsTypeName()
+	err = checkStringLen(ctx, sourceRsTypeName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SourceRsTypeName [%s]: %+v", sourceRsTypeName, err)
+		return err
+	}
+
+	sourceSeverity := req.GetSourceSeverity()
+	err = checkStringLen(ctx, sourceSeverity, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SourceSeverity [%s]: %+v", sourceSeverity, err)
+		return err
+	}
+
+	targetRsTypeName := req.GetTargetRsTypeName()
+	err = checkStringLen(ctx, targetRsTypeName, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TargetRsTypeName [%s]: %+v", targetRsTypeName, err)
+		return err
+	}
+
+	equalLabel := req.GetEqualLabel()
+	err = checkStringLen(ctx, equalLabel, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate EqualLabel [%s]: %+v", equalLabel, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateCreateSilenceParams(ctx context.Context, req *pb.CreateSilenceRequest) error {
+	creator := req.GetCreator()
+	err := checkStringLen(ctx, creator, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Creator [%s]: %+v", creator, err)
+		return err
+	}
+
+	comment := req.GetComment()
+	err = checkStringLen(ctx, comment, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Comment [%s]: %+v", comment, err)
+		return err
+	}
+
+	matchers := req.GetMatchers()
+	err = checkSilenceMatchers(ctx, matchers)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Matchers [%s]: %+v", matchers, err)
+		return err
+	}
+
+	if req.GetEndTime() == nil {
+		err = gerr.New(ctx, gerr.InvalidArgument, gerr.ErrorMissingParameter, models.SlColEndTime)
+		logger.Error(ctx, "Failed to validate EndTime: %+v", err)
+		return err
+	}
+
+	startTime := pbutil.GetTime(req.GetStartTime())
+	endTime := pbutil.FromProtoTimestamp(req.GetEndTime())
+	err = checkTimeRange(ctx, startTime, endTime)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate StartTime [%v] EndTime [%v]: %+v", startTime, endTime, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateModifySilenceParams(ctx context.Context, req *pb.ModifySilenceRequest) error {
+	silenceId := req.GetSilenceId()
+	err := checkStringLen(ctx, silenceId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SilenceId [%s]: %+v", silenceId, err)
+		return err
+	}
+
+	creator := req.GetCreator()
+	err = checkStringLen(ctx, creator, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Creator [%s]: %+v", creator, err)
+		return err
+	}
+
+	comment := req.GetComment()
+	err = checkStringLen(ctx, comment, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Comment [%s]: %+v", comment, err)
+		return err
+	}
+
+	matchers := req.GetMatchers()
+	if matchers != "" {
+		err = checkSilenceMatchers(ctx, matchers)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate Matchers [%s]: %+v", matchers, err)
+			return err
+		}
+	}
+
+	if req.GetStartTime() != nil && req.GetEndTime() != nil {
+		startTime := pbutil.FromProtoTimestamp(req.GetStartTime())
+		endTime := pbutil.FromProtoTimestamp(req.GetEndTime())
+		err = checkTimeRange(ctx, startTime, endTime)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate StartTime [%v] EndTime [%v]: %+v", startTime, endTime, err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func ValidateAcknowledgeAlertParams(ctx context.Context, req *pb.AcknowledgeAlertRequest) error {
+	alertId := req.GetAlertId()
+	err := checkStringLen(ctx, alertId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate AlertId [%s]: %+v", alertId, err)
+		return err
+	}
+
+	ruleId := req.GetRuleId()
+	err = checkStringLen(ctx, ruleId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RuleId [%s]: %+v", ruleId, err)
+		return err
+	}
+
+	resourceName := req.GetResourceName()
+	err = checkStringLen(ctx, resourceName, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ResourceName [%s]: %+v", resourceName, err)
+		return err
+	}
+
+	acknowledger := req.GetAcknowledger()
+	err = checkStringLen(ctx, acknowledger, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Acknowledger [%s]: %+v", acknowledger, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateSnoozeAlertParams(ctx context.Context, req *pb.SnoozeAlertRequest) error {
+	alertId := req.GetAlertId()
+	err := checkStringLen(ctx, alertId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate AlertId [%s]: %+v", alertId, err)
+		return err
+	}
+
+	ruleId := req.GetRuleId()
+	err = checkStringLen(ctx, ruleId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate RuleId [%s]: %+v", ruleId, err)
+		return err
+	}
+
+	resourceName := req.GetResourceName()
+	err = checkStringLen(ctx, resourceName, 255)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate ResourceName [%s]: %+v", resourceName, err)
+		return err
+	}
+
+	snoozeMinutes := req.GetSnoozeMinutes()
+	err = checkSnoozeMinutes(ctx, snoozeMinutes)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate SnoozeMinutes [%d]: %+v", snoozeMinutes, err)
+		return err
+	}
+
+	operator := req.GetOperator()
+	err = checkStringLen(ctx, operator, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Operator [%s]: %+v", operator, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidateCreateTemplateParams(ctx context.Context, req *pb.CreateTemplateRequest) error {
+	policyId := req.GetPolicyId()
+	err := checkStringLen(ctx, policyId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate PolicyId [%s]: %+v", policyId, err)
+		return err
+	}
+
+	language := req.GetLanguage()
+	err = checkStringLen(ctx, language, 8)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Language [%s]: %+v", language, err)
+		return err
+	}
+
+	format := req.GetFormat()
+	err = checkTemplateFormat(ctx, format)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Format [%s]: %+v", format, err)
+		return err
+	}
+
+	title := req.GetTitle()
+	content := req.GetContent()
+	err = checkTemplate(ctx, format, title, content)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Title [%s] Content [%s]: %+v", title, content, err)
+		return err
+	}
+
+	return nil
+}
+
+//ValidateModifyTemplateParams checks template modified together with the current one.
+func ValidateModifyTemplateParams(ctx context.Context, req *pb.ModifyTemplateRequest, template *models.Template) error {
+	templateId := req.GetTemplateId()
+	err := checkStringLen(ctx, templateId, 50)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate TemplateId [%s]: %+v", templateId, err)
+		return err
+	}
+
+	format := req.GetFormat()
+	err = checkTemplateFormat(ctx, format)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Format [%s]: %+v", format, err)
+		return err
+	}
+
+	if format == "" {
+		format = template.Format
+	}
+	title := req.GetTitle()
+	if title == "" {
+		title = template.Title
+	}
+	content := req.GetContent()
+	if content == "" {
+		content = template.Content
+	}
+	err = checkTemplate(ctx, format, title, content)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Title [%s] Content [%s]: %+v", title, content, err)
+		return err
+	}
+
+	return nil
+}
+
+func ValidatePreviewTemplateParams(ctx context.Context, req *pb.PreviewTemplateRequest) error {
+	format := req.GetFormat()
+	err := checkTemplateFormat(ctx, format)
+	if err != nil {
+		logger.Error(ctx, "Failed to validate Format [%s]: %+v", format, err)
+		return err
+	}
+
+	notificationParam := req.GetNotificationParam()
+	if notificationParam != "" {
+		err = checkNotificationParam(ctx, notificationParam)
+		if err != nil {
+			logger.Error(ctx, "Failed to validate NotificationParam [%s]: %+v", notificationParam, err)
+			return err
+		}
+	}
+
 	return nil
 }
@@ -23,10 +23,22 @@ type Executor struct {
 	TaskCount int
 }
 
+//DispatchStats is backpressure of outbox dispatcher reported by executor.
+type DispatchStats struct {
+	QueueLength   int    `json:"queue_length"`
+	QueueCapacity int    `json:"queue_capacity"`
+	Workers       int    `json:"workers"`
+	BusyWorkers   int32  `json:"busy_workers"`
+	Delivered     uint64 `json:"delivered"`
+	Failed        uint64 `json:"failed"`
+	Throttled     uint64 `json:"throttled"`
+}
+
 // ExecutorInfo is the service register information to etcd
 type ExecutorInfo struct {
 	Name      string
 	TaskCount int
+	Dispatch  DispatchStats
 }
 
 type ExecutorWatcher struct {
@@ -40,6 +52,7 @@ type ExecutorWatcher struct {
 type Member struct {
 	Name      string
 	TaskCount int
+	Dispatch  DispatchStats
 }
 
 func NewExecutorWatcher() *ExecutorWatcher {
@@ -58,16 +71,27 @@ func (ew *ExecutorWatcher) addExecutor(info *ExecutorInfo) {
 	member := &Member{
 		Name:      info.Name,
 		TaskCount: info.TaskCount,
+		Dispatch:  info.Dispatch,
 	}
 	ew.Lock()
 	ew.members[member.Name] = member
 	ew.Unlock()
 }
 
+//isDispatchThrottled reports whether dispatcher of executor skipped claiming outboxes for full queue since the last report,
+//stats restart from zero with executor.
+func isDispatchThrottled(last DispatchStats, current DispatchStats) bool {
+	return current.Throttled > last.Throttled
+}
+
 func (ew *ExecutorWatcher) updateExecutor(info *ExecutorInfo) {
 	member := ew.members[info.Name]
+	if isDispatchThrottled(member.Dispatch, info.Dispatch) {
+		logger.Warn(nil, "ExecutorWatcher executor [%s] dispatch is throttled by full queue, %+v", info.Name, info.Dispatch)
+	}
 	ew.Lock()
 	member.TaskCount = info.TaskCount
+	member.Dispatch = info.Dispatch
 	ew.Unlock()
 }
 
@@ -87,7 +111,7 @@ func (ew *ExecutorWatcher) GetExecutors() []ExecutorInfo {
 	ew.Lock()
 	for name := range ew.members {
 		member := ew.members[name]
-		executors = append(executors, ExecutorInfo{Name: member.Name, TaskCount: member.TaskCount})
+		executors = append(executors, ExecutorInfo{Name: member.Name, TaskCount: member.TaskCount, Dispatch: member.Dispatch})
 	}
 	ew.Unlock()
 
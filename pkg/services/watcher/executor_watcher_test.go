@@ -0,0 +1,44 @@
+// Copyright 2018 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package watcher
+
+import (
+	"testing"
+
+	"kubesphere.io/alert/pkg/util/jsonutil"
+)
+
+func TestDecodeExecutorInfo(t *testing.T) {
+	value := `{"Name":"executor-1","TaskCount":3,"Dispatch":{"queue_length":100,"queue_capacity":100,"workers":4,"busy_workers":4,"delivered":10,"failed":1,"throttled":2,"last_deliver_millis":30}}`
+
+	var info ExecutorInfo
+	err := jsonutil.Decode([]byte(value), &info)
+	if err != nil {
+		t.Fatalf("Decode ExecutorInfo failed: %+v", err)
+	}
+	if info.Dispatch.QueueLength != 100 || info.Dispatch.Workers != 4 || info.Dispatch.Throttled != 2 {
+		t.Fatalf("Decode ExecutorInfo get wrong dispatch stats %+v", info.Dispatch)
+	}
+}
+
+func TestIsDispatchThrottled(t *testing.T) {
+	testCase := []struct {
+		last    uint64
+		current uint64
+		expect  bool
+	}{
+		{0, 0, false},
+		{2, 2, false},
+		{2, 3, true},
+		//Executor restarted
+		{5, 1, false},
+	}
+	for _, c := range testCase {
+		throttled := isDispatchThrottled(DispatchStats{Throttled: c.last}, DispatchStats{Throttled: c.current})
+		if throttled != c.expect {
+			t.Fatalf("isDispatchThrottled from [%d] to [%d] expect [%v]", c.last, c.current, c.expect)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package cronutil parses standard 5 fields cron expressions, eg.
+//   0 9 * * 1-5
+//   */30 8-18 * * *
+// Fields are minute, hour, day of month, month and day of week (0 or 7 is Sunday),
+// each field supports *, lists, ranges and steps.
+package cronutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Schedule struct {
+	src    string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+type field struct {
+	min int
+	max int
+}
+
+var fields = []field{
+	{0, 59}, //minute
+	{0, 23}, //hour
+	{1, 31}, //day of month
+	{1, 12}, //month
+	{0, 7},  //day of week
+}
+
+//Searching next time gives up after this, eg. for 30 2 * * with February only.
+const maxSearchYears = 5
+
+func Parse(src string) (*Schedule, error) {
+	parts := strings.Fields(src)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("cron [%s] should have %d fields", src, len(fields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("cron [%s] field [%s]: %v", src, part, err)
+		}
+		bits[i] = b
+	}
+
+	//Sunday could be 0 or 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &Schedule{
+		src:    src,
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		anyDom: parts[2] == "*",
+		anyDow: parts[4] == "*",
+	}, nil
+}
+
+func parseField(part string, f field) (uint64, error) {
+	bits := uint64(0)
+	for _, item := range strings.Split(part, ",") {
+		step := 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s <= 0 {
+				return 0, fmt.Errorf("illegal step [%s]", item[i+1:])
+			}
+			step = s
+			item = item[:i]
+		}
+
+		start, end := f.min, f.max
+		if item != "*" {
+			if i := strings.Index(item, "-"); i >= 0 {
+				s, err1 := strconv.Atoi(item[:i])
+				e, err2 := strconv.Atoi(item[i+1:])
+				if err1 != nil || err2 != nil {
+					return 0, fmt.Errorf("illegal range [%s]", item)
+				}
+				start, end = s, e
+			} else {
+				v, err := strconv.Atoi(item)
+				if err != nil {
+					return 0, fmt.Errorf("illegal value [%s]", item)
+				}
+				start, end = v, v
+				//Single value with step means from the value to the max, eg. 5/15
+				if step > 1 {
+					end = f.max
+				}
+			}
+		}
+		if start < f.min || end > f.max || start > end {
+			return 0, fmt.Errorf("[%s] out of range [%d, %d]", item, f.min, f.max)
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
+
+//matchDay follows cron that when both day of month and day of week are restricted, either matches.
+func (s *Schedule) matchDay(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.anyDom || s.anyDow {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+//Next returns the first time matching schedule after t, in the location of t, zero time if none in a few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) String() string {
+	return s.src
+}
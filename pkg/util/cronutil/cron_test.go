@@ -0,0 +1,61 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package cronutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNext(t *testing.T) {
+	//2019-09-27 is Friday
+	now := time.Date(2019, 9, 27, 10, 7, 30, 0, time.UTC)
+	testCase := map[string]string{
+		"* * * * *":       "2019-09-27 10:08",
+		"*/15 * * * *":    "2019-09-27 10:15",
+		"5/15 * * * *":    "2019-09-27 10:20",
+		"0 9 * * 1-5":     "2019-09-30 09:00",
+		"0 9,18 * * *":    "2019-09-27 18:00",
+		"30 2 1 * *":      "2019-10-01 02:30",
+		"0 0 * * 7":       "2019-09-29 00:00",
+		"0 0 13 * 5":      "2019-10-04 00:00",
+		"0 12 29 2 *":     "2020-02-29 12:00",
+		"5 10 27 9 *":     "2020-09-27 10:05",
+		"0-10/5 10 * * *": "2019-09-27 10:10",
+	}
+	for src, expect := range testCase {
+		s, err := Parse(src)
+		if err != nil {
+			t.Fatalf("Parse [%s] failed: %+v", src, err)
+		}
+		next := s.Next(now).Format("2006-01-02 15:04")
+		if next != expect {
+			t.Fatalf("Next of [%s] expect [%s] but get [%s]", src, expect, next)
+		}
+	}
+
+	s, _ := Parse("0 0 31 2 *")
+	if !s.Next(now).IsZero() {
+		t.Fatalf("Next of impossible schedule should be zero")
+	}
+}
+
+func TestParseIllegal(t *testing.T) {
+	for _, src := range []string{
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		if _, err := Parse(src); err == nil {
+			t.Fatalf("Parse [%s] should fail", src)
+		}
+	}
+}
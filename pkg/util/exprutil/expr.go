@@ -0,0 +1,458 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+// Package exprutil implements the small condition language used by alert rules, eg.
+//   value > 80 && value < 95
+//   abs(value - 50) > 10
+//   outside(value, 20, 80)
+// All operands are float64, comparison and logical operators yield 1 or 0.
+package exprutil
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type Expr struct {
+	src  string
+	root node
+}
+
+type node interface {
+	eval(vars map[string]float64) (float64, error)
+}
+
+type numNode float64
+
+type identNode string
+
+type unaryNode struct {
+	op string
+	x  node
+}
+
+type binaryNode struct {
+	op   string
+	l, r node
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+//Number of arguments accepted by each builtin function.
+var funcArgs = map[string]int{
+	"abs":     1,
+	"min":     2,
+	"max":     2,
+	"between": 3,
+	"outside": 3,
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (n numNode) eval(vars map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+func (n identNode) eval(vars map[string]float64) (float64, error) {
+	v, ok := vars[string(n)]
+	if !ok {
+		return 0, fmt.Errorf("undefined variable [%s]", string(n))
+	}
+	return v, nil
+}
+
+func (n unaryNode) eval(vars map[string]float64) (float64, error) {
+	x, err := n.x.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case "-":
+		return -x, nil
+	case "!":
+		return boolToFloat(x == 0), nil
+	}
+	return 0, fmt.Errorf("unknown operator [%s]", n.op)
+}
+
+func (n binaryNode) eval(vars map[string]float64) (float64, error) {
+	l, err := n.l.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	//Short circuit logical operators
+	switch n.op {
+	case "&&":
+		if l == 0 {
+			return 0, nil
+		}
+	case "||":
+		if l != 0 {
+			return 1, nil
+		}
+	}
+
+	r, err := n.r.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	switch n.op {
+	case "&&", "||":
+		return boolToFloat(r != 0), nil
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	case "==":
+		return boolToFloat(l == r), nil
+	case "!=":
+		return boolToFloat(l != r), nil
+	case ">":
+		return boolToFloat(l > r), nil
+	case ">=":
+		return boolToFloat(l >= r), nil
+	case "<":
+		return boolToFloat(l < r), nil
+	case "<=":
+		return boolToFloat(l <= r), nil
+	}
+	return 0, fmt.Errorf("unknown operator [%s]", n.op)
+}
+
+func (n callNode) eval(vars map[string]float64) (float64, error) {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+
+	switch n.name {
+	case "abs":
+		return math.Abs(args[0]), nil
+	case "min":
+		return math.Min(args[0], args[1]), nil
+	case "max":
+		return math.Max(args[0], args[1]), nil
+	case "between":
+		return boolToFloat(args[0] >= args[1] && args[0] <= args[2]), nil
+	case "outside":
+		return boolToFloat(args[0] < args[1] || args[0] > args[2]), nil
+	}
+	return 0, fmt.Errorf("unknown function [%s]", n.name)
+}
+
+//Eval evaluates the expression, a non-zero result is true.
+func (e *Expr) Eval(vars map[string]float64) (bool, error) {
+	v, err := e.root.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	return v != 0, nil
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+//IsBoolean reports whether the expression yields a truth value, ie. it is a comparison, a logical operation or a range check.
+func (e *Expr) IsBoolean() bool {
+	switch n := e.root.(type) {
+	case binaryNode:
+		switch n.op {
+		case "&&", "||", "==", "!=", ">", ">=", "<", "<=":
+			return true
+		}
+	case unaryNode:
+		return n.op == "!"
+	case callNode:
+		return n.name == "between" || n.name == "outside"
+	}
+	return false
+}
+
+//References reports whether the expression uses variable name.
+func (e *Expr) References(name string) bool {
+	return references(e.root, name)
+}
+
+func references(n node, name string) bool {
+	switch n := n.(type) {
+	case identNode:
+		return string(n) == name
+	case unaryNode:
+		return references(n.x, name)
+	case binaryNode:
+		return references(n.l, name) || references(n.r, name)
+	case callNode:
+		for _, arg := range n.args {
+			if references(arg, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+	vars   map[string]bool
+}
+
+//Parse compiles src, when vars is not empty only the listed variables may be referenced.
+func Parse(src string, vars ...string) (*Expr, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	p := &parser{tokens: tokens}
+	if len(vars) > 0 {
+		p.vars = make(map[string]bool)
+		for _, v := range vars {
+			p.vars[v] = true
+		}
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected token [%s]", p.tokens[p.pos])
+	}
+
+	return &Expr{src: src, root: root}, nil
+}
+
+func tokenize(src string) ([]string, error) {
+	var tokens []string
+	rs := []rune(src)
+
+	for i := 0; i < len(rs); {
+		c := rs[i]
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsDigit(c) || c == '.':
+			j := i
+			for j < len(rs) && (unicode.IsDigit(rs[j]) || rs[j] == '.' || rs[j] == 'e' || rs[j] == 'E' ||
+				((rs[j] == '+' || rs[j] == '-') && (rs[j-1] == 'e' || rs[j-1] == 'E'))) {
+				j++
+			}
+			tokens = append(tokens, string(rs[i:j]))
+			i = j
+		case unicode.IsLetter(c) || c == '_':
+			j := i
+			for j < len(rs) && (unicode.IsLetter(rs[j]) || unicode.IsDigit(rs[j]) || rs[j] == '_') {
+				j++
+			}
+			tokens = append(tokens, string(rs[i:j]))
+			i = j
+		default:
+			if i+1 < len(rs) {
+				two := string(rs[i : i+2])
+				switch two {
+				case "&&", "||", "==", "!=", ">=", "<=":
+					tokens = append(tokens, two)
+					i += 2
+					continue
+				}
+			}
+			if strings.ContainsRune("+-*/()<>!,", c) {
+				tokens = append(tokens, string(c))
+				i++
+				continue
+			}
+			return nil, fmt.Errorf("illegal character [%c]", c)
+		}
+	}
+
+	return tokens, nil
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *parser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) expect(t string) error {
+	if p.peek() != t {
+		if p.pos >= len(p.tokens) {
+			return fmt.Errorf("expect [%s] but reach the end", t)
+		}
+		return fmt.Errorf("expect [%s] but get [%s]", t, p.peek())
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseBinary(ops []string, operand func() (node, error)) (node, error) {
+	l, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		matched := false
+		for _, o := range ops {
+			if op == o {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return l, nil
+		}
+		p.pos++
+		r, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		l = binaryNode{op, l, r}
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary([]string{"||"}, p.parseAnd)
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary([]string{"&&"}, p.parseCompare)
+}
+
+func (p *parser) parseCompare() (node, error) {
+	l, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek(); op {
+	case "==", "!=", ">", ">=", "<", "<=":
+		p.pos++
+		r, err := p.parseAdd()
+		if err != nil {
+			return nil, err
+		}
+		return binaryNode{op, l, r}, nil
+	}
+	return l, nil
+}
+
+func (p *parser) parseAdd() (node, error) {
+	return p.parseBinary([]string{"+", "-"}, p.parseMul)
+}
+
+func (p *parser) parseMul() (node, error) {
+	return p.parseBinary([]string{"*", "/"}, p.parseUnary)
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch op := p.peek(); op {
+	case "-", "!":
+		p.pos++
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{op, x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	t := p.next()
+	c := []rune(t)[0]
+
+	switch {
+	case t == "(":
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		return x, p.expect(")")
+	case unicode.IsDigit(c) || c == '.':
+		v, err := strconv.ParseFloat(t, 64)
+		if err != nil {
+			return nil, fmt.Errorf("illegal number [%s]", t)
+		}
+		return numNode(v), nil
+	case unicode.IsLetter(c) || c == '_':
+		if p.peek() == "(" {
+			return p.parseCall(t)
+		}
+		if p.vars != nil && !p.vars[t] {
+			return nil, fmt.Errorf("undefined variable [%s]", t)
+		}
+		return identNode(t), nil
+	}
+
+	return nil, fmt.Errorf("unexpected token [%s]", t)
+}
+
+func (p *parser) parseCall(name string) (node, error) {
+	argc, ok := funcArgs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown function [%s]", name)
+	}
+
+	p.pos++
+	var args []node
+	for p.peek() != ")" {
+		if len(args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	p.pos++
+
+	if len(args) != argc {
+		return nil, fmt.Errorf("function [%s] expects %d arguments but get %d", name, argc, len(args))
+	}
+
+	return callNode{name, args}, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2019 The KubeSphere Authors. All rights reserved.
+// Use of this source code is governed by a Apache license
+// that can be found in the LICENSE file.
+
+package exprutil
+
+import "testing"
+
+func TestEval(t *testing.T) {
+	testCase := []struct {
+		expr   string
+		value  float64
+		result bool
+	}{
+		{"value >= threshold", 80, true},
+		{"value > threshold", 80, false},
+		{"value > 80 && value < 95", 90, true},
+		{"value > 80 && value < 95", 95, false},
+		{"abs(value - 50) > 10", 35, true},
+		{"abs(value - 50) > 10", 55, false},
+		{"value == 3 || value != 3 && value < 0", -1, true},
+		{"!(value == 3)", 3, false},
+		{"outside(value, 20, 80)", 85, true},
+		{"between(value, 20, 80)", 85, false},
+		{"value * 2 / 4 + 1 <= -(-26)", 50, true},
+		{"max(value, 1e2) == 100", 3.5, true},
+	}
+	for _, c := range testCase {
+		e, err := Parse(c.expr, "value", "threshold")
+		if err != nil {
+			t.Fatalf("Parse [%s] failed: %+v", c.expr, err)
+		}
+		result, err := e.Eval(map[string]float64{"value": c.value, "threshold": 80})
+		if err != nil {
+			t.Fatalf("Eval [%s] failed: %+v", c.expr, err)
+		}
+		if result != c.result {
+			t.Fatalf("Eval [%s] with value [%v] expect [%v] but get [%v]", c.expr, c.value, c.result, result)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	testCase := []string{
+		"",
+		"value >",
+		"value > 80 &&",
+		"(value > 80",
+		"foo > 80",
+		"abs(value, 1) > 2",
+		"sqrt(value) > 2",
+		"value # 2",
+		"value > 80 90",
+	}
+	for _, c := range testCase {
+		if _, err := Parse(c, "value", "threshold"); err == nil {
+			t.Fatalf("Parse [%s] should fail", c)
+		}
+	}
+}
+
+func TestIsBoolean(t *testing.T) {
+	testCase := map[string]bool{
+		"value > 80":              true,
+		"value > 80 || value < 5": true,
+		"!value":                  true,
+		"between(value, 20, 80)":  true,
+		"value":                   false,
+		"-value":                  false,
+		"value * 2":               false,
+		"max(value, 80)":          false,
+	}
+	for expr, expect := range testCase {
+		e, err := Parse(expr, "value")
+		if err != nil {
+			t.Fatalf("Parse [%s] failed: %+v", expr, err)
+		}
+		if e.IsBoolean() != expect {
+			t.Fatalf("IsBoolean of [%s] expect [%v] but get [%v]", expr, expect, !expect)
+		}
+	}
+}